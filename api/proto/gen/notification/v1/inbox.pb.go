@@ -0,0 +1,716 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: notification/v1/inbox.proto
+
+package notificationv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 站内信状态
+type InboxMessageStatus int32
+
+const (
+	// 未指定状态
+	InboxMessageStatus_INBOX_MESSAGE_STATUS_UNSPECIFIED InboxMessageStatus = 0
+	// 未读
+	InboxMessageStatus_UNREAD InboxMessageStatus = 1
+	// 已读
+	InboxMessageStatus_READ InboxMessageStatus = 2
+)
+
+// Enum value maps for InboxMessageStatus.
+var (
+	InboxMessageStatus_name = map[int32]string{
+		0: "INBOX_MESSAGE_STATUS_UNSPECIFIED",
+		1: "UNREAD",
+		2: "READ",
+	}
+	InboxMessageStatus_value = map[string]int32{
+		"INBOX_MESSAGE_STATUS_UNSPECIFIED": 0,
+		"UNREAD":                           1,
+		"READ":                             2,
+	}
+)
+
+func (x InboxMessageStatus) Enum() *InboxMessageStatus {
+	p := new(InboxMessageStatus)
+	*p = x
+	return p
+}
+
+func (x InboxMessageStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InboxMessageStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_notification_v1_inbox_proto_enumTypes[0].Descriptor()
+}
+
+func (InboxMessageStatus) Type() protoreflect.EnumType {
+	return &file_notification_v1_inbox_proto_enumTypes[0]
+}
+
+func (x InboxMessageStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InboxMessageStatus.Descriptor instead.
+func (InboxMessageStatus) EnumDescriptor() ([]byte, []int) {
+	return file_notification_v1_inbox_proto_rawDescGZIP(), []int{0}
+}
+
+// 站内信
+type InboxMessage struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 站内信ID
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 对应的通知ID
+	NotificationId uint64 `protobuf:"varint,2,opt,name=notification_id,json=notificationId,proto3" json:"notification_id,omitempty"`
+	// 标题
+	Title string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	// 内容
+	Content string `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	// 状态
+	Status InboxMessageStatus `protobuf:"varint,5,opt,name=status,proto3,enum=notification.v1.InboxMessageStatus" json:"status,omitempty"`
+	// 阅读时间，毫秒时间戳，未读时为0
+	ReadTime int64 `protobuf:"varint,6,opt,name=read_time,json=readTime,proto3" json:"read_time,omitempty"`
+	// 创建时间，毫秒时间戳
+	Ctime         int64 `protobuf:"varint,7,opt,name=ctime,proto3" json:"ctime,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InboxMessage) Reset() {
+	*x = InboxMessage{}
+	mi := &file_notification_v1_inbox_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InboxMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InboxMessage) ProtoMessage() {}
+
+func (x *InboxMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_inbox_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InboxMessage.ProtoReflect.Descriptor instead.
+func (*InboxMessage) Descriptor() ([]byte, []int) {
+	return file_notification_v1_inbox_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *InboxMessage) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *InboxMessage) GetNotificationId() uint64 {
+	if x != nil {
+		return x.NotificationId
+	}
+	return 0
+}
+
+func (x *InboxMessage) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *InboxMessage) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *InboxMessage) GetStatus() InboxMessageStatus {
+	if x != nil {
+		return x.Status
+	}
+	return InboxMessageStatus_INBOX_MESSAGE_STATUS_UNSPECIFIED
+}
+
+func (x *InboxMessage) GetReadTime() int64 {
+	if x != nil {
+		return x.ReadTime
+	}
+	return 0
+}
+
+func (x *InboxMessage) GetCtime() int64 {
+	if x != nil {
+		return x.Ctime
+	}
+	return 0
+}
+
+// 分页查询站内信请求
+type ListInboxMessagesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 接收者（用户ID）
+	Receiver string `protobuf:"bytes,1,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// 每页数量
+	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// 是否只查询未读的站内信
+	OnlyUnread bool `protobuf:"varint,3,opt,name=only_unread,json=onlyUnread,proto3" json:"only_unread,omitempty"`
+	// 游标，传入上一页响应中的 next_cursor，第一页不传
+	Cursor        int64 `protobuf:"varint,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInboxMessagesRequest) Reset() {
+	*x = ListInboxMessagesRequest{}
+	mi := &file_notification_v1_inbox_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInboxMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInboxMessagesRequest) ProtoMessage() {}
+
+func (x *ListInboxMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_inbox_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInboxMessagesRequest.ProtoReflect.Descriptor instead.
+func (*ListInboxMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_inbox_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListInboxMessagesRequest) GetReceiver() string {
+	if x != nil {
+		return x.Receiver
+	}
+	return ""
+}
+
+func (x *ListInboxMessagesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListInboxMessagesRequest) GetOnlyUnread() bool {
+	if x != nil {
+		return x.OnlyUnread
+	}
+	return false
+}
+
+func (x *ListInboxMessagesRequest) GetCursor() int64 {
+	if x != nil {
+		return x.Cursor
+	}
+	return 0
+}
+
+// 分页查询站内信响应
+type ListInboxMessagesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 站内信，按照创建时间倒序排列
+	Messages []*InboxMessage `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	// 下一页的游标，为 0 表示没有更多站内信
+	NextCursor    int64 `protobuf:"varint,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInboxMessagesResponse) Reset() {
+	*x = ListInboxMessagesResponse{}
+	mi := &file_notification_v1_inbox_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInboxMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInboxMessagesResponse) ProtoMessage() {}
+
+func (x *ListInboxMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_inbox_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInboxMessagesResponse.ProtoReflect.Descriptor instead.
+func (*ListInboxMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_inbox_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListInboxMessagesResponse) GetMessages() []*InboxMessage {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *ListInboxMessagesResponse) GetNextCursor() int64 {
+	if x != nil {
+		return x.NextCursor
+	}
+	return 0
+}
+
+// 查询未读数量请求
+type GetUnreadCountRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 接收者（用户ID）
+	Receiver      string `protobuf:"bytes,1,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUnreadCountRequest) Reset() {
+	*x = GetUnreadCountRequest{}
+	mi := &file_notification_v1_inbox_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUnreadCountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUnreadCountRequest) ProtoMessage() {}
+
+func (x *GetUnreadCountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_inbox_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUnreadCountRequest.ProtoReflect.Descriptor instead.
+func (*GetUnreadCountRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_inbox_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetUnreadCountRequest) GetReceiver() string {
+	if x != nil {
+		return x.Receiver
+	}
+	return ""
+}
+
+// 查询未读数量响应
+type GetUnreadCountResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 未读数量
+	Count         int64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUnreadCountResponse) Reset() {
+	*x = GetUnreadCountResponse{}
+	mi := &file_notification_v1_inbox_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUnreadCountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUnreadCountResponse) ProtoMessage() {}
+
+func (x *GetUnreadCountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_inbox_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUnreadCountResponse.ProtoReflect.Descriptor instead.
+func (*GetUnreadCountResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_inbox_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetUnreadCountResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// 标记已读请求
+type MarkInboxMessagesReadRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 接收者（用户ID）
+	Receiver string `protobuf:"bytes,1,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// 要标记的站内信ID，为空时标记该接收者的全部站内信
+	Ids           []int64 `protobuf:"varint,2,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkInboxMessagesReadRequest) Reset() {
+	*x = MarkInboxMessagesReadRequest{}
+	mi := &file_notification_v1_inbox_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkInboxMessagesReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkInboxMessagesReadRequest) ProtoMessage() {}
+
+func (x *MarkInboxMessagesReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_inbox_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkInboxMessagesReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkInboxMessagesReadRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_inbox_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MarkInboxMessagesReadRequest) GetReceiver() string {
+	if x != nil {
+		return x.Receiver
+	}
+	return ""
+}
+
+func (x *MarkInboxMessagesReadRequest) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+// 标记已读响应
+type MarkInboxMessagesReadResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 实际被标记的数量
+	Affected      int64 `protobuf:"varint,1,opt,name=affected,proto3" json:"affected,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkInboxMessagesReadResponse) Reset() {
+	*x = MarkInboxMessagesReadResponse{}
+	mi := &file_notification_v1_inbox_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkInboxMessagesReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkInboxMessagesReadResponse) ProtoMessage() {}
+
+func (x *MarkInboxMessagesReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_inbox_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkInboxMessagesReadResponse.ProtoReflect.Descriptor instead.
+func (*MarkInboxMessagesReadResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_inbox_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MarkInboxMessagesReadResponse) GetAffected() int64 {
+	if x != nil {
+		return x.Affected
+	}
+	return 0
+}
+
+// 删除站内信请求
+type DeleteInboxMessagesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 接收者（用户ID）
+	Receiver string `protobuf:"bytes,1,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// 要删除的站内信ID
+	Ids           []int64 `protobuf:"varint,2,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteInboxMessagesRequest) Reset() {
+	*x = DeleteInboxMessagesRequest{}
+	mi := &file_notification_v1_inbox_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteInboxMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteInboxMessagesRequest) ProtoMessage() {}
+
+func (x *DeleteInboxMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_inbox_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteInboxMessagesRequest.ProtoReflect.Descriptor instead.
+func (*DeleteInboxMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_inbox_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteInboxMessagesRequest) GetReceiver() string {
+	if x != nil {
+		return x.Receiver
+	}
+	return ""
+}
+
+func (x *DeleteInboxMessagesRequest) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+// 删除站内信响应
+type DeleteInboxMessagesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 实际被删除的数量
+	Affected      int64 `protobuf:"varint,1,opt,name=affected,proto3" json:"affected,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteInboxMessagesResponse) Reset() {
+	*x = DeleteInboxMessagesResponse{}
+	mi := &file_notification_v1_inbox_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteInboxMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteInboxMessagesResponse) ProtoMessage() {}
+
+func (x *DeleteInboxMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_inbox_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteInboxMessagesResponse.ProtoReflect.Descriptor instead.
+func (*DeleteInboxMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_inbox_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteInboxMessagesResponse) GetAffected() int64 {
+	if x != nil {
+		return x.Affected
+	}
+	return 0
+}
+
+var File_notification_v1_inbox_proto protoreflect.FileDescriptor
+
+const file_notification_v1_inbox_proto_rawDesc = "" +
+	"\n" +
+	"\x1bnotification/v1/inbox.proto\x12\x0fnotification.v1\"\xe7\x01\n" +
+	"\fInboxMessage\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12'\n" +
+	"\x0fnotification_id\x18\x02 \x01(\x04R\x0enotificationId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\x12;\n" +
+	"\x06status\x18\x05 \x01(\x0e2#.notification.v1.InboxMessageStatusR\x06status\x12\x1b\n" +
+	"\tread_time\x18\x06 \x01(\x03R\breadTime\x12\x14\n" +
+	"\x05ctime\x18\a \x01(\x03R\x05ctime\"\x85\x01\n" +
+	"\x18ListInboxMessagesRequest\x12\x1a\n" +
+	"\breceiver\x18\x01 \x01(\tR\breceiver\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x1f\n" +
+	"\vonly_unread\x18\x03 \x01(\bR\n" +
+	"onlyUnread\x12\x16\n" +
+	"\x06cursor\x18\x04 \x01(\x03R\x06cursor\"w\n" +
+	"\x19ListInboxMessagesResponse\x129\n" +
+	"\bmessages\x18\x01 \x03(\v2\x1d.notification.v1.InboxMessageR\bmessages\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\x03R\n" +
+	"nextCursor\"3\n" +
+	"\x15GetUnreadCountRequest\x12\x1a\n" +
+	"\breceiver\x18\x01 \x01(\tR\breceiver\".\n" +
+	"\x16GetUnreadCountResponse\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x03R\x05count\"L\n" +
+	"\x1cMarkInboxMessagesReadRequest\x12\x1a\n" +
+	"\breceiver\x18\x01 \x01(\tR\breceiver\x12\x10\n" +
+	"\x03ids\x18\x02 \x03(\x03R\x03ids\";\n" +
+	"\x1dMarkInboxMessagesReadResponse\x12\x1a\n" +
+	"\baffected\x18\x01 \x01(\x03R\baffected\"J\n" +
+	"\x1aDeleteInboxMessagesRequest\x12\x1a\n" +
+	"\breceiver\x18\x01 \x01(\tR\breceiver\x12\x10\n" +
+	"\x03ids\x18\x02 \x03(\x03R\x03ids\"9\n" +
+	"\x1bDeleteInboxMessagesResponse\x12\x1a\n" +
+	"\baffected\x18\x01 \x01(\x03R\baffected*P\n" +
+	"\x12InboxMessageStatus\x12$\n" +
+	" INBOX_MESSAGE_STATUS_UNSPECIFIED\x10\x00\x12\n" +
+	"\n" +
+	"\x06UNREAD\x10\x01\x12\b\n" +
+	"\x04READ\x10\x022\xc7\x03\n" +
+	"\fInboxService\x12j\n" +
+	"\x11ListInboxMessages\x12).notification.v1.ListInboxMessagesRequest\x1a*.notification.v1.ListInboxMessagesResponse\x12a\n" +
+	"\x0eGetUnreadCount\x12&.notification.v1.GetUnreadCountRequest\x1a'.notification.v1.GetUnreadCountResponse\x12v\n" +
+	"\x15MarkInboxMessagesRead\x12-.notification.v1.MarkInboxMessagesReadRequest\x1a..notification.v1.MarkInboxMessagesReadResponse\x12p\n" +
+	"\x13DeleteInboxMessages\x12+.notification.v1.DeleteInboxMessagesRequest\x1a,.notification.v1.DeleteInboxMessagesResponseB\xd5\x01\n" +
+	"\x13com.notification.v1B\n" +
+	"InboxProtoP\x01ZUgithub.com/robinlg/notification-platform/api/proto/gen/notification/v1;notificationv1\xa2\x02\x03NXX\xaa\x02\x0fNotification.V1\xca\x02\x0fNotification\\V1\xe2\x02\x1bNotification\\V1\\GPBMetadata\xea\x02\x10Notification::V1b\x06proto3"
+
+var (
+	file_notification_v1_inbox_proto_rawDescOnce sync.Once
+	file_notification_v1_inbox_proto_rawDescData []byte
+)
+
+func file_notification_v1_inbox_proto_rawDescGZIP() []byte {
+	file_notification_v1_inbox_proto_rawDescOnce.Do(func() {
+		file_notification_v1_inbox_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_notification_v1_inbox_proto_rawDesc), len(file_notification_v1_inbox_proto_rawDesc)))
+	})
+	return file_notification_v1_inbox_proto_rawDescData
+}
+
+var file_notification_v1_inbox_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_notification_v1_inbox_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_notification_v1_inbox_proto_goTypes = []any{
+	(InboxMessageStatus)(0),               // 0: notification.v1.InboxMessageStatus
+	(*InboxMessage)(nil),                  // 1: notification.v1.InboxMessage
+	(*ListInboxMessagesRequest)(nil),      // 2: notification.v1.ListInboxMessagesRequest
+	(*ListInboxMessagesResponse)(nil),     // 3: notification.v1.ListInboxMessagesResponse
+	(*GetUnreadCountRequest)(nil),         // 4: notification.v1.GetUnreadCountRequest
+	(*GetUnreadCountResponse)(nil),        // 5: notification.v1.GetUnreadCountResponse
+	(*MarkInboxMessagesReadRequest)(nil),  // 6: notification.v1.MarkInboxMessagesReadRequest
+	(*MarkInboxMessagesReadResponse)(nil), // 7: notification.v1.MarkInboxMessagesReadResponse
+	(*DeleteInboxMessagesRequest)(nil),    // 8: notification.v1.DeleteInboxMessagesRequest
+	(*DeleteInboxMessagesResponse)(nil),   // 9: notification.v1.DeleteInboxMessagesResponse
+}
+var file_notification_v1_inbox_proto_depIdxs = []int32{
+	0, // 0: notification.v1.InboxMessage.status:type_name -> notification.v1.InboxMessageStatus
+	1, // 1: notification.v1.ListInboxMessagesResponse.messages:type_name -> notification.v1.InboxMessage
+	2, // 2: notification.v1.InboxService.ListInboxMessages:input_type -> notification.v1.ListInboxMessagesRequest
+	4, // 3: notification.v1.InboxService.GetUnreadCount:input_type -> notification.v1.GetUnreadCountRequest
+	6, // 4: notification.v1.InboxService.MarkInboxMessagesRead:input_type -> notification.v1.MarkInboxMessagesReadRequest
+	8, // 5: notification.v1.InboxService.DeleteInboxMessages:input_type -> notification.v1.DeleteInboxMessagesRequest
+	3, // 6: notification.v1.InboxService.ListInboxMessages:output_type -> notification.v1.ListInboxMessagesResponse
+	5, // 7: notification.v1.InboxService.GetUnreadCount:output_type -> notification.v1.GetUnreadCountResponse
+	7, // 8: notification.v1.InboxService.MarkInboxMessagesRead:output_type -> notification.v1.MarkInboxMessagesReadResponse
+	9, // 9: notification.v1.InboxService.DeleteInboxMessages:output_type -> notification.v1.DeleteInboxMessagesResponse
+	6, // [6:10] is the sub-list for method output_type
+	2, // [2:6] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_notification_v1_inbox_proto_init() }
+func file_notification_v1_inbox_proto_init() {
+	if File_notification_v1_inbox_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_notification_v1_inbox_proto_rawDesc), len(file_notification_v1_inbox_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_notification_v1_inbox_proto_goTypes,
+		DependencyIndexes: file_notification_v1_inbox_proto_depIdxs,
+		EnumInfos:         file_notification_v1_inbox_proto_enumTypes,
+		MessageInfos:      file_notification_v1_inbox_proto_msgTypes,
+	}.Build()
+	File_notification_v1_inbox_proto = out.File
+	file_notification_v1_inbox_proto_goTypes = nil
+	file_notification_v1_inbox_proto_depIdxs = nil
+}
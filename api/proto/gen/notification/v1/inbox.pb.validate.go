@@ -0,0 +1,1024 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: notification/v1/inbox.proto
+
+package notificationv1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on InboxMessage with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *InboxMessage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InboxMessage with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in InboxMessageMultiError, or
+// nil if none found.
+func (m *InboxMessage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InboxMessage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for NotificationId
+
+	// no validation rules for Title
+
+	// no validation rules for Content
+
+	// no validation rules for Status
+
+	// no validation rules for ReadTime
+
+	// no validation rules for Ctime
+
+	if len(errors) > 0 {
+		return InboxMessageMultiError(errors)
+	}
+
+	return nil
+}
+
+// InboxMessageMultiError is an error wrapping multiple validation errors
+// returned by InboxMessage.ValidateAll() if the designated constraints aren't met.
+type InboxMessageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InboxMessageMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InboxMessageMultiError) AllErrors() []error { return m }
+
+// InboxMessageValidationError is the validation error returned by
+// InboxMessage.Validate if the designated constraints aren't met.
+type InboxMessageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InboxMessageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InboxMessageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InboxMessageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InboxMessageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InboxMessageValidationError) ErrorName() string { return "InboxMessageValidationError" }
+
+// Error satisfies the builtin error interface
+func (e InboxMessageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInboxMessage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InboxMessageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InboxMessageValidationError{}
+
+// Validate checks the field values on ListInboxMessagesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListInboxMessagesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListInboxMessagesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListInboxMessagesRequestMultiError, or nil if none found.
+func (m *ListInboxMessagesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListInboxMessagesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Receiver
+
+	// no validation rules for Limit
+
+	// no validation rules for OnlyUnread
+
+	// no validation rules for Cursor
+
+	if len(errors) > 0 {
+		return ListInboxMessagesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListInboxMessagesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListInboxMessagesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListInboxMessagesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListInboxMessagesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListInboxMessagesRequestMultiError) AllErrors() []error { return m }
+
+// ListInboxMessagesRequestValidationError is the validation error returned by
+// ListInboxMessagesRequest.Validate if the designated constraints aren't met.
+type ListInboxMessagesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListInboxMessagesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListInboxMessagesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListInboxMessagesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListInboxMessagesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListInboxMessagesRequestValidationError) ErrorName() string {
+	return "ListInboxMessagesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListInboxMessagesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListInboxMessagesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListInboxMessagesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListInboxMessagesRequestValidationError{}
+
+// Validate checks the field values on ListInboxMessagesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListInboxMessagesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListInboxMessagesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListInboxMessagesResponseMultiError, or nil if none found.
+func (m *ListInboxMessagesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListInboxMessagesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetMessages() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListInboxMessagesResponseValidationError{
+						field:  fmt.Sprintf("Messages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListInboxMessagesResponseValidationError{
+						field:  fmt.Sprintf("Messages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListInboxMessagesResponseValidationError{
+					field:  fmt.Sprintf("Messages[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextCursor
+
+	if len(errors) > 0 {
+		return ListInboxMessagesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListInboxMessagesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListInboxMessagesResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ListInboxMessagesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListInboxMessagesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListInboxMessagesResponseMultiError) AllErrors() []error { return m }
+
+// ListInboxMessagesResponseValidationError is the validation error returned by
+// ListInboxMessagesResponse.Validate if the designated constraints aren't met.
+type ListInboxMessagesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListInboxMessagesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListInboxMessagesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListInboxMessagesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListInboxMessagesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListInboxMessagesResponseValidationError) ErrorName() string {
+	return "ListInboxMessagesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListInboxMessagesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListInboxMessagesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListInboxMessagesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListInboxMessagesResponseValidationError{}
+
+// Validate checks the field values on GetUnreadCountRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUnreadCountRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUnreadCountRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUnreadCountRequestMultiError, or nil if none found.
+func (m *GetUnreadCountRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUnreadCountRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Receiver
+
+	if len(errors) > 0 {
+		return GetUnreadCountRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUnreadCountRequestMultiError is an error wrapping multiple validation
+// errors returned by GetUnreadCountRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetUnreadCountRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUnreadCountRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUnreadCountRequestMultiError) AllErrors() []error { return m }
+
+// GetUnreadCountRequestValidationError is the validation error returned by
+// GetUnreadCountRequest.Validate if the designated constraints aren't met.
+type GetUnreadCountRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUnreadCountRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUnreadCountRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUnreadCountRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUnreadCountRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUnreadCountRequestValidationError) ErrorName() string {
+	return "GetUnreadCountRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUnreadCountRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUnreadCountRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUnreadCountRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUnreadCountRequestValidationError{}
+
+// Validate checks the field values on GetUnreadCountResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUnreadCountResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUnreadCountResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUnreadCountResponseMultiError, or nil if none found.
+func (m *GetUnreadCountResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUnreadCountResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return GetUnreadCountResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUnreadCountResponseMultiError is an error wrapping multiple validation
+// errors returned by GetUnreadCountResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetUnreadCountResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUnreadCountResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUnreadCountResponseMultiError) AllErrors() []error { return m }
+
+// GetUnreadCountResponseValidationError is the validation error returned by
+// GetUnreadCountResponse.Validate if the designated constraints aren't met.
+type GetUnreadCountResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUnreadCountResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUnreadCountResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUnreadCountResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUnreadCountResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUnreadCountResponseValidationError) ErrorName() string {
+	return "GetUnreadCountResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUnreadCountResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUnreadCountResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUnreadCountResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUnreadCountResponseValidationError{}
+
+// Validate checks the field values on MarkInboxMessagesReadRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MarkInboxMessagesReadRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MarkInboxMessagesReadRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MarkInboxMessagesReadRequestMultiError, or nil if none found.
+func (m *MarkInboxMessagesReadRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MarkInboxMessagesReadRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Receiver
+
+	if len(errors) > 0 {
+		return MarkInboxMessagesReadRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MarkInboxMessagesReadRequestMultiError is an error wrapping multiple
+// validation errors returned by MarkInboxMessagesReadRequest.ValidateAll() if
+// the designated constraints aren't met.
+type MarkInboxMessagesReadRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MarkInboxMessagesReadRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MarkInboxMessagesReadRequestMultiError) AllErrors() []error { return m }
+
+// MarkInboxMessagesReadRequestValidationError is the validation error returned
+// by MarkInboxMessagesReadRequest.Validate if the designated constraints
+// aren't met.
+type MarkInboxMessagesReadRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MarkInboxMessagesReadRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MarkInboxMessagesReadRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MarkInboxMessagesReadRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MarkInboxMessagesReadRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MarkInboxMessagesReadRequestValidationError) ErrorName() string {
+	return "MarkInboxMessagesReadRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MarkInboxMessagesReadRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMarkInboxMessagesReadRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MarkInboxMessagesReadRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MarkInboxMessagesReadRequestValidationError{}
+
+// Validate checks the field values on MarkInboxMessagesReadResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MarkInboxMessagesReadResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MarkInboxMessagesReadResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// MarkInboxMessagesReadResponseMultiError, or nil if none found.
+func (m *MarkInboxMessagesReadResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MarkInboxMessagesReadResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Affected
+
+	if len(errors) > 0 {
+		return MarkInboxMessagesReadResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// MarkInboxMessagesReadResponseMultiError is an error wrapping multiple
+// validation errors returned by MarkInboxMessagesReadResponse.ValidateAll()
+// if the designated constraints aren't met.
+type MarkInboxMessagesReadResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MarkInboxMessagesReadResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MarkInboxMessagesReadResponseMultiError) AllErrors() []error { return m }
+
+// MarkInboxMessagesReadResponseValidationError is the validation error
+// returned by MarkInboxMessagesReadResponse.Validate if the designated
+// constraints aren't met.
+type MarkInboxMessagesReadResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MarkInboxMessagesReadResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MarkInboxMessagesReadResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MarkInboxMessagesReadResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MarkInboxMessagesReadResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MarkInboxMessagesReadResponseValidationError) ErrorName() string {
+	return "MarkInboxMessagesReadResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MarkInboxMessagesReadResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMarkInboxMessagesReadResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MarkInboxMessagesReadResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MarkInboxMessagesReadResponseValidationError{}
+
+// Validate checks the field values on DeleteInboxMessagesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteInboxMessagesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteInboxMessagesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteInboxMessagesRequestMultiError, or nil if none found.
+func (m *DeleteInboxMessagesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteInboxMessagesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Receiver
+
+	if len(errors) > 0 {
+		return DeleteInboxMessagesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteInboxMessagesRequestMultiError is an error wrapping multiple
+// validation errors returned by DeleteInboxMessagesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteInboxMessagesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteInboxMessagesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteInboxMessagesRequestMultiError) AllErrors() []error { return m }
+
+// DeleteInboxMessagesRequestValidationError is the validation error returned
+// by DeleteInboxMessagesRequest.Validate if the designated constraints aren't met.
+type DeleteInboxMessagesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteInboxMessagesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteInboxMessagesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteInboxMessagesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteInboxMessagesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteInboxMessagesRequestValidationError) ErrorName() string {
+	return "DeleteInboxMessagesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteInboxMessagesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteInboxMessagesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteInboxMessagesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteInboxMessagesRequestValidationError{}
+
+// Validate checks the field values on DeleteInboxMessagesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteInboxMessagesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteInboxMessagesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteInboxMessagesResponseMultiError, or nil if none found.
+func (m *DeleteInboxMessagesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteInboxMessagesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Affected
+
+	if len(errors) > 0 {
+		return DeleteInboxMessagesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteInboxMessagesResponseMultiError is an error wrapping multiple
+// validation errors returned by DeleteInboxMessagesResponse.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteInboxMessagesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteInboxMessagesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteInboxMessagesResponseMultiError) AllErrors() []error { return m }
+
+// DeleteInboxMessagesResponseValidationError is the validation error returned
+// by DeleteInboxMessagesResponse.Validate if the designated constraints
+// aren't met.
+type DeleteInboxMessagesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteInboxMessagesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteInboxMessagesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteInboxMessagesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteInboxMessagesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteInboxMessagesResponseValidationError) ErrorName() string {
+	return "DeleteInboxMessagesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteInboxMessagesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteInboxMessagesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteInboxMessagesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteInboxMessagesResponseValidationError{}
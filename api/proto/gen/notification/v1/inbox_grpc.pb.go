@@ -0,0 +1,245 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: notification/v1/inbox.proto
+
+package notificationv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	InboxService_ListInboxMessages_FullMethodName     = "/notification.v1.InboxService/ListInboxMessages"
+	InboxService_GetUnreadCount_FullMethodName        = "/notification.v1.InboxService/GetUnreadCount"
+	InboxService_MarkInboxMessagesRead_FullMethodName = "/notification.v1.InboxService/MarkInboxMessagesRead"
+	InboxService_DeleteInboxMessages_FullMethodName   = "/notification.v1.InboxService/DeleteInboxMessages"
+)
+
+// InboxServiceClient is the client API for InboxService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// 站内信收件箱服务
+type InboxServiceClient interface {
+	// 分页查询用户的站内信
+	ListInboxMessages(ctx context.Context, in *ListInboxMessagesRequest, opts ...grpc.CallOption) (*ListInboxMessagesResponse, error)
+	// 查询用户的未读站内信数量
+	GetUnreadCount(ctx context.Context, in *GetUnreadCountRequest, opts ...grpc.CallOption) (*GetUnreadCountResponse, error)
+	// 将站内信标记为已读
+	MarkInboxMessagesRead(ctx context.Context, in *MarkInboxMessagesReadRequest, opts ...grpc.CallOption) (*MarkInboxMessagesReadResponse, error)
+	// 删除站内信
+	DeleteInboxMessages(ctx context.Context, in *DeleteInboxMessagesRequest, opts ...grpc.CallOption) (*DeleteInboxMessagesResponse, error)
+}
+
+type inboxServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewInboxServiceClient(cc grpc.ClientConnInterface) InboxServiceClient {
+	return &inboxServiceClient{cc}
+}
+
+func (c *inboxServiceClient) ListInboxMessages(ctx context.Context, in *ListInboxMessagesRequest, opts ...grpc.CallOption) (*ListInboxMessagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListInboxMessagesResponse)
+	err := c.cc.Invoke(ctx, InboxService_ListInboxMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inboxServiceClient) GetUnreadCount(ctx context.Context, in *GetUnreadCountRequest, opts ...grpc.CallOption) (*GetUnreadCountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUnreadCountResponse)
+	err := c.cc.Invoke(ctx, InboxService_GetUnreadCount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inboxServiceClient) MarkInboxMessagesRead(ctx context.Context, in *MarkInboxMessagesReadRequest, opts ...grpc.CallOption) (*MarkInboxMessagesReadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarkInboxMessagesReadResponse)
+	err := c.cc.Invoke(ctx, InboxService_MarkInboxMessagesRead_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inboxServiceClient) DeleteInboxMessages(ctx context.Context, in *DeleteInboxMessagesRequest, opts ...grpc.CallOption) (*DeleteInboxMessagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteInboxMessagesResponse)
+	err := c.cc.Invoke(ctx, InboxService_DeleteInboxMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// InboxServiceServer is the server API for InboxService service.
+// All implementations should embed UnimplementedInboxServiceServer
+// for forward compatibility.
+//
+// 站内信收件箱服务
+type InboxServiceServer interface {
+	// 分页查询用户的站内信
+	ListInboxMessages(context.Context, *ListInboxMessagesRequest) (*ListInboxMessagesResponse, error)
+	// 查询用户的未读站内信数量
+	GetUnreadCount(context.Context, *GetUnreadCountRequest) (*GetUnreadCountResponse, error)
+	// 将站内信标记为已读
+	MarkInboxMessagesRead(context.Context, *MarkInboxMessagesReadRequest) (*MarkInboxMessagesReadResponse, error)
+	// 删除站内信
+	DeleteInboxMessages(context.Context, *DeleteInboxMessagesRequest) (*DeleteInboxMessagesResponse, error)
+}
+
+// UnimplementedInboxServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedInboxServiceServer struct{}
+
+func (UnimplementedInboxServiceServer) ListInboxMessages(context.Context, *ListInboxMessagesRequest) (*ListInboxMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInboxMessages not implemented")
+}
+func (UnimplementedInboxServiceServer) GetUnreadCount(context.Context, *GetUnreadCountRequest) (*GetUnreadCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUnreadCount not implemented")
+}
+func (UnimplementedInboxServiceServer) MarkInboxMessagesRead(context.Context, *MarkInboxMessagesReadRequest) (*MarkInboxMessagesReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkInboxMessagesRead not implemented")
+}
+func (UnimplementedInboxServiceServer) DeleteInboxMessages(context.Context, *DeleteInboxMessagesRequest) (*DeleteInboxMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteInboxMessages not implemented")
+}
+func (UnimplementedInboxServiceServer) testEmbeddedByValue() {}
+
+// UnsafeInboxServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to InboxServiceServer will
+// result in compilation errors.
+type UnsafeInboxServiceServer interface {
+	mustEmbedUnimplementedInboxServiceServer()
+}
+
+func RegisterInboxServiceServer(s grpc.ServiceRegistrar, srv InboxServiceServer) {
+	// If the following call pancis, it indicates UnimplementedInboxServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&InboxService_ServiceDesc, srv)
+}
+
+func _InboxService_ListInboxMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInboxMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InboxServiceServer).ListInboxMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InboxService_ListInboxMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InboxServiceServer).ListInboxMessages(ctx, req.(*ListInboxMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InboxService_GetUnreadCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUnreadCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InboxServiceServer).GetUnreadCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InboxService_GetUnreadCount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InboxServiceServer).GetUnreadCount(ctx, req.(*GetUnreadCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InboxService_MarkInboxMessagesRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkInboxMessagesReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InboxServiceServer).MarkInboxMessagesRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InboxService_MarkInboxMessagesRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InboxServiceServer).MarkInboxMessagesRead(ctx, req.(*MarkInboxMessagesReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InboxService_DeleteInboxMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteInboxMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InboxServiceServer).DeleteInboxMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InboxService_DeleteInboxMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InboxServiceServer).DeleteInboxMessages(ctx, req.(*DeleteInboxMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// InboxService_ServiceDesc is the grpc.ServiceDesc for InboxService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var InboxService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "notification.v1.InboxService",
+	HandlerType: (*InboxServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListInboxMessages",
+			Handler:    _InboxService_ListInboxMessages_Handler,
+		},
+		{
+			MethodName: "GetUnreadCount",
+			Handler:    _InboxService_GetUnreadCount_Handler,
+		},
+		{
+			MethodName: "MarkInboxMessagesRead",
+			Handler:    _InboxService_MarkInboxMessagesRead_Handler,
+		},
+		{
+			MethodName: "DeleteInboxMessages",
+			Handler:    _InboxService_DeleteInboxMessages_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "notification/v1/inbox.proto",
+}
@@ -95,6 +95,10 @@ const (
 	SendStatus_SUCCEEDED SendStatus = 4
 	// 发送失败
 	SendStatus_FAILED SendStatus = 5
+	// 发送中
+	SendStatus_SENDING SendStatus = 6
+	// 已过期，超出计划发送时间仍未发送
+	SendStatus_EXPIRED SendStatus = 7
 )
 
 // Enum value maps for SendStatus.
@@ -106,6 +110,8 @@ var (
 		3: "PENDING",
 		4: "SUCCEEDED",
 		5: "FAILED",
+		6: "SENDING",
+		7: "EXPIRED",
 	}
 	SendStatus_value = map[string]int32{
 		"SEND_STATUS_UNSPECIFIED": 0,
@@ -114,6 +120,8 @@ var (
 		"PENDING":                 3,
 		"SUCCEEDED":               4,
 		"FAILED":                  5,
+		"SENDING":                 6,
+		"EXPIRED":                 7,
 	}
 )
 
@@ -182,6 +190,10 @@ const (
 	ErrorCode_PROVIDER_NOT_FOUND ErrorCode = 15
 	// 未知渠道类型
 	ErrorCode_UNKNOWN_CHANNEL ErrorCode = 16
+	// 通知已过期
+	ErrorCode_NOTIFICATION_EXPIRED ErrorCode = 17
+	// 通知当前状态不允许该操作
+	ErrorCode_INVALID_NOTIFICATION_STATUS ErrorCode = 18
 )
 
 // Enum value maps for ErrorCode.
@@ -204,25 +216,29 @@ var (
 		14: "QUOTA_NOT_FOUND",
 		15: "PROVIDER_NOT_FOUND",
 		16: "UNKNOWN_CHANNEL",
+		17: "NOTIFICATION_EXPIRED",
+		18: "INVALID_NOTIFICATION_STATUS",
 	}
 	ErrorCode_value = map[string]int32{
-		"ERROR_CODE_UNSPECIFIED":     0,
-		"INVALID_PARAMETER":          1,
-		"RATE_LIMITED":               2,
-		"TEMPLATE_NOT_FOUND":         3,
-		"CHANNEL_DISABLED":           4,
-		"CREATE_NOTIFICATION_FAILED": 5,
-		"BIZ_ID_NOT_FOUND":           6,
-		"NOTIFICATION_NOT_FOUND":     7,
-		"NO_AVAILABLE_PROVIDER":      8,
-		"NO_AVAILABLE_CHANNEL":       9,
-		"SEND_NOTIFICATION_FAILED":   10,
-		"CONFIG_NOT_FOUND":           11,
-		"NO_QUOTA_CONFIG":            12,
-		"NO_QUOTA":                   13,
-		"QUOTA_NOT_FOUND":            14,
-		"PROVIDER_NOT_FOUND":         15,
-		"UNKNOWN_CHANNEL":            16,
+		"ERROR_CODE_UNSPECIFIED":      0,
+		"INVALID_PARAMETER":           1,
+		"RATE_LIMITED":                2,
+		"TEMPLATE_NOT_FOUND":          3,
+		"CHANNEL_DISABLED":            4,
+		"CREATE_NOTIFICATION_FAILED":  5,
+		"BIZ_ID_NOT_FOUND":            6,
+		"NOTIFICATION_NOT_FOUND":      7,
+		"NO_AVAILABLE_PROVIDER":       8,
+		"NO_AVAILABLE_CHANNEL":        9,
+		"SEND_NOTIFICATION_FAILED":    10,
+		"CONFIG_NOT_FOUND":            11,
+		"NO_QUOTA_CONFIG":             12,
+		"NO_QUOTA":                    13,
+		"QUOTA_NOT_FOUND":             14,
+		"PROVIDER_NOT_FOUND":          15,
+		"UNKNOWN_CHANNEL":             16,
+		"NOTIFICATION_EXPIRED":        17,
+		"INVALID_NOTIFICATION_STATUS": 18,
 	}
 )
 
@@ -403,7 +419,9 @@ type Notification struct {
 	// 模版参数
 	TemplateParams map[string]string `protobuf:"bytes,5,rep,name=template_params,json=templateParams,proto3" json:"template_params,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	// 发送策略
-	Strategy      *SendStrategy `protobuf:"bytes,6,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	Strategy *SendStrategy `protobuf:"bytes,6,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	// 降级目标，业务方开启渠道降级后，原渠道发送失败时按照业务配置的渠道优先级依次尝试
+	Fallbacks     []*FallbackTarget `protobuf:"bytes,7,rep,name=fallbacks,proto3" json:"fallbacks,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -480,6 +498,77 @@ func (x *Notification) GetStrategy() *SendStrategy {
 	return nil
 }
 
+func (x *Notification) GetFallbacks() []*FallbackTarget {
+	if x != nil {
+		return x.Fallbacks
+	}
+	return nil
+}
+
+// 降级目标
+type FallbackTarget struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 降级渠道
+	Channel Channel `protobuf:"varint,1,opt,name=channel,proto3,enum=notification.v1.Channel" json:"channel,omitempty"`
+	// 该渠道下的接收者(用户ID/邮箱/手机号等)
+	Receivers []string `protobuf:"bytes,2,rep,name=receivers,proto3" json:"receivers,omitempty"`
+	// 该渠道下的模版ID，模版参数与原通知共用
+	TemplateId    string `protobuf:"bytes,3,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FallbackTarget) Reset() {
+	*x = FallbackTarget{}
+	mi := &file_notification_v1_notification_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FallbackTarget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FallbackTarget) ProtoMessage() {}
+
+func (x *FallbackTarget) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FallbackTarget.ProtoReflect.Descriptor instead.
+func (*FallbackTarget) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *FallbackTarget) GetChannel() Channel {
+	if x != nil {
+		return x.Channel
+	}
+	return Channel_CHANNEL_UNSPECIFIED
+}
+
+func (x *FallbackTarget) GetReceivers() []string {
+	if x != nil {
+		return x.Receivers
+	}
+	return nil
+}
+
+func (x *FallbackTarget) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
 // 同步单条发送通知请求
 type SendNotificationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -490,7 +579,7 @@ type SendNotificationRequest struct {
 
 func (x *SendNotificationRequest) Reset() {
 	*x = SendNotificationRequest{}
-	mi := &file_notification_v1_notification_proto_msgTypes[2]
+	mi := &file_notification_v1_notification_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -502,7 +591,7 @@ func (x *SendNotificationRequest) String() string {
 func (*SendNotificationRequest) ProtoMessage() {}
 
 func (x *SendNotificationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[2]
+	mi := &file_notification_v1_notification_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -515,7 +604,7 @@ func (x *SendNotificationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendNotificationRequest.ProtoReflect.Descriptor instead.
 func (*SendNotificationRequest) Descriptor() ([]byte, []int) {
-	return file_notification_v1_notification_proto_rawDescGZIP(), []int{2}
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *SendNotificationRequest) GetNotification() *Notification {
@@ -535,14 +624,16 @@ type SendNotificationResponse struct {
 	// 失败时的错误代码
 	ErrorCode ErrorCode `protobuf:"varint,3,opt,name=error_code,json=errorCode,proto3,enum=notification.v1.ErrorCode" json:"error_code,omitempty"`
 	// 错误详情
-	ErrorMessage  string `protobuf:"bytes,4,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	ErrorMessage string `protobuf:"bytes,4,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	// 实际送达的渠道，发生渠道降级时与请求中的渠道不同
+	DeliveredChannel Channel `protobuf:"varint,5,opt,name=delivered_channel,json=deliveredChannel,proto3,enum=notification.v1.Channel" json:"delivered_channel,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *SendNotificationResponse) Reset() {
 	*x = SendNotificationResponse{}
-	mi := &file_notification_v1_notification_proto_msgTypes[3]
+	mi := &file_notification_v1_notification_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -554,7 +645,7 @@ func (x *SendNotificationResponse) String() string {
 func (*SendNotificationResponse) ProtoMessage() {}
 
 func (x *SendNotificationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[3]
+	mi := &file_notification_v1_notification_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -567,7 +658,7 @@ func (x *SendNotificationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendNotificationResponse.ProtoReflect.Descriptor instead.
 func (*SendNotificationResponse) Descriptor() ([]byte, []int) {
-	return file_notification_v1_notification_proto_rawDescGZIP(), []int{3}
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *SendNotificationResponse) GetNotificationId() uint64 {
@@ -598,6 +689,13 @@ func (x *SendNotificationResponse) GetErrorMessage() string {
 	return ""
 }
 
+func (x *SendNotificationResponse) GetDeliveredChannel() Channel {
+	if x != nil {
+		return x.DeliveredChannel
+	}
+	return Channel_CHANNEL_UNSPECIFIED
+}
+
 // 异步单条发送通知请求
 type SendNotificationAsyncRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -608,7 +706,7 @@ type SendNotificationAsyncRequest struct {
 
 func (x *SendNotificationAsyncRequest) Reset() {
 	*x = SendNotificationAsyncRequest{}
-	mi := &file_notification_v1_notification_proto_msgTypes[4]
+	mi := &file_notification_v1_notification_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -620,7 +718,7 @@ func (x *SendNotificationAsyncRequest) String() string {
 func (*SendNotificationAsyncRequest) ProtoMessage() {}
 
 func (x *SendNotificationAsyncRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[4]
+	mi := &file_notification_v1_notification_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -633,7 +731,7 @@ func (x *SendNotificationAsyncRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendNotificationAsyncRequest.ProtoReflect.Descriptor instead.
 func (*SendNotificationAsyncRequest) Descriptor() ([]byte, []int) {
-	return file_notification_v1_notification_proto_rawDescGZIP(), []int{4}
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *SendNotificationAsyncRequest) GetNotification() *Notification {
@@ -658,7 +756,7 @@ type SendNotificationAsyncResponse struct {
 
 func (x *SendNotificationAsyncResponse) Reset() {
 	*x = SendNotificationAsyncResponse{}
-	mi := &file_notification_v1_notification_proto_msgTypes[5]
+	mi := &file_notification_v1_notification_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -670,7 +768,7 @@ func (x *SendNotificationAsyncResponse) String() string {
 func (*SendNotificationAsyncResponse) ProtoMessage() {}
 
 func (x *SendNotificationAsyncResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[5]
+	mi := &file_notification_v1_notification_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -683,7 +781,7 @@ func (x *SendNotificationAsyncResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendNotificationAsyncResponse.ProtoReflect.Descriptor instead.
 func (*SendNotificationAsyncResponse) Descriptor() ([]byte, []int) {
-	return file_notification_v1_notification_proto_rawDescGZIP(), []int{5}
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *SendNotificationAsyncResponse) GetNotificationId() uint64 {
@@ -717,7 +815,7 @@ type BatchSendNotificationsRequest struct {
 
 func (x *BatchSendNotificationsRequest) Reset() {
 	*x = BatchSendNotificationsRequest{}
-	mi := &file_notification_v1_notification_proto_msgTypes[6]
+	mi := &file_notification_v1_notification_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -729,7 +827,7 @@ func (x *BatchSendNotificationsRequest) String() string {
 func (*BatchSendNotificationsRequest) ProtoMessage() {}
 
 func (x *BatchSendNotificationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[6]
+	mi := &file_notification_v1_notification_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -742,7 +840,7 @@ func (x *BatchSendNotificationsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchSendNotificationsRequest.ProtoReflect.Descriptor instead.
 func (*BatchSendNotificationsRequest) Descriptor() ([]byte, []int) {
-	return file_notification_v1_notification_proto_rawDescGZIP(), []int{6}
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *BatchSendNotificationsRequest) GetNotifications() []*Notification {
@@ -767,7 +865,7 @@ type BatchSendNotificationsResponse struct {
 
 func (x *BatchSendNotificationsResponse) Reset() {
 	*x = BatchSendNotificationsResponse{}
-	mi := &file_notification_v1_notification_proto_msgTypes[7]
+	mi := &file_notification_v1_notification_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -779,7 +877,7 @@ func (x *BatchSendNotificationsResponse) String() string {
 func (*BatchSendNotificationsResponse) ProtoMessage() {}
 
 func (x *BatchSendNotificationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[7]
+	mi := &file_notification_v1_notification_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -792,7 +890,7 @@ func (x *BatchSendNotificationsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchSendNotificationsResponse.ProtoReflect.Descriptor instead.
 func (*BatchSendNotificationsResponse) Descriptor() ([]byte, []int) {
-	return file_notification_v1_notification_proto_rawDescGZIP(), []int{7}
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *BatchSendNotificationsResponse) GetResults() []*SendNotificationResponse {
@@ -826,7 +924,7 @@ type BatchSendNotificationsAsyncRequest struct {
 
 func (x *BatchSendNotificationsAsyncRequest) Reset() {
 	*x = BatchSendNotificationsAsyncRequest{}
-	mi := &file_notification_v1_notification_proto_msgTypes[8]
+	mi := &file_notification_v1_notification_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -838,7 +936,7 @@ func (x *BatchSendNotificationsAsyncRequest) String() string {
 func (*BatchSendNotificationsAsyncRequest) ProtoMessage() {}
 
 func (x *BatchSendNotificationsAsyncRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[8]
+	mi := &file_notification_v1_notification_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -851,7 +949,7 @@ func (x *BatchSendNotificationsAsyncRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use BatchSendNotificationsAsyncRequest.ProtoReflect.Descriptor instead.
 func (*BatchSendNotificationsAsyncRequest) Descriptor() ([]byte, []int) {
-	return file_notification_v1_notification_proto_rawDescGZIP(), []int{8}
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *BatchSendNotificationsAsyncRequest) GetNotifications() []*Notification {
@@ -872,7 +970,7 @@ type BatchSendNotificationsAsyncResponse struct {
 
 func (x *BatchSendNotificationsAsyncResponse) Reset() {
 	*x = BatchSendNotificationsAsyncResponse{}
-	mi := &file_notification_v1_notification_proto_msgTypes[9]
+	mi := &file_notification_v1_notification_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -884,7 +982,7 @@ func (x *BatchSendNotificationsAsyncResponse) String() string {
 func (*BatchSendNotificationsAsyncResponse) ProtoMessage() {}
 
 func (x *BatchSendNotificationsAsyncResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[9]
+	mi := &file_notification_v1_notification_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -897,7 +995,7 @@ func (x *BatchSendNotificationsAsyncResponse) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use BatchSendNotificationsAsyncResponse.ProtoReflect.Descriptor instead.
 func (*BatchSendNotificationsAsyncResponse) Descriptor() ([]byte, []int) {
-	return file_notification_v1_notification_proto_rawDescGZIP(), []int{9}
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *BatchSendNotificationsAsyncResponse) GetNotificationIds() []uint64 {
@@ -917,7 +1015,7 @@ type TxPrepareRequest struct {
 
 func (x *TxPrepareRequest) Reset() {
 	*x = TxPrepareRequest{}
-	mi := &file_notification_v1_notification_proto_msgTypes[10]
+	mi := &file_notification_v1_notification_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -929,7 +1027,7 @@ func (x *TxPrepareRequest) String() string {
 func (*TxPrepareRequest) ProtoMessage() {}
 
 func (x *TxPrepareRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[10]
+	mi := &file_notification_v1_notification_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -942,7 +1040,7 @@ func (x *TxPrepareRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TxPrepareRequest.ProtoReflect.Descriptor instead.
 func (*TxPrepareRequest) Descriptor() ([]byte, []int) {
-	return file_notification_v1_notification_proto_rawDescGZIP(), []int{10}
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *TxPrepareRequest) GetNotification() *Notification {
@@ -961,7 +1059,7 @@ type TxPrepareResponse struct {
 
 func (x *TxPrepareResponse) Reset() {
 	*x = TxPrepareResponse{}
-	mi := &file_notification_v1_notification_proto_msgTypes[11]
+	mi := &file_notification_v1_notification_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -973,7 +1071,7 @@ func (x *TxPrepareResponse) String() string {
 func (*TxPrepareResponse) ProtoMessage() {}
 
 func (x *TxPrepareResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[11]
+	mi := &file_notification_v1_notification_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -986,7 +1084,7 @@ func (x *TxPrepareResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TxPrepareResponse.ProtoReflect.Descriptor instead.
 func (*TxPrepareResponse) Descriptor() ([]byte, []int) {
-	return file_notification_v1_notification_proto_rawDescGZIP(), []int{11}
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{12}
 }
 
 // 提交事务请求
@@ -1000,7 +1098,7 @@ type TxCommitRequest struct {
 
 func (x *TxCommitRequest) Reset() {
 	*x = TxCommitRequest{}
-	mi := &file_notification_v1_notification_proto_msgTypes[12]
+	mi := &file_notification_v1_notification_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1012,7 +1110,7 @@ func (x *TxCommitRequest) String() string {
 func (*TxCommitRequest) ProtoMessage() {}
 
 func (x *TxCommitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[12]
+	mi := &file_notification_v1_notification_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1025,7 +1123,7 @@ func (x *TxCommitRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TxCommitRequest.ProtoReflect.Descriptor instead.
 func (*TxCommitRequest) Descriptor() ([]byte, []int) {
-	return file_notification_v1_notification_proto_rawDescGZIP(), []int{12}
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *TxCommitRequest) GetKey() string {
@@ -1044,7 +1142,7 @@ type TxCommitResponse struct {
 
 func (x *TxCommitResponse) Reset() {
 	*x = TxCommitResponse{}
-	mi := &file_notification_v1_notification_proto_msgTypes[13]
+	mi := &file_notification_v1_notification_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1056,7 +1154,7 @@ func (x *TxCommitResponse) String() string {
 func (*TxCommitResponse) ProtoMessage() {}
 
 func (x *TxCommitResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[13]
+	mi := &file_notification_v1_notification_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1069,7 +1167,7 @@ func (x *TxCommitResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TxCommitResponse.ProtoReflect.Descriptor instead.
 func (*TxCommitResponse) Descriptor() ([]byte, []int) {
-	return file_notification_v1_notification_proto_rawDescGZIP(), []int{13}
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{14}
 }
 
 // 回滚事务请求
@@ -1083,7 +1181,7 @@ type TxCancelRequest struct {
 
 func (x *TxCancelRequest) Reset() {
 	*x = TxCancelRequest{}
-	mi := &file_notification_v1_notification_proto_msgTypes[14]
+	mi := &file_notification_v1_notification_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1095,7 +1193,7 @@ func (x *TxCancelRequest) String() string {
 func (*TxCancelRequest) ProtoMessage() {}
 
 func (x *TxCancelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[14]
+	mi := &file_notification_v1_notification_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1108,7 +1206,7 @@ func (x *TxCancelRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TxCancelRequest.ProtoReflect.Descriptor instead.
 func (*TxCancelRequest) Descriptor() ([]byte, []int) {
-	return file_notification_v1_notification_proto_rawDescGZIP(), []int{14}
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *TxCancelRequest) GetKey() string {
@@ -1127,7 +1225,7 @@ type TxCancelResponse struct {
 
 func (x *TxCancelResponse) Reset() {
 	*x = TxCancelResponse{}
-	mi := &file_notification_v1_notification_proto_msgTypes[15]
+	mi := &file_notification_v1_notification_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1139,7 +1237,7 @@ func (x *TxCancelResponse) String() string {
 func (*TxCancelResponse) ProtoMessage() {}
 
 func (x *TxCancelResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[15]
+	mi := &file_notification_v1_notification_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1152,31 +1250,33 @@ func (x *TxCancelResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TxCancelResponse.ProtoReflect.Descriptor instead.
 func (*TxCancelResponse) Descriptor() ([]byte, []int) {
-	return file_notification_v1_notification_proto_rawDescGZIP(), []int{15}
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{16}
 }
 
-// 空结构表示立即发送
-type SendStrategy_ImmediateStrategy struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+// 取消通知请求
+type CancelNotificationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 业务方某个业务内部的唯一标识
+	Key           string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SendStrategy_ImmediateStrategy) Reset() {
-	*x = SendStrategy_ImmediateStrategy{}
-	mi := &file_notification_v1_notification_proto_msgTypes[16]
+func (x *CancelNotificationRequest) Reset() {
+	*x = CancelNotificationRequest{}
+	mi := &file_notification_v1_notification_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SendStrategy_ImmediateStrategy) String() string {
+func (x *CancelNotificationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendStrategy_ImmediateStrategy) ProtoMessage() {}
+func (*CancelNotificationRequest) ProtoMessage() {}
 
-func (x *SendStrategy_ImmediateStrategy) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[16]
+func (x *CancelNotificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1187,34 +1287,48 @@ func (x *SendStrategy_ImmediateStrategy) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendStrategy_ImmediateStrategy.ProtoReflect.Descriptor instead.
-func (*SendStrategy_ImmediateStrategy) Descriptor() ([]byte, []int) {
-	return file_notification_v1_notification_proto_rawDescGZIP(), []int{0, 0}
+// Deprecated: Use CancelNotificationRequest.ProtoReflect.Descriptor instead.
+func (*CancelNotificationRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{17}
 }
 
-type SendStrategy_DelayedStrategy struct {
+func (x *CancelNotificationRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+// 取消通知响应
+type CancelNotificationResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// 延迟秒数
-	DelaySeconds  int64 `protobuf:"varint,1,opt,name=delay_seconds,json=delaySeconds,proto3" json:"delay_seconds,omitempty"`
+	// 通知平台生成的通知ID
+	NotificationId uint64 `protobuf:"varint,1,opt,name=notification_id,json=notificationId,proto3" json:"notification_id,omitempty"`
+	// 通知的当前状态，取消成功时为 CANCELED
+	Status SendStatus `protobuf:"varint,2,opt,name=status,proto3,enum=notification.v1.SendStatus" json:"status,omitempty"`
+	// 失败时的错误代码
+	ErrorCode ErrorCode `protobuf:"varint,3,opt,name=error_code,json=errorCode,proto3,enum=notification.v1.ErrorCode" json:"error_code,omitempty"`
+	// 错误详情
+	ErrorMessage  string `protobuf:"bytes,4,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SendStrategy_DelayedStrategy) Reset() {
-	*x = SendStrategy_DelayedStrategy{}
-	mi := &file_notification_v1_notification_proto_msgTypes[17]
+func (x *CancelNotificationResponse) Reset() {
+	*x = CancelNotificationResponse{}
+	mi := &file_notification_v1_notification_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SendStrategy_DelayedStrategy) String() string {
+func (x *CancelNotificationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendStrategy_DelayedStrategy) ProtoMessage() {}
+func (*CancelNotificationResponse) ProtoMessage() {}
 
-func (x *SendStrategy_DelayedStrategy) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[17]
+func (x *CancelNotificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1225,87 +1339,66 @@ func (x *SendStrategy_DelayedStrategy) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendStrategy_DelayedStrategy.ProtoReflect.Descriptor instead.
-func (*SendStrategy_DelayedStrategy) Descriptor() ([]byte, []int) {
-	return file_notification_v1_notification_proto_rawDescGZIP(), []int{0, 1}
+// Deprecated: Use CancelNotificationResponse.ProtoReflect.Descriptor instead.
+func (*CancelNotificationResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *SendStrategy_DelayedStrategy) GetDelaySeconds() int64 {
+func (x *CancelNotificationResponse) GetNotificationId() uint64 {
 	if x != nil {
-		return x.DelaySeconds
+		return x.NotificationId
 	}
 	return 0
 }
 
-type SendStrategy_ScheduledStrategy struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// 具体发送时间
-	SendTime      *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=send_time,json=sendTime,proto3" json:"send_time,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *SendStrategy_ScheduledStrategy) Reset() {
-	*x = SendStrategy_ScheduledStrategy{}
-	mi := &file_notification_v1_notification_proto_msgTypes[18]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *SendStrategy_ScheduledStrategy) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*SendStrategy_ScheduledStrategy) ProtoMessage() {}
-
-func (x *SendStrategy_ScheduledStrategy) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[18]
+func (x *CancelNotificationResponse) GetStatus() SendStatus {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Status
 	}
-	return mi.MessageOf(x)
+	return SendStatus_SEND_STATUS_UNSPECIFIED
 }
 
-// Deprecated: Use SendStrategy_ScheduledStrategy.ProtoReflect.Descriptor instead.
-func (*SendStrategy_ScheduledStrategy) Descriptor() ([]byte, []int) {
-	return file_notification_v1_notification_proto_rawDescGZIP(), []int{0, 2}
+func (x *CancelNotificationResponse) GetErrorCode() ErrorCode {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ErrorCode_ERROR_CODE_UNSPECIFIED
 }
 
-func (x *SendStrategy_ScheduledStrategy) GetSendTime() *timestamppb.Timestamp {
+func (x *CancelNotificationResponse) GetErrorMessage() string {
 	if x != nil {
-		return x.SendTime
+		return x.ErrorMessage
 	}
-	return nil
+	return ""
 }
 
-type SendStrategy_TimeWindowStrategy struct {
+// 修改等待发送的通知请求，发送策略和模版参数至少指定一个
+type UpdatePendingNotificationRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// 开始时间
-	StartTimeMilliseconds int64 `protobuf:"varint,1,opt,name=start_time_milliseconds,json=startTimeMilliseconds,proto3" json:"start_time_milliseconds,omitempty"`
-	// 结束时间
-	EndTimeMilliseconds int64 `protobuf:"varint,2,opt,name=end_time_milliseconds,json=endTimeMilliseconds,proto3" json:"end_time_milliseconds,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+	// 业务方某个业务内部的唯一标识
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// 新的发送策略，不指定时保持原有的计划发送时间
+	Strategy *SendStrategy `protobuf:"bytes,2,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	// 新的模版参数，不指定时保持原有的模版参数，指定时整体替换
+	TemplateParams map[string]string `protobuf:"bytes,3,rep,name=template_params,json=templateParams,proto3" json:"template_params,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *SendStrategy_TimeWindowStrategy) Reset() {
-	*x = SendStrategy_TimeWindowStrategy{}
+func (x *UpdatePendingNotificationRequest) Reset() {
+	*x = UpdatePendingNotificationRequest{}
 	mi := &file_notification_v1_notification_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SendStrategy_TimeWindowStrategy) String() string {
+func (x *UpdatePendingNotificationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendStrategy_TimeWindowStrategy) ProtoMessage() {}
+func (*UpdatePendingNotificationRequest) ProtoMessage() {}
 
-func (x *SendStrategy_TimeWindowStrategy) ProtoReflect() protoreflect.Message {
+func (x *UpdatePendingNotificationRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_notification_v1_notification_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1317,19 +1410,646 @@ func (x *SendStrategy_TimeWindowStrategy) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendStrategy_TimeWindowStrategy.ProtoReflect.Descriptor instead.
-func (*SendStrategy_TimeWindowStrategy) Descriptor() ([]byte, []int) {
-	return file_notification_v1_notification_proto_rawDescGZIP(), []int{0, 3}
+// Deprecated: Use UpdatePendingNotificationRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePendingNotificationRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *SendStrategy_TimeWindowStrategy) GetStartTimeMilliseconds() int64 {
+func (x *UpdatePendingNotificationRequest) GetKey() string {
 	if x != nil {
-		return x.StartTimeMilliseconds
+		return x.Key
 	}
-	return 0
+	return ""
 }
 
-func (x *SendStrategy_TimeWindowStrategy) GetEndTimeMilliseconds() int64 {
+func (x *UpdatePendingNotificationRequest) GetStrategy() *SendStrategy {
+	if x != nil {
+		return x.Strategy
+	}
+	return nil
+}
+
+func (x *UpdatePendingNotificationRequest) GetTemplateParams() map[string]string {
+	if x != nil {
+		return x.TemplateParams
+	}
+	return nil
+}
+
+// 修改等待发送的通知响应
+type UpdatePendingNotificationResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 通知平台生成的通知ID
+	NotificationId uint64 `protobuf:"varint,1,opt,name=notification_id,json=notificationId,proto3" json:"notification_id,omitempty"`
+	// 通知的当前状态
+	Status SendStatus `protobuf:"varint,2,opt,name=status,proto3,enum=notification.v1.SendStatus" json:"status,omitempty"`
+	// 失败时的错误代码
+	ErrorCode ErrorCode `protobuf:"varint,3,opt,name=error_code,json=errorCode,proto3,enum=notification.v1.ErrorCode" json:"error_code,omitempty"`
+	// 错误详情
+	ErrorMessage  string `protobuf:"bytes,4,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePendingNotificationResponse) Reset() {
+	*x = UpdatePendingNotificationResponse{}
+	mi := &file_notification_v1_notification_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePendingNotificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePendingNotificationResponse) ProtoMessage() {}
+
+func (x *UpdatePendingNotificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePendingNotificationResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePendingNotificationResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UpdatePendingNotificationResponse) GetNotificationId() uint64 {
+	if x != nil {
+		return x.NotificationId
+	}
+	return 0
+}
+
+func (x *UpdatePendingNotificationResponse) GetStatus() SendStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SendStatus_SEND_STATUS_UNSPECIFIED
+}
+
+func (x *UpdatePendingNotificationResponse) GetErrorCode() ErrorCode {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ErrorCode_ERROR_CODE_UNSPECIFIED
+}
+
+func (x *UpdatePendingNotificationResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+// 重新发送通知请求
+type ResendNotificationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 业务方某个业务内部的唯一标识
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// 是否同步立刻发送，否则等待调度器发送
+	SendImmediately bool `protobuf:"varint,2,opt,name=send_immediately,json=sendImmediately,proto3" json:"send_immediately,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ResendNotificationRequest) Reset() {
+	*x = ResendNotificationRequest{}
+	mi := &file_notification_v1_notification_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResendNotificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResendNotificationRequest) ProtoMessage() {}
+
+func (x *ResendNotificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResendNotificationRequest.ProtoReflect.Descriptor instead.
+func (*ResendNotificationRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ResendNotificationRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ResendNotificationRequest) GetSendImmediately() bool {
+	if x != nil {
+		return x.SendImmediately
+	}
+	return false
+}
+
+// 重新发送通知响应
+type ResendNotificationResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Result        *SendNotificationResponse `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResendNotificationResponse) Reset() {
+	*x = ResendNotificationResponse{}
+	mi := &file_notification_v1_notification_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResendNotificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResendNotificationResponse) ProtoMessage() {}
+
+func (x *ResendNotificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResendNotificationResponse.ProtoReflect.Descriptor instead.
+func (*ResendNotificationResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ResendNotificationResponse) GetResult() *SendNotificationResponse {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+// 流式异步发送通知请求，每个请求一条通知
+type StreamSendNotificationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notification  *Notification          `protobuf:"bytes,1,opt,name=notification,proto3" json:"notification,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamSendNotificationsRequest) Reset() {
+	*x = StreamSendNotificationsRequest{}
+	mi := &file_notification_v1_notification_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamSendNotificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamSendNotificationsRequest) ProtoMessage() {}
+
+func (x *StreamSendNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamSendNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*StreamSendNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *StreamSendNotificationsRequest) GetNotification() *Notification {
+	if x != nil {
+		return x.Notification
+	}
+	return nil
+}
+
+// 流式异步发送通知响应
+// 单个流最多接收 50000 条通知，达到上限之后服务端提前结束流并返回已经接收的通知的结果，
+// 客户端根据 total_count 在新的流中发送剩余的通知
+type StreamSendNotificationsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 每条通知的结果，按照接收顺序排列
+	Results []*StreamSendResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// 总数
+	TotalCount int32 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	// 成功数
+	SuccessCount int32 `protobuf:"varint,3,opt,name=success_count,json=successCount,proto3" json:"success_count,omitempty"`
+	// 按照错误代码汇总的失败数
+	Errors        []*StreamSendErrorSummary `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamSendNotificationsResponse) Reset() {
+	*x = StreamSendNotificationsResponse{}
+	mi := &file_notification_v1_notification_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamSendNotificationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamSendNotificationsResponse) ProtoMessage() {}
+
+func (x *StreamSendNotificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamSendNotificationsResponse.ProtoReflect.Descriptor instead.
+func (*StreamSendNotificationsResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *StreamSendNotificationsResponse) GetResults() []*StreamSendResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *StreamSendNotificationsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *StreamSendNotificationsResponse) GetSuccessCount() int32 {
+	if x != nil {
+		return x.SuccessCount
+	}
+	return 0
+}
+
+func (x *StreamSendNotificationsResponse) GetErrors() []*StreamSendErrorSummary {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+// 流式发送中单条通知的结果
+type StreamSendResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 通知在流中的位置，从 0 开始
+	Index int64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// 业务方某个业务内部唯一标识，通知不合法时可能为空
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// 通知ID，发送失败时为 0
+	NotificationId uint64 `protobuf:"varint,3,opt,name=notification_id,json=notificationId,proto3" json:"notification_id,omitempty"`
+	// 发送状态
+	Status SendStatus `protobuf:"varint,4,opt,name=status,proto3,enum=notification.v1.SendStatus" json:"status,omitempty"`
+	// 错误代码
+	ErrorCode     ErrorCode `protobuf:"varint,5,opt,name=error_code,json=errorCode,proto3,enum=notification.v1.ErrorCode" json:"error_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamSendResult) Reset() {
+	*x = StreamSendResult{}
+	mi := &file_notification_v1_notification_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamSendResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamSendResult) ProtoMessage() {}
+
+func (x *StreamSendResult) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamSendResult.ProtoReflect.Descriptor instead.
+func (*StreamSendResult) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *StreamSendResult) GetIndex() int64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *StreamSendResult) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *StreamSendResult) GetNotificationId() uint64 {
+	if x != nil {
+		return x.NotificationId
+	}
+	return 0
+}
+
+func (x *StreamSendResult) GetStatus() SendStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SendStatus_SEND_STATUS_UNSPECIFIED
+}
+
+func (x *StreamSendResult) GetErrorCode() ErrorCode {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ErrorCode_ERROR_CODE_UNSPECIFIED
+}
+
+// 流式发送中同一个错误代码的失败汇总
+type StreamSendErrorSummary struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 错误代码
+	ErrorCode ErrorCode `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3,enum=notification.v1.ErrorCode" json:"error_code,omitempty"`
+	// 失败数
+	Count int32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	// 第一条失败的通知的错误详情
+	ErrorMessage  string `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamSendErrorSummary) Reset() {
+	*x = StreamSendErrorSummary{}
+	mi := &file_notification_v1_notification_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamSendErrorSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamSendErrorSummary) ProtoMessage() {}
+
+func (x *StreamSendErrorSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamSendErrorSummary.ProtoReflect.Descriptor instead.
+func (*StreamSendErrorSummary) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *StreamSendErrorSummary) GetErrorCode() ErrorCode {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ErrorCode_ERROR_CODE_UNSPECIFIED
+}
+
+func (x *StreamSendErrorSummary) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *StreamSendErrorSummary) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+// 空结构表示立即发送
+type SendStrategy_ImmediateStrategy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendStrategy_ImmediateStrategy) Reset() {
+	*x = SendStrategy_ImmediateStrategy{}
+	mi := &file_notification_v1_notification_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendStrategy_ImmediateStrategy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendStrategy_ImmediateStrategy) ProtoMessage() {}
+
+func (x *SendStrategy_ImmediateStrategy) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendStrategy_ImmediateStrategy.ProtoReflect.Descriptor instead.
+func (*SendStrategy_ImmediateStrategy) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type SendStrategy_DelayedStrategy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 延迟秒数
+	DelaySeconds  int64 `protobuf:"varint,1,opt,name=delay_seconds,json=delaySeconds,proto3" json:"delay_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendStrategy_DelayedStrategy) Reset() {
+	*x = SendStrategy_DelayedStrategy{}
+	mi := &file_notification_v1_notification_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendStrategy_DelayedStrategy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendStrategy_DelayedStrategy) ProtoMessage() {}
+
+func (x *SendStrategy_DelayedStrategy) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendStrategy_DelayedStrategy.ProtoReflect.Descriptor instead.
+func (*SendStrategy_DelayedStrategy) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *SendStrategy_DelayedStrategy) GetDelaySeconds() int64 {
+	if x != nil {
+		return x.DelaySeconds
+	}
+	return 0
+}
+
+type SendStrategy_ScheduledStrategy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 具体发送时间
+	SendTime      *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=send_time,json=sendTime,proto3" json:"send_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendStrategy_ScheduledStrategy) Reset() {
+	*x = SendStrategy_ScheduledStrategy{}
+	mi := &file_notification_v1_notification_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendStrategy_ScheduledStrategy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendStrategy_ScheduledStrategy) ProtoMessage() {}
+
+func (x *SendStrategy_ScheduledStrategy) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendStrategy_ScheduledStrategy.ProtoReflect.Descriptor instead.
+func (*SendStrategy_ScheduledStrategy) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{0, 2}
+}
+
+func (x *SendStrategy_ScheduledStrategy) GetSendTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SendTime
+	}
+	return nil
+}
+
+type SendStrategy_TimeWindowStrategy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 开始时间
+	StartTimeMilliseconds int64 `protobuf:"varint,1,opt,name=start_time_milliseconds,json=startTimeMilliseconds,proto3" json:"start_time_milliseconds,omitempty"`
+	// 结束时间
+	EndTimeMilliseconds int64 `protobuf:"varint,2,opt,name=end_time_milliseconds,json=endTimeMilliseconds,proto3" json:"end_time_milliseconds,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *SendStrategy_TimeWindowStrategy) Reset() {
+	*x = SendStrategy_TimeWindowStrategy{}
+	mi := &file_notification_v1_notification_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendStrategy_TimeWindowStrategy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendStrategy_TimeWindowStrategy) ProtoMessage() {}
+
+func (x *SendStrategy_TimeWindowStrategy) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendStrategy_TimeWindowStrategy.ProtoReflect.Descriptor instead.
+func (*SendStrategy_TimeWindowStrategy) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{0, 3}
+}
+
+func (x *SendStrategy_TimeWindowStrategy) GetStartTimeMilliseconds() int64 {
+	if x != nil {
+		return x.StartTimeMilliseconds
+	}
+	return 0
+}
+
+func (x *SendStrategy_TimeWindowStrategy) GetEndTimeMilliseconds() int64 {
 	if x != nil {
 		return x.EndTimeMilliseconds
 	}
@@ -1346,7 +2066,7 @@ type SendStrategy_DeadlineStrategy struct {
 
 func (x *SendStrategy_DeadlineStrategy) Reset() {
 	*x = SendStrategy_DeadlineStrategy{}
-	mi := &file_notification_v1_notification_proto_msgTypes[20]
+	mi := &file_notification_v1_notification_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1358,7 +2078,7 @@ func (x *SendStrategy_DeadlineStrategy) String() string {
 func (*SendStrategy_DeadlineStrategy) ProtoMessage() {}
 
 func (x *SendStrategy_DeadlineStrategy) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[20]
+	mi := &file_notification_v1_notification_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1403,7 +2123,7 @@ const file_notification_v1_notification_proto_rawDesc = "" +
 	"\x15end_time_milliseconds\x18\x02 \x01(\x03R\x13endTimeMilliseconds\x1aJ\n" +
 	"\x10DeadlineStrategy\x126\n" +
 	"\bdeadline\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\bdeadlineB\x0f\n" +
-	"\rstrategy_type\"\xed\x02\n" +
+	"\rstrategy_type\"\xac\x03\n" +
 	"\fNotification\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x1c\n" +
 	"\treceivers\x18\x02 \x03(\tR\treceivers\x122\n" +
@@ -1411,18 +2131,25 @@ const file_notification_v1_notification_proto_rawDesc = "" +
 	"\vtemplate_id\x18\x04 \x01(\tR\n" +
 	"templateId\x12Z\n" +
 	"\x0ftemplate_params\x18\x05 \x03(\v21.notification.v1.Notification.TemplateParamsEntryR\x0etemplateParams\x129\n" +
-	"\bstrategy\x18\x06 \x01(\v2\x1d.notification.v1.SendStrategyR\bstrategy\x1aA\n" +
+	"\bstrategy\x18\x06 \x01(\v2\x1d.notification.v1.SendStrategyR\bstrategy\x12=\n" +
+	"\tfallbacks\x18\a \x03(\v2\x1f.notification.v1.FallbackTargetR\tfallbacks\x1aA\n" +
 	"\x13TemplateParamsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\\\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x83\x01\n" +
+	"\x0eFallbackTarget\x122\n" +
+	"\achannel\x18\x01 \x01(\x0e2\x18.notification.v1.ChannelR\achannel\x12\x1c\n" +
+	"\treceivers\x18\x02 \x03(\tR\treceivers\x12\x1f\n" +
+	"\vtemplate_id\x18\x03 \x01(\tR\n" +
+	"templateId\"\\\n" +
 	"\x17SendNotificationRequest\x12A\n" +
-	"\fnotification\x18\x01 \x01(\v2\x1d.notification.v1.NotificationR\fnotification\"\xd8\x01\n" +
+	"\fnotification\x18\x01 \x01(\v2\x1d.notification.v1.NotificationR\fnotification\"\x9f\x02\n" +
 	"\x18SendNotificationResponse\x12'\n" +
 	"\x0fnotification_id\x18\x01 \x01(\x04R\x0enotificationId\x123\n" +
 	"\x06status\x18\x02 \x01(\x0e2\x1b.notification.v1.SendStatusR\x06status\x129\n" +
 	"\n" +
 	"error_code\x18\x03 \x01(\x0e2\x1a.notification.v1.ErrorCodeR\terrorCode\x12#\n" +
-	"\rerror_message\x18\x04 \x01(\tR\ferrorMessage\"a\n" +
+	"\rerror_message\x18\x04 \x01(\tR\ferrorMessage\x12E\n" +
+	"\x11delivered_channel\x18\x05 \x01(\x0e2\x18.notification.v1.ChannelR\x10deliveredChannel\"a\n" +
 	"\x1cSendNotificationAsyncRequest\x12A\n" +
 	"\fnotification\x18\x01 \x01(\v2\x1d.notification.v1.NotificationR\fnotification\"\xa8\x01\n" +
 	"\x1dSendNotificationAsyncResponse\x12'\n" +
@@ -1449,13 +2176,59 @@ const file_notification_v1_notification_proto_rawDesc = "" +
 	"\x10TxCommitResponse\"#\n" +
 	"\x0fTxCancelRequest\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\"\x12\n" +
-	"\x10TxCancelResponse*B\n" +
+	"\x10TxCancelResponse\"-\n" +
+	"\x19CancelNotificationRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\"\xda\x01\n" +
+	"\x1aCancelNotificationResponse\x12'\n" +
+	"\x0fnotification_id\x18\x01 \x01(\x04R\x0enotificationId\x123\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1b.notification.v1.SendStatusR\x06status\x129\n" +
+	"\n" +
+	"error_code\x18\x03 \x01(\x0e2\x1a.notification.v1.ErrorCodeR\terrorCode\x12#\n" +
+	"\rerror_message\x18\x04 \x01(\tR\ferrorMessage\"\xa2\x02\n" +
+	" UpdatePendingNotificationRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x129\n" +
+	"\bstrategy\x18\x02 \x01(\v2\x1d.notification.v1.SendStrategyR\bstrategy\x12n\n" +
+	"\x0ftemplate_params\x18\x03 \x03(\v2E.notification.v1.UpdatePendingNotificationRequest.TemplateParamsEntryR\x0etemplateParams\x1aA\n" +
+	"\x13TemplateParamsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xe1\x01\n" +
+	"!UpdatePendingNotificationResponse\x12'\n" +
+	"\x0fnotification_id\x18\x01 \x01(\x04R\x0enotificationId\x123\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1b.notification.v1.SendStatusR\x06status\x129\n" +
+	"\n" +
+	"error_code\x18\x03 \x01(\x0e2\x1a.notification.v1.ErrorCodeR\terrorCode\x12#\n" +
+	"\rerror_message\x18\x04 \x01(\tR\ferrorMessage\"X\n" +
+	"\x19ResendNotificationRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12)\n" +
+	"\x10send_immediately\x18\x02 \x01(\bR\x0fsendImmediately\"_\n" +
+	"\x1aResendNotificationResponse\x12A\n" +
+	"\x06result\x18\x01 \x01(\v2).notification.v1.SendNotificationResponseR\x06result\"c\n" +
+	"\x1eStreamSendNotificationsRequest\x12A\n" +
+	"\fnotification\x18\x01 \x01(\v2\x1d.notification.v1.NotificationR\fnotification\"\xe5\x01\n" +
+	"\x1fStreamSendNotificationsResponse\x12;\n" +
+	"\aresults\x18\x01 \x03(\v2!.notification.v1.StreamSendResultR\aresults\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\x12#\n" +
+	"\rsuccess_count\x18\x03 \x01(\x05R\fsuccessCount\x12?\n" +
+	"\x06errors\x18\x04 \x03(\v2'.notification.v1.StreamSendErrorSummaryR\x06errors\"\xd3\x01\n" +
+	"\x10StreamSendResult\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x03R\x05index\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12'\n" +
+	"\x0fnotification_id\x18\x03 \x01(\x04R\x0enotificationId\x123\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x1b.notification.v1.SendStatusR\x06status\x129\n" +
+	"\n" +
+	"error_code\x18\x05 \x01(\x0e2\x1a.notification.v1.ErrorCodeR\terrorCode\"\x8e\x01\n" +
+	"\x16StreamSendErrorSummary\x129\n" +
+	"\n" +
+	"error_code\x18\x01 \x01(\x0e2\x1a.notification.v1.ErrorCodeR\terrorCode\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\x12#\n" +
+	"\rerror_message\x18\x03 \x01(\tR\ferrorMessage*B\n" +
 	"\aChannel\x12\x17\n" +
 	"\x13CHANNEL_UNSPECIFIED\x10\x00\x12\a\n" +
 	"\x03SMS\x10\x01\x12\t\n" +
 	"\x05EMAIL\x10\x02\x12\n" +
 	"\n" +
-	"\x06IN_APP\x10\x03*l\n" +
+	"\x06IN_APP\x10\x03*\x86\x01\n" +
 	"\n" +
 	"SendStatus\x12\x1b\n" +
 	"\x17SEND_STATUS_UNSPECIFIED\x10\x00\x12\v\n" +
@@ -1464,7 +2237,9 @@ const file_notification_v1_notification_proto_rawDesc = "" +
 	"\aPENDING\x10\x03\x12\r\n" +
 	"\tSUCCEEDED\x10\x04\x12\n" +
 	"\n" +
-	"\x06FAILED\x10\x05*\x9e\x03\n" +
+	"\x06FAILED\x10\x05\x12\v\n" +
+	"\aSENDING\x10\x06\x12\v\n" +
+	"\aEXPIRED\x10\a*\xd9\x03\n" +
 	"\tErrorCode\x12\x1a\n" +
 	"\x16ERROR_CODE_UNSPECIFIED\x10\x00\x12\x15\n" +
 	"\x11INVALID_PARAMETER\x10\x01\x12\x10\n" +
@@ -1483,15 +2258,21 @@ const file_notification_v1_notification_proto_rawDesc = "" +
 	"\bNO_QUOTA\x10\r\x12\x13\n" +
 	"\x0fQUOTA_NOT_FOUND\x10\x0e\x12\x16\n" +
 	"\x12PROVIDER_NOT_FOUND\x10\x0f\x12\x13\n" +
-	"\x0fUNKNOWN_CHANNEL\x10\x102\xf2\x05\n" +
+	"\x0fUNKNOWN_CHANNEL\x10\x10\x12\x18\n" +
+	"\x14NOTIFICATION_EXPIRED\x10\x11\x12\x1f\n" +
+	"\x1bINVALID_NOTIFICATION_STATUS\x10\x122\xd5\t\n" +
 	"\x13NotificationService\x12g\n" +
 	"\x10SendNotification\x12(.notification.v1.SendNotificationRequest\x1a).notification.v1.SendNotificationResponse\x12v\n" +
 	"\x15SendNotificationAsync\x12-.notification.v1.SendNotificationAsyncRequest\x1a..notification.v1.SendNotificationAsyncResponse\x12y\n" +
 	"\x16BatchSendNotifications\x12..notification.v1.BatchSendNotificationsRequest\x1a/.notification.v1.BatchSendNotificationsResponse\x12\x88\x01\n" +
-	"\x1bBatchSendNotificationsAsync\x123.notification.v1.BatchSendNotificationsAsyncRequest\x1a4.notification.v1.BatchSendNotificationsAsyncResponse\x12R\n" +
+	"\x1bBatchSendNotificationsAsync\x123.notification.v1.BatchSendNotificationsAsyncRequest\x1a4.notification.v1.BatchSendNotificationsAsyncResponse\x12~\n" +
+	"\x17StreamSendNotifications\x12/.notification.v1.StreamSendNotificationsRequest\x1a0.notification.v1.StreamSendNotificationsResponse(\x01\x12R\n" +
 	"\tTxPrepare\x12!.notification.v1.TxPrepareRequest\x1a\".notification.v1.TxPrepareResponse\x12O\n" +
 	"\bTxCommit\x12 .notification.v1.TxCommitRequest\x1a!.notification.v1.TxCommitResponse\x12O\n" +
-	"\bTxCancel\x12 .notification.v1.TxCancelRequest\x1a!.notification.v1.TxCancelResponseB\xdc\x01\n" +
+	"\bTxCancel\x12 .notification.v1.TxCancelRequest\x1a!.notification.v1.TxCancelResponse\x12m\n" +
+	"\x12CancelNotification\x12*.notification.v1.CancelNotificationRequest\x1a+.notification.v1.CancelNotificationResponse\x12\x82\x01\n" +
+	"\x19UpdatePendingNotification\x121.notification.v1.UpdatePendingNotificationRequest\x1a2.notification.v1.UpdatePendingNotificationResponse\x12m\n" +
+	"\x12ResendNotification\x12*.notification.v1.ResendNotificationRequest\x1a+.notification.v1.ResendNotificationResponseB\xdc\x01\n" +
 	"\x13com.notification.v1B\x11NotificationProtoP\x01ZUgithub.com/robinlg/notification-platform/api/proto/gen/notification/v1;notificationv1\xa2\x02\x03NXX\xaa\x02\x0fNotification.V1\xca\x02\x0fNotification\\V1\xe2\x02\x1bNotification\\V1\\GPBMetadata\xea\x02\x10Notification::V1b\x06proto3"
 
 var (
@@ -1507,74 +2288,110 @@ func file_notification_v1_notification_proto_rawDescGZIP() []byte {
 }
 
 var file_notification_v1_notification_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_notification_v1_notification_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_notification_v1_notification_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
 var file_notification_v1_notification_proto_goTypes = []any{
 	(Channel)(0),                                // 0: notification.v1.Channel
 	(SendStatus)(0),                             // 1: notification.v1.SendStatus
 	(ErrorCode)(0),                              // 2: notification.v1.ErrorCode
 	(*SendStrategy)(nil),                        // 3: notification.v1.SendStrategy
 	(*Notification)(nil),                        // 4: notification.v1.Notification
-	(*SendNotificationRequest)(nil),             // 5: notification.v1.SendNotificationRequest
-	(*SendNotificationResponse)(nil),            // 6: notification.v1.SendNotificationResponse
-	(*SendNotificationAsyncRequest)(nil),        // 7: notification.v1.SendNotificationAsyncRequest
-	(*SendNotificationAsyncResponse)(nil),       // 8: notification.v1.SendNotificationAsyncResponse
-	(*BatchSendNotificationsRequest)(nil),       // 9: notification.v1.BatchSendNotificationsRequest
-	(*BatchSendNotificationsResponse)(nil),      // 10: notification.v1.BatchSendNotificationsResponse
-	(*BatchSendNotificationsAsyncRequest)(nil),  // 11: notification.v1.BatchSendNotificationsAsyncRequest
-	(*BatchSendNotificationsAsyncResponse)(nil), // 12: notification.v1.BatchSendNotificationsAsyncResponse
-	(*TxPrepareRequest)(nil),                    // 13: notification.v1.TxPrepareRequest
-	(*TxPrepareResponse)(nil),                   // 14: notification.v1.TxPrepareResponse
-	(*TxCommitRequest)(nil),                     // 15: notification.v1.TxCommitRequest
-	(*TxCommitResponse)(nil),                    // 16: notification.v1.TxCommitResponse
-	(*TxCancelRequest)(nil),                     // 17: notification.v1.TxCancelRequest
-	(*TxCancelResponse)(nil),                    // 18: notification.v1.TxCancelResponse
-	(*SendStrategy_ImmediateStrategy)(nil),      // 19: notification.v1.SendStrategy.ImmediateStrategy
-	(*SendStrategy_DelayedStrategy)(nil),        // 20: notification.v1.SendStrategy.DelayedStrategy
-	(*SendStrategy_ScheduledStrategy)(nil),      // 21: notification.v1.SendStrategy.ScheduledStrategy
-	(*SendStrategy_TimeWindowStrategy)(nil),     // 22: notification.v1.SendStrategy.TimeWindowStrategy
-	(*SendStrategy_DeadlineStrategy)(nil),       // 23: notification.v1.SendStrategy.DeadlineStrategy
-	nil,                                         // 24: notification.v1.Notification.TemplateParamsEntry
-	(*timestamppb.Timestamp)(nil),               // 25: google.protobuf.Timestamp
+	(*FallbackTarget)(nil),                      // 5: notification.v1.FallbackTarget
+	(*SendNotificationRequest)(nil),             // 6: notification.v1.SendNotificationRequest
+	(*SendNotificationResponse)(nil),            // 7: notification.v1.SendNotificationResponse
+	(*SendNotificationAsyncRequest)(nil),        // 8: notification.v1.SendNotificationAsyncRequest
+	(*SendNotificationAsyncResponse)(nil),       // 9: notification.v1.SendNotificationAsyncResponse
+	(*BatchSendNotificationsRequest)(nil),       // 10: notification.v1.BatchSendNotificationsRequest
+	(*BatchSendNotificationsResponse)(nil),      // 11: notification.v1.BatchSendNotificationsResponse
+	(*BatchSendNotificationsAsyncRequest)(nil),  // 12: notification.v1.BatchSendNotificationsAsyncRequest
+	(*BatchSendNotificationsAsyncResponse)(nil), // 13: notification.v1.BatchSendNotificationsAsyncResponse
+	(*TxPrepareRequest)(nil),                    // 14: notification.v1.TxPrepareRequest
+	(*TxPrepareResponse)(nil),                   // 15: notification.v1.TxPrepareResponse
+	(*TxCommitRequest)(nil),                     // 16: notification.v1.TxCommitRequest
+	(*TxCommitResponse)(nil),                    // 17: notification.v1.TxCommitResponse
+	(*TxCancelRequest)(nil),                     // 18: notification.v1.TxCancelRequest
+	(*TxCancelResponse)(nil),                    // 19: notification.v1.TxCancelResponse
+	(*CancelNotificationRequest)(nil),           // 20: notification.v1.CancelNotificationRequest
+	(*CancelNotificationResponse)(nil),          // 21: notification.v1.CancelNotificationResponse
+	(*UpdatePendingNotificationRequest)(nil),    // 22: notification.v1.UpdatePendingNotificationRequest
+	(*UpdatePendingNotificationResponse)(nil),   // 23: notification.v1.UpdatePendingNotificationResponse
+	(*ResendNotificationRequest)(nil),           // 24: notification.v1.ResendNotificationRequest
+	(*ResendNotificationResponse)(nil),          // 25: notification.v1.ResendNotificationResponse
+	(*StreamSendNotificationsRequest)(nil),      // 26: notification.v1.StreamSendNotificationsRequest
+	(*StreamSendNotificationsResponse)(nil),     // 27: notification.v1.StreamSendNotificationsResponse
+	(*StreamSendResult)(nil),                    // 28: notification.v1.StreamSendResult
+	(*StreamSendErrorSummary)(nil),              // 29: notification.v1.StreamSendErrorSummary
+	(*SendStrategy_ImmediateStrategy)(nil),      // 30: notification.v1.SendStrategy.ImmediateStrategy
+	(*SendStrategy_DelayedStrategy)(nil),        // 31: notification.v1.SendStrategy.DelayedStrategy
+	(*SendStrategy_ScheduledStrategy)(nil),      // 32: notification.v1.SendStrategy.ScheduledStrategy
+	(*SendStrategy_TimeWindowStrategy)(nil),     // 33: notification.v1.SendStrategy.TimeWindowStrategy
+	(*SendStrategy_DeadlineStrategy)(nil),       // 34: notification.v1.SendStrategy.DeadlineStrategy
+	nil,                                         // 35: notification.v1.Notification.TemplateParamsEntry
+	nil,                                         // 36: notification.v1.UpdatePendingNotificationRequest.TemplateParamsEntry
+	(*timestamppb.Timestamp)(nil),               // 37: google.protobuf.Timestamp
 }
 var file_notification_v1_notification_proto_depIdxs = []int32{
-	19, // 0: notification.v1.SendStrategy.immediate:type_name -> notification.v1.SendStrategy.ImmediateStrategy
-	20, // 1: notification.v1.SendStrategy.delayed:type_name -> notification.v1.SendStrategy.DelayedStrategy
-	21, // 2: notification.v1.SendStrategy.scheduled:type_name -> notification.v1.SendStrategy.ScheduledStrategy
-	22, // 3: notification.v1.SendStrategy.time_window:type_name -> notification.v1.SendStrategy.TimeWindowStrategy
-	23, // 4: notification.v1.SendStrategy.deadline:type_name -> notification.v1.SendStrategy.DeadlineStrategy
+	30, // 0: notification.v1.SendStrategy.immediate:type_name -> notification.v1.SendStrategy.ImmediateStrategy
+	31, // 1: notification.v1.SendStrategy.delayed:type_name -> notification.v1.SendStrategy.DelayedStrategy
+	32, // 2: notification.v1.SendStrategy.scheduled:type_name -> notification.v1.SendStrategy.ScheduledStrategy
+	33, // 3: notification.v1.SendStrategy.time_window:type_name -> notification.v1.SendStrategy.TimeWindowStrategy
+	34, // 4: notification.v1.SendStrategy.deadline:type_name -> notification.v1.SendStrategy.DeadlineStrategy
 	0,  // 5: notification.v1.Notification.channel:type_name -> notification.v1.Channel
-	24, // 6: notification.v1.Notification.template_params:type_name -> notification.v1.Notification.TemplateParamsEntry
+	35, // 6: notification.v1.Notification.template_params:type_name -> notification.v1.Notification.TemplateParamsEntry
 	3,  // 7: notification.v1.Notification.strategy:type_name -> notification.v1.SendStrategy
-	4,  // 8: notification.v1.SendNotificationRequest.notification:type_name -> notification.v1.Notification
-	1,  // 9: notification.v1.SendNotificationResponse.status:type_name -> notification.v1.SendStatus
-	2,  // 10: notification.v1.SendNotificationResponse.error_code:type_name -> notification.v1.ErrorCode
-	4,  // 11: notification.v1.SendNotificationAsyncRequest.notification:type_name -> notification.v1.Notification
-	2,  // 12: notification.v1.SendNotificationAsyncResponse.error_code:type_name -> notification.v1.ErrorCode
-	4,  // 13: notification.v1.BatchSendNotificationsRequest.notifications:type_name -> notification.v1.Notification
-	6,  // 14: notification.v1.BatchSendNotificationsResponse.results:type_name -> notification.v1.SendNotificationResponse
-	4,  // 15: notification.v1.BatchSendNotificationsAsyncRequest.notifications:type_name -> notification.v1.Notification
-	4,  // 16: notification.v1.TxPrepareRequest.notification:type_name -> notification.v1.Notification
-	25, // 17: notification.v1.SendStrategy.ScheduledStrategy.send_time:type_name -> google.protobuf.Timestamp
-	25, // 18: notification.v1.SendStrategy.DeadlineStrategy.deadline:type_name -> google.protobuf.Timestamp
-	5,  // 19: notification.v1.NotificationService.SendNotification:input_type -> notification.v1.SendNotificationRequest
-	7,  // 20: notification.v1.NotificationService.SendNotificationAsync:input_type -> notification.v1.SendNotificationAsyncRequest
-	9,  // 21: notification.v1.NotificationService.BatchSendNotifications:input_type -> notification.v1.BatchSendNotificationsRequest
-	11, // 22: notification.v1.NotificationService.BatchSendNotificationsAsync:input_type -> notification.v1.BatchSendNotificationsAsyncRequest
-	13, // 23: notification.v1.NotificationService.TxPrepare:input_type -> notification.v1.TxPrepareRequest
-	15, // 24: notification.v1.NotificationService.TxCommit:input_type -> notification.v1.TxCommitRequest
-	17, // 25: notification.v1.NotificationService.TxCancel:input_type -> notification.v1.TxCancelRequest
-	6,  // 26: notification.v1.NotificationService.SendNotification:output_type -> notification.v1.SendNotificationResponse
-	8,  // 27: notification.v1.NotificationService.SendNotificationAsync:output_type -> notification.v1.SendNotificationAsyncResponse
-	10, // 28: notification.v1.NotificationService.BatchSendNotifications:output_type -> notification.v1.BatchSendNotificationsResponse
-	12, // 29: notification.v1.NotificationService.BatchSendNotificationsAsync:output_type -> notification.v1.BatchSendNotificationsAsyncResponse
-	14, // 30: notification.v1.NotificationService.TxPrepare:output_type -> notification.v1.TxPrepareResponse
-	16, // 31: notification.v1.NotificationService.TxCommit:output_type -> notification.v1.TxCommitResponse
-	18, // 32: notification.v1.NotificationService.TxCancel:output_type -> notification.v1.TxCancelResponse
-	26, // [26:33] is the sub-list for method output_type
-	19, // [19:26] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+	5,  // 8: notification.v1.Notification.fallbacks:type_name -> notification.v1.FallbackTarget
+	0,  // 9: notification.v1.FallbackTarget.channel:type_name -> notification.v1.Channel
+	4,  // 10: notification.v1.SendNotificationRequest.notification:type_name -> notification.v1.Notification
+	1,  // 11: notification.v1.SendNotificationResponse.status:type_name -> notification.v1.SendStatus
+	2,  // 12: notification.v1.SendNotificationResponse.error_code:type_name -> notification.v1.ErrorCode
+	0,  // 13: notification.v1.SendNotificationResponse.delivered_channel:type_name -> notification.v1.Channel
+	4,  // 14: notification.v1.SendNotificationAsyncRequest.notification:type_name -> notification.v1.Notification
+	2,  // 15: notification.v1.SendNotificationAsyncResponse.error_code:type_name -> notification.v1.ErrorCode
+	4,  // 16: notification.v1.BatchSendNotificationsRequest.notifications:type_name -> notification.v1.Notification
+	7,  // 17: notification.v1.BatchSendNotificationsResponse.results:type_name -> notification.v1.SendNotificationResponse
+	4,  // 18: notification.v1.BatchSendNotificationsAsyncRequest.notifications:type_name -> notification.v1.Notification
+	4,  // 19: notification.v1.TxPrepareRequest.notification:type_name -> notification.v1.Notification
+	1,  // 20: notification.v1.CancelNotificationResponse.status:type_name -> notification.v1.SendStatus
+	2,  // 21: notification.v1.CancelNotificationResponse.error_code:type_name -> notification.v1.ErrorCode
+	3,  // 22: notification.v1.UpdatePendingNotificationRequest.strategy:type_name -> notification.v1.SendStrategy
+	36, // 23: notification.v1.UpdatePendingNotificationRequest.template_params:type_name -> notification.v1.UpdatePendingNotificationRequest.TemplateParamsEntry
+	1,  // 24: notification.v1.UpdatePendingNotificationResponse.status:type_name -> notification.v1.SendStatus
+	2,  // 25: notification.v1.UpdatePendingNotificationResponse.error_code:type_name -> notification.v1.ErrorCode
+	7,  // 26: notification.v1.ResendNotificationResponse.result:type_name -> notification.v1.SendNotificationResponse
+	4,  // 27: notification.v1.StreamSendNotificationsRequest.notification:type_name -> notification.v1.Notification
+	28, // 28: notification.v1.StreamSendNotificationsResponse.results:type_name -> notification.v1.StreamSendResult
+	29, // 29: notification.v1.StreamSendNotificationsResponse.errors:type_name -> notification.v1.StreamSendErrorSummary
+	1,  // 30: notification.v1.StreamSendResult.status:type_name -> notification.v1.SendStatus
+	2,  // 31: notification.v1.StreamSendResult.error_code:type_name -> notification.v1.ErrorCode
+	2,  // 32: notification.v1.StreamSendErrorSummary.error_code:type_name -> notification.v1.ErrorCode
+	37, // 33: notification.v1.SendStrategy.ScheduledStrategy.send_time:type_name -> google.protobuf.Timestamp
+	37, // 34: notification.v1.SendStrategy.DeadlineStrategy.deadline:type_name -> google.protobuf.Timestamp
+	6,  // 35: notification.v1.NotificationService.SendNotification:input_type -> notification.v1.SendNotificationRequest
+	8,  // 36: notification.v1.NotificationService.SendNotificationAsync:input_type -> notification.v1.SendNotificationAsyncRequest
+	10, // 37: notification.v1.NotificationService.BatchSendNotifications:input_type -> notification.v1.BatchSendNotificationsRequest
+	12, // 38: notification.v1.NotificationService.BatchSendNotificationsAsync:input_type -> notification.v1.BatchSendNotificationsAsyncRequest
+	26, // 39: notification.v1.NotificationService.StreamSendNotifications:input_type -> notification.v1.StreamSendNotificationsRequest
+	14, // 40: notification.v1.NotificationService.TxPrepare:input_type -> notification.v1.TxPrepareRequest
+	16, // 41: notification.v1.NotificationService.TxCommit:input_type -> notification.v1.TxCommitRequest
+	18, // 42: notification.v1.NotificationService.TxCancel:input_type -> notification.v1.TxCancelRequest
+	20, // 43: notification.v1.NotificationService.CancelNotification:input_type -> notification.v1.CancelNotificationRequest
+	22, // 44: notification.v1.NotificationService.UpdatePendingNotification:input_type -> notification.v1.UpdatePendingNotificationRequest
+	24, // 45: notification.v1.NotificationService.ResendNotification:input_type -> notification.v1.ResendNotificationRequest
+	7,  // 46: notification.v1.NotificationService.SendNotification:output_type -> notification.v1.SendNotificationResponse
+	9,  // 47: notification.v1.NotificationService.SendNotificationAsync:output_type -> notification.v1.SendNotificationAsyncResponse
+	11, // 48: notification.v1.NotificationService.BatchSendNotifications:output_type -> notification.v1.BatchSendNotificationsResponse
+	13, // 49: notification.v1.NotificationService.BatchSendNotificationsAsync:output_type -> notification.v1.BatchSendNotificationsAsyncResponse
+	27, // 50: notification.v1.NotificationService.StreamSendNotifications:output_type -> notification.v1.StreamSendNotificationsResponse
+	15, // 51: notification.v1.NotificationService.TxPrepare:output_type -> notification.v1.TxPrepareResponse
+	17, // 52: notification.v1.NotificationService.TxCommit:output_type -> notification.v1.TxCommitResponse
+	19, // 53: notification.v1.NotificationService.TxCancel:output_type -> notification.v1.TxCancelResponse
+	21, // 54: notification.v1.NotificationService.CancelNotification:output_type -> notification.v1.CancelNotificationResponse
+	23, // 55: notification.v1.NotificationService.UpdatePendingNotification:output_type -> notification.v1.UpdatePendingNotificationResponse
+	25, // 56: notification.v1.NotificationService.ResendNotification:output_type -> notification.v1.ResendNotificationResponse
+	46, // [46:57] is the sub-list for method output_type
+	35, // [35:46] is the sub-list for method input_type
+	35, // [35:35] is the sub-list for extension type_name
+	35, // [35:35] is the sub-list for extension extendee
+	0,  // [0:35] is the sub-list for field type_name
 }
 
 func init() { file_notification_v1_notification_proto_init() }
@@ -1595,7 +2412,7 @@ func file_notification_v1_notification_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_notification_v1_notification_proto_rawDesc), len(file_notification_v1_notification_proto_rawDesc)),
 			NumEnums:      3,
-			NumMessages:   22,
+			NumMessages:   34,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
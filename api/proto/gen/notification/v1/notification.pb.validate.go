@@ -403,6 +403,40 @@ func (m *Notification) validate(all bool) error {
 		}
 	}
 
+	for idx, item := range m.GetFallbacks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, NotificationValidationError{
+						field:  fmt.Sprintf("Fallbacks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, NotificationValidationError{
+						field:  fmt.Sprintf("Fallbacks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return NotificationValidationError{
+					field:  fmt.Sprintf("Fallbacks[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return NotificationMultiError(errors)
 	}
@@ -480,6 +514,110 @@ var _ interface {
 	ErrorName() string
 } = NotificationValidationError{}
 
+// Validate checks the field values on FallbackTarget with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FallbackTarget) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FallbackTarget with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FallbackTargetMultiError,
+// or nil if none found.
+func (m *FallbackTarget) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FallbackTarget) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Channel
+
+	// no validation rules for TemplateId
+
+	if len(errors) > 0 {
+		return FallbackTargetMultiError(errors)
+	}
+
+	return nil
+}
+
+// FallbackTargetMultiError is an error wrapping multiple validation errors
+// returned by FallbackTarget.ValidateAll() if the designated constraints
+// aren't met.
+type FallbackTargetMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FallbackTargetMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FallbackTargetMultiError) AllErrors() []error { return m }
+
+// FallbackTargetValidationError is the validation error returned by
+// FallbackTarget.Validate if the designated constraints aren't met.
+type FallbackTargetValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FallbackTargetValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FallbackTargetValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FallbackTargetValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FallbackTargetValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FallbackTargetValidationError) ErrorName() string { return "FallbackTargetValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FallbackTargetValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFallbackTarget.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FallbackTargetValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FallbackTargetValidationError{}
+
 // Validate checks the field values on SendNotificationRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -641,6 +779,8 @@ func (m *SendNotificationResponse) validate(all bool) error {
 
 	// no validation rules for ErrorMessage
 
+	// no validation rules for DeliveredChannel
+
 	if len(errors) > 0 {
 		return SendNotificationResponseMultiError(errors)
 	}
@@ -2119,6 +2259,1233 @@ var _ interface {
 	ErrorName() string
 } = TxCancelResponseValidationError{}
 
+// Validate checks the field values on CancelNotificationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelNotificationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelNotificationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelNotificationRequestMultiError, or nil if none found.
+func (m *CancelNotificationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelNotificationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Key
+
+	if len(errors) > 0 {
+		return CancelNotificationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelNotificationRequestMultiError is an error wrapping multiple validation
+// errors returned by CancelNotificationRequest.ValidateAll() if the
+// designated constraints aren't met.
+type CancelNotificationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelNotificationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelNotificationRequestMultiError) AllErrors() []error { return m }
+
+// CancelNotificationRequestValidationError is the validation error returned by
+// CancelNotificationRequest.Validate if the designated constraints aren't met.
+type CancelNotificationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelNotificationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelNotificationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelNotificationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelNotificationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelNotificationRequestValidationError) ErrorName() string {
+	return "CancelNotificationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelNotificationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelNotificationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelNotificationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelNotificationRequestValidationError{}
+
+// Validate checks the field values on CancelNotificationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelNotificationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelNotificationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelNotificationResponseMultiError, or nil if none found.
+func (m *CancelNotificationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelNotificationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for NotificationId
+
+	// no validation rules for Status
+
+	// no validation rules for ErrorCode
+
+	// no validation rules for ErrorMessage
+
+	if len(errors) > 0 {
+		return CancelNotificationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelNotificationResponseMultiError is an error wrapping multiple
+// validation errors returned by CancelNotificationResponse.ValidateAll() if
+// the designated constraints aren't met.
+type CancelNotificationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelNotificationResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelNotificationResponseMultiError) AllErrors() []error { return m }
+
+// CancelNotificationResponseValidationError is the validation error returned
+// by CancelNotificationResponse.Validate if the designated constraints aren't met.
+type CancelNotificationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelNotificationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelNotificationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelNotificationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelNotificationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelNotificationResponseValidationError) ErrorName() string {
+	return "CancelNotificationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelNotificationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelNotificationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelNotificationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelNotificationResponseValidationError{}
+
+// Validate checks the field values on UpdatePendingNotificationRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *UpdatePendingNotificationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdatePendingNotificationRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// UpdatePendingNotificationRequestMultiError, or nil if none found.
+func (m *UpdatePendingNotificationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdatePendingNotificationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Key
+
+	if all {
+		switch v := interface{}(m.GetStrategy()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdatePendingNotificationRequestValidationError{
+					field:  "Strategy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdatePendingNotificationRequestValidationError{
+					field:  "Strategy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStrategy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdatePendingNotificationRequestValidationError{
+				field:  "Strategy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for TemplateParams
+
+	if len(errors) > 0 {
+		return UpdatePendingNotificationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdatePendingNotificationRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// UpdatePendingNotificationRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdatePendingNotificationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdatePendingNotificationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdatePendingNotificationRequestMultiError) AllErrors() []error { return m }
+
+// UpdatePendingNotificationRequestValidationError is the validation error
+// returned by UpdatePendingNotificationRequest.Validate if the designated
+// constraints aren't met.
+type UpdatePendingNotificationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdatePendingNotificationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdatePendingNotificationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdatePendingNotificationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdatePendingNotificationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdatePendingNotificationRequestValidationError) ErrorName() string {
+	return "UpdatePendingNotificationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdatePendingNotificationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdatePendingNotificationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdatePendingNotificationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdatePendingNotificationRequestValidationError{}
+
+// Validate checks the field values on UpdatePendingNotificationResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *UpdatePendingNotificationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdatePendingNotificationResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// UpdatePendingNotificationResponseMultiError, or nil if none found.
+func (m *UpdatePendingNotificationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdatePendingNotificationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for NotificationId
+
+	// no validation rules for Status
+
+	// no validation rules for ErrorCode
+
+	// no validation rules for ErrorMessage
+
+	if len(errors) > 0 {
+		return UpdatePendingNotificationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdatePendingNotificationResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// UpdatePendingNotificationResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdatePendingNotificationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdatePendingNotificationResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdatePendingNotificationResponseMultiError) AllErrors() []error { return m }
+
+// UpdatePendingNotificationResponseValidationError is the validation error
+// returned by UpdatePendingNotificationResponse.Validate if the designated
+// constraints aren't met.
+type UpdatePendingNotificationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdatePendingNotificationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdatePendingNotificationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdatePendingNotificationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdatePendingNotificationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdatePendingNotificationResponseValidationError) ErrorName() string {
+	return "UpdatePendingNotificationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdatePendingNotificationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdatePendingNotificationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdatePendingNotificationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdatePendingNotificationResponseValidationError{}
+
+// Validate checks the field values on ResendNotificationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResendNotificationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResendNotificationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResendNotificationRequestMultiError, or nil if none found.
+func (m *ResendNotificationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResendNotificationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Key
+
+	// no validation rules for SendImmediately
+
+	if len(errors) > 0 {
+		return ResendNotificationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResendNotificationRequestMultiError is an error wrapping multiple validation
+// errors returned by ResendNotificationRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ResendNotificationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResendNotificationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResendNotificationRequestMultiError) AllErrors() []error { return m }
+
+// ResendNotificationRequestValidationError is the validation error returned by
+// ResendNotificationRequest.Validate if the designated constraints aren't met.
+type ResendNotificationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResendNotificationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResendNotificationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResendNotificationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResendNotificationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResendNotificationRequestValidationError) ErrorName() string {
+	return "ResendNotificationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResendNotificationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResendNotificationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResendNotificationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResendNotificationRequestValidationError{}
+
+// Validate checks the field values on ResendNotificationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResendNotificationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResendNotificationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResendNotificationResponseMultiError, or nil if none found.
+func (m *ResendNotificationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResendNotificationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetResult()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ResendNotificationResponseValidationError{
+					field:  "Result",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ResendNotificationResponseValidationError{
+					field:  "Result",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResult()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ResendNotificationResponseValidationError{
+				field:  "Result",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ResendNotificationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResendNotificationResponseMultiError is an error wrapping multiple
+// validation errors returned by ResendNotificationResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ResendNotificationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResendNotificationResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResendNotificationResponseMultiError) AllErrors() []error { return m }
+
+// ResendNotificationResponseValidationError is the validation error returned
+// by ResendNotificationResponse.Validate if the designated constraints aren't met.
+type ResendNotificationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResendNotificationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResendNotificationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResendNotificationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResendNotificationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResendNotificationResponseValidationError) ErrorName() string {
+	return "ResendNotificationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResendNotificationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResendNotificationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResendNotificationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResendNotificationResponseValidationError{}
+
+// Validate checks the field values on StreamSendNotificationsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StreamSendNotificationsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StreamSendNotificationsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// StreamSendNotificationsRequestMultiError, or nil if none found.
+func (m *StreamSendNotificationsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StreamSendNotificationsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetNotification()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StreamSendNotificationsRequestValidationError{
+					field:  "Notification",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StreamSendNotificationsRequestValidationError{
+					field:  "Notification",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNotification()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StreamSendNotificationsRequestValidationError{
+				field:  "Notification",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return StreamSendNotificationsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StreamSendNotificationsRequestMultiError is an error wrapping multiple
+// validation errors returned by StreamSendNotificationsRequest.ValidateAll()
+// if the designated constraints aren't met.
+type StreamSendNotificationsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StreamSendNotificationsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StreamSendNotificationsRequestMultiError) AllErrors() []error { return m }
+
+// StreamSendNotificationsRequestValidationError is the validation error
+// returned by StreamSendNotificationsRequest.Validate if the designated
+// constraints aren't met.
+type StreamSendNotificationsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StreamSendNotificationsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StreamSendNotificationsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StreamSendNotificationsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StreamSendNotificationsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StreamSendNotificationsRequestValidationError) ErrorName() string {
+	return "StreamSendNotificationsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StreamSendNotificationsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStreamSendNotificationsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StreamSendNotificationsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StreamSendNotificationsRequestValidationError{}
+
+// Validate checks the field values on StreamSendNotificationsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StreamSendNotificationsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StreamSendNotificationsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// StreamSendNotificationsResponseMultiError, or nil if none found.
+func (m *StreamSendNotificationsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StreamSendNotificationsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StreamSendNotificationsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StreamSendNotificationsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StreamSendNotificationsResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalCount
+
+	// no validation rules for SuccessCount
+
+	for idx, item := range m.GetErrors() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StreamSendNotificationsResponseValidationError{
+						field:  fmt.Sprintf("Errors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StreamSendNotificationsResponseValidationError{
+						field:  fmt.Sprintf("Errors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StreamSendNotificationsResponseValidationError{
+					field:  fmt.Sprintf("Errors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return StreamSendNotificationsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// StreamSendNotificationsResponseMultiError is an error wrapping multiple
+// validation errors returned by StreamSendNotificationsResponse.ValidateAll()
+// if the designated constraints aren't met.
+type StreamSendNotificationsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StreamSendNotificationsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StreamSendNotificationsResponseMultiError) AllErrors() []error { return m }
+
+// StreamSendNotificationsResponseValidationError is the validation error
+// returned by StreamSendNotificationsResponse.Validate if the designated
+// constraints aren't met.
+type StreamSendNotificationsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StreamSendNotificationsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StreamSendNotificationsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StreamSendNotificationsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StreamSendNotificationsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StreamSendNotificationsResponseValidationError) ErrorName() string {
+	return "StreamSendNotificationsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StreamSendNotificationsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStreamSendNotificationsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StreamSendNotificationsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StreamSendNotificationsResponseValidationError{}
+
+// Validate checks the field values on StreamSendResult with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *StreamSendResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StreamSendResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StreamSendResultMultiError, or nil if none found.
+func (m *StreamSendResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StreamSendResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Index
+
+	// no validation rules for Key
+
+	// no validation rules for NotificationId
+
+	// no validation rules for Status
+
+	// no validation rules for ErrorCode
+
+	if len(errors) > 0 {
+		return StreamSendResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// StreamSendResultMultiError is an error wrapping multiple validation errors
+// returned by StreamSendResult.ValidateAll() if the designated constraints
+// aren't met.
+type StreamSendResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StreamSendResultMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StreamSendResultMultiError) AllErrors() []error { return m }
+
+// StreamSendResultValidationError is the validation error returned by
+// StreamSendResult.Validate if the designated constraints aren't met.
+type StreamSendResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StreamSendResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StreamSendResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StreamSendResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StreamSendResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StreamSendResultValidationError) ErrorName() string { return "StreamSendResultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StreamSendResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStreamSendResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StreamSendResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StreamSendResultValidationError{}
+
+// Validate checks the field values on StreamSendErrorSummary with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StreamSendErrorSummary) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StreamSendErrorSummary with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StreamSendErrorSummaryMultiError, or nil if none found.
+func (m *StreamSendErrorSummary) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StreamSendErrorSummary) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ErrorCode
+
+	// no validation rules for Count
+
+	// no validation rules for ErrorMessage
+
+	if len(errors) > 0 {
+		return StreamSendErrorSummaryMultiError(errors)
+	}
+
+	return nil
+}
+
+// StreamSendErrorSummaryMultiError is an error wrapping multiple validation
+// errors returned by StreamSendErrorSummary.ValidateAll() if the designated
+// constraints aren't met.
+type StreamSendErrorSummaryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StreamSendErrorSummaryMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StreamSendErrorSummaryMultiError) AllErrors() []error { return m }
+
+// StreamSendErrorSummaryValidationError is the validation error returned by
+// StreamSendErrorSummary.Validate if the designated constraints aren't met.
+type StreamSendErrorSummaryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StreamSendErrorSummaryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StreamSendErrorSummaryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StreamSendErrorSummaryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StreamSendErrorSummaryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StreamSendErrorSummaryValidationError) ErrorName() string {
+	return "StreamSendErrorSummaryValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StreamSendErrorSummaryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStreamSendErrorSummary.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StreamSendErrorSummaryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StreamSendErrorSummaryValidationError{}
+
 // Validate checks the field values on SendStrategy_ImmediateStrategy with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -23,9 +23,13 @@ const (
 	NotificationService_SendNotificationAsync_FullMethodName       = "/notification.v1.NotificationService/SendNotificationAsync"
 	NotificationService_BatchSendNotifications_FullMethodName      = "/notification.v1.NotificationService/BatchSendNotifications"
 	NotificationService_BatchSendNotificationsAsync_FullMethodName = "/notification.v1.NotificationService/BatchSendNotificationsAsync"
+	NotificationService_StreamSendNotifications_FullMethodName     = "/notification.v1.NotificationService/StreamSendNotifications"
 	NotificationService_TxPrepare_FullMethodName                   = "/notification.v1.NotificationService/TxPrepare"
 	NotificationService_TxCommit_FullMethodName                    = "/notification.v1.NotificationService/TxCommit"
 	NotificationService_TxCancel_FullMethodName                    = "/notification.v1.NotificationService/TxCancel"
+	NotificationService_CancelNotification_FullMethodName          = "/notification.v1.NotificationService/CancelNotification"
+	NotificationService_UpdatePendingNotification_FullMethodName   = "/notification.v1.NotificationService/UpdatePendingNotification"
+	NotificationService_ResendNotification_FullMethodName          = "/notification.v1.NotificationService/ResendNotification"
 )
 
 // NotificationServiceClient is the client API for NotificationService service.
@@ -40,12 +44,22 @@ type NotificationServiceClient interface {
 	BatchSendNotifications(ctx context.Context, in *BatchSendNotificationsRequest, opts ...grpc.CallOption) (*BatchSendNotificationsResponse, error)
 	// 异步批量发送
 	BatchSendNotificationsAsync(ctx context.Context, in *BatchSendNotificationsAsyncRequest, opts ...grpc.CallOption) (*BatchSendNotificationsAsyncResponse, error)
+	// 流式异步发送，用于大批量的营销类通知，不限制通知数量
+	// 通知按照接收的顺序分批保存，等待调度器发送，单条通知不合法不影响其他通知
+	// 客户端关闭发送之后返回总数、成功数以及发送失败的通知，成功的通知不返回，可以使用 key 查询
+	StreamSendNotifications(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[StreamSendNotificationsRequest, StreamSendNotificationsResponse], error)
 	// 准备事务
 	TxPrepare(ctx context.Context, in *TxPrepareRequest, opts ...grpc.CallOption) (*TxPrepareResponse, error)
 	// 提交事务
 	TxCommit(ctx context.Context, in *TxCommitRequest, opts ...grpc.CallOption) (*TxCommitResponse, error)
 	// 取消事务
 	TxCancel(ctx context.Context, in *TxCancelRequest, opts ...grpc.CallOption) (*TxCancelResponse, error)
+	// 取消尚未发送的通知，只有等待发送的通知可以取消
+	CancelNotification(ctx context.Context, in *CancelNotificationRequest, opts ...grpc.CallOption) (*CancelNotificationResponse, error)
+	// 修改尚未发送的通知的发送策略和模版参数，只有等待发送的通知可以修改
+	UpdatePendingNotification(ctx context.Context, in *UpdatePendingNotificationRequest, opts ...grpc.CallOption) (*UpdatePendingNotificationResponse, error)
+	// 重新发送发送失败的通知，沿用原有的业务唯一标识
+	ResendNotification(ctx context.Context, in *ResendNotificationRequest, opts ...grpc.CallOption) (*ResendNotificationResponse, error)
 }
 
 type notificationServiceClient struct {
@@ -96,6 +110,19 @@ func (c *notificationServiceClient) BatchSendNotificationsAsync(ctx context.Cont
 	return out, nil
 }
 
+func (c *notificationServiceClient) StreamSendNotifications(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[StreamSendNotificationsRequest, StreamSendNotificationsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &NotificationService_ServiceDesc.Streams[0], NotificationService_StreamSendNotifications_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamSendNotificationsRequest, StreamSendNotificationsResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type NotificationService_StreamSendNotificationsClient = grpc.ClientStreamingClient[StreamSendNotificationsRequest, StreamSendNotificationsResponse]
+
 func (c *notificationServiceClient) TxPrepare(ctx context.Context, in *TxPrepareRequest, opts ...grpc.CallOption) (*TxPrepareResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(TxPrepareResponse)
@@ -126,6 +153,36 @@ func (c *notificationServiceClient) TxCancel(ctx context.Context, in *TxCancelRe
 	return out, nil
 }
 
+func (c *notificationServiceClient) CancelNotification(ctx context.Context, in *CancelNotificationRequest, opts ...grpc.CallOption) (*CancelNotificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelNotificationResponse)
+	err := c.cc.Invoke(ctx, NotificationService_CancelNotification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) UpdatePendingNotification(ctx context.Context, in *UpdatePendingNotificationRequest, opts ...grpc.CallOption) (*UpdatePendingNotificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePendingNotificationResponse)
+	err := c.cc.Invoke(ctx, NotificationService_UpdatePendingNotification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) ResendNotification(ctx context.Context, in *ResendNotificationRequest, opts ...grpc.CallOption) (*ResendNotificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResendNotificationResponse)
+	err := c.cc.Invoke(ctx, NotificationService_ResendNotification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NotificationServiceServer is the server API for NotificationService service.
 // All implementations should embed UnimplementedNotificationServiceServer
 // for forward compatibility.
@@ -138,12 +195,22 @@ type NotificationServiceServer interface {
 	BatchSendNotifications(context.Context, *BatchSendNotificationsRequest) (*BatchSendNotificationsResponse, error)
 	// 异步批量发送
 	BatchSendNotificationsAsync(context.Context, *BatchSendNotificationsAsyncRequest) (*BatchSendNotificationsAsyncResponse, error)
+	// 流式异步发送，用于大批量的营销类通知，不限制通知数量
+	// 通知按照接收的顺序分批保存，等待调度器发送，单条通知不合法不影响其他通知
+	// 客户端关闭发送之后返回总数、成功数以及发送失败的通知，成功的通知不返回，可以使用 key 查询
+	StreamSendNotifications(grpc.ClientStreamingServer[StreamSendNotificationsRequest, StreamSendNotificationsResponse]) error
 	// 准备事务
 	TxPrepare(context.Context, *TxPrepareRequest) (*TxPrepareResponse, error)
 	// 提交事务
 	TxCommit(context.Context, *TxCommitRequest) (*TxCommitResponse, error)
 	// 取消事务
 	TxCancel(context.Context, *TxCancelRequest) (*TxCancelResponse, error)
+	// 取消尚未发送的通知，只有等待发送的通知可以取消
+	CancelNotification(context.Context, *CancelNotificationRequest) (*CancelNotificationResponse, error)
+	// 修改尚未发送的通知的发送策略和模版参数，只有等待发送的通知可以修改
+	UpdatePendingNotification(context.Context, *UpdatePendingNotificationRequest) (*UpdatePendingNotificationResponse, error)
+	// 重新发送发送失败的通知，沿用原有的业务唯一标识
+	ResendNotification(context.Context, *ResendNotificationRequest) (*ResendNotificationResponse, error)
 }
 
 // UnimplementedNotificationServiceServer should be embedded to have
@@ -165,6 +232,9 @@ func (UnimplementedNotificationServiceServer) BatchSendNotifications(context.Con
 func (UnimplementedNotificationServiceServer) BatchSendNotificationsAsync(context.Context, *BatchSendNotificationsAsyncRequest) (*BatchSendNotificationsAsyncResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BatchSendNotificationsAsync not implemented")
 }
+func (UnimplementedNotificationServiceServer) StreamSendNotifications(grpc.ClientStreamingServer[StreamSendNotificationsRequest, StreamSendNotificationsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamSendNotifications not implemented")
+}
 func (UnimplementedNotificationServiceServer) TxPrepare(context.Context, *TxPrepareRequest) (*TxPrepareResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TxPrepare not implemented")
 }
@@ -174,6 +244,15 @@ func (UnimplementedNotificationServiceServer) TxCommit(context.Context, *TxCommi
 func (UnimplementedNotificationServiceServer) TxCancel(context.Context, *TxCancelRequest) (*TxCancelResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TxCancel not implemented")
 }
+func (UnimplementedNotificationServiceServer) CancelNotification(context.Context, *CancelNotificationRequest) (*CancelNotificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelNotification not implemented")
+}
+func (UnimplementedNotificationServiceServer) UpdatePendingNotification(context.Context, *UpdatePendingNotificationRequest) (*UpdatePendingNotificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePendingNotification not implemented")
+}
+func (UnimplementedNotificationServiceServer) ResendNotification(context.Context, *ResendNotificationRequest) (*ResendNotificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResendNotification not implemented")
+}
 func (UnimplementedNotificationServiceServer) testEmbeddedByValue() {}
 
 // UnsafeNotificationServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -266,6 +345,13 @@ func _NotificationService_BatchSendNotificationsAsync_Handler(srv interface{}, c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NotificationService_StreamSendNotifications_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(NotificationServiceServer).StreamSendNotifications(&grpc.GenericServerStream[StreamSendNotificationsRequest, StreamSendNotificationsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type NotificationService_StreamSendNotificationsServer = grpc.ClientStreamingServer[StreamSendNotificationsRequest, StreamSendNotificationsResponse]
+
 func _NotificationService_TxPrepare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TxPrepareRequest)
 	if err := dec(in); err != nil {
@@ -320,6 +406,60 @@ func _NotificationService_TxCancel_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NotificationService_CancelNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelNotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).CancelNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_CancelNotification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).CancelNotification(ctx, req.(*CancelNotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_UpdatePendingNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePendingNotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).UpdatePendingNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_UpdatePendingNotification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).UpdatePendingNotification(ctx, req.(*UpdatePendingNotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_ResendNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResendNotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).ResendNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_ResendNotification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).ResendNotification(ctx, req.(*ResendNotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // NotificationService_ServiceDesc is the grpc.ServiceDesc for NotificationService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -355,7 +495,25 @@ var NotificationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TxCancel",
 			Handler:    _NotificationService_TxCancel_Handler,
 		},
+		{
+			MethodName: "CancelNotification",
+			Handler:    _NotificationService_CancelNotification_Handler,
+		},
+		{
+			MethodName: "UpdatePendingNotification",
+			Handler:    _NotificationService_UpdatePendingNotification_Handler,
+		},
+		{
+			MethodName: "ResendNotification",
+			Handler:    _NotificationService_ResendNotification_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamSendNotifications",
+			Handler:       _NotificationService_StreamSendNotifications_Handler,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "notification/v1/notification.proto",
 }
@@ -21,6 +21,58 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// 通知事件类型
+type NotificationEventType int32
+
+const (
+	NotificationEventType_NOTIFICATION_EVENT_TYPE_UNSPECIFIED NotificationEventType = 0
+	// 状态变更
+	NotificationEventType_TRANSITION NotificationEventType = 1
+	// 调用供应商发送
+	NotificationEventType_PROVIDER_ATTEMPT NotificationEventType = 2
+)
+
+// Enum value maps for NotificationEventType.
+var (
+	NotificationEventType_name = map[int32]string{
+		0: "NOTIFICATION_EVENT_TYPE_UNSPECIFIED",
+		1: "TRANSITION",
+		2: "PROVIDER_ATTEMPT",
+	}
+	NotificationEventType_value = map[string]int32{
+		"NOTIFICATION_EVENT_TYPE_UNSPECIFIED": 0,
+		"TRANSITION":                          1,
+		"PROVIDER_ATTEMPT":                    2,
+	}
+)
+
+func (x NotificationEventType) Enum() *NotificationEventType {
+	p := new(NotificationEventType)
+	*p = x
+	return p
+}
+
+func (x NotificationEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NotificationEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_notification_v1_notification_query_proto_enumTypes[0].Descriptor()
+}
+
+func (NotificationEventType) Type() protoreflect.EnumType {
+	return &file_notification_v1_notification_query_proto_enumTypes[0]
+}
+
+func (x NotificationEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NotificationEventType.Descriptor instead.
+func (NotificationEventType) EnumDescriptor() ([]byte, []int) {
+	return file_notification_v1_notification_query_proto_rawDescGZIP(), []int{0}
+}
+
 // 单条查询请求
 type QueryNotificationRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -203,6 +255,678 @@ func (x *BatchQueryNotificationsResponse) GetResults() []*SendNotificationRespon
 	return nil
 }
 
+// 订阅通知状态变更请求
+type WatchNotificationsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 只推送这些通知的状态变更，为空时推送业务方所有通知的状态变更
+	Keys []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	// 从这个位置之后开始推送，为空时只推送订阅之后的状态变更
+	Cursor        string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchNotificationsRequest) Reset() {
+	*x = WatchNotificationsRequest{}
+	mi := &file_notification_v1_notification_query_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchNotificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchNotificationsRequest) ProtoMessage() {}
+
+func (x *WatchNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_query_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*WatchNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_query_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *WatchNotificationsRequest) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *WatchNotificationsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+// 通知状态变更
+type WatchNotificationsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 事件位置，断线重连时使用
+	Cursor string `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// 通知平台生成的通知ID
+	NotificationId uint64 `protobuf:"varint,2,opt,name=notification_id,json=notificationId,proto3" json:"notification_id,omitempty"`
+	// 业务方某个业务内部的唯一标识
+	Key string `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	// 变更之后的状态
+	Status SendStatus `protobuf:"varint,4,opt,name=status,proto3,enum=notification.v1.SendStatus" json:"status,omitempty"`
+	// 状态变更时间，毫秒
+	TimestampMilliseconds int64 `protobuf:"varint,5,opt,name=timestamp_milliseconds,json=timestampMilliseconds,proto3" json:"timestamp_milliseconds,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *WatchNotificationsResponse) Reset() {
+	*x = WatchNotificationsResponse{}
+	mi := &file_notification_v1_notification_query_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchNotificationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchNotificationsResponse) ProtoMessage() {}
+
+func (x *WatchNotificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_query_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchNotificationsResponse.ProtoReflect.Descriptor instead.
+func (*WatchNotificationsResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_query_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *WatchNotificationsResponse) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *WatchNotificationsResponse) GetNotificationId() uint64 {
+	if x != nil {
+		return x.NotificationId
+	}
+	return 0
+}
+
+func (x *WatchNotificationsResponse) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *WatchNotificationsResponse) GetStatus() SendStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SendStatus_SEND_STATUS_UNSPECIFIED
+}
+
+func (x *WatchNotificationsResponse) GetTimestampMilliseconds() int64 {
+	if x != nil {
+		return x.TimestampMilliseconds
+	}
+	return 0
+}
+
+// 查询通知列表请求，未指定的条件不参与过滤
+type ListNotificationsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 发送状态
+	Status SendStatus `protobuf:"varint,1,opt,name=status,proto3,enum=notification.v1.SendStatus" json:"status,omitempty"`
+	// 发送渠道
+	Channel Channel `protobuf:"varint,2,opt,name=channel,proto3,enum=notification.v1.Channel" json:"channel,omitempty"`
+	// 模版ID
+	TemplateId string `protobuf:"bytes,3,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	// 接收者(手机/邮箱/用户ID)，精确匹配
+	Receiver string `protobuf:"bytes,4,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// 创建时间下限(包含)，毫秒
+	StartTimeMilliseconds int64 `protobuf:"varint,5,opt,name=start_time_milliseconds,json=startTimeMilliseconds,proto3" json:"start_time_milliseconds,omitempty"`
+	// 创建时间上限(不包含)，毫秒
+	EndTimeMilliseconds int64 `protobuf:"varint,6,opt,name=end_time_milliseconds,json=endTimeMilliseconds,proto3" json:"end_time_milliseconds,omitempty"`
+	// 上一页返回的 next_cursor，查询第一页时不指定
+	Cursor uint64 `protobuf:"varint,7,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// 每页数量，默认 20，最大 100
+	Limit         int32 `protobuf:"varint,8,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotificationsRequest) Reset() {
+	*x = ListNotificationsRequest{}
+	mi := &file_notification_v1_notification_query_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationsRequest) ProtoMessage() {}
+
+func (x *ListNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_query_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*ListNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_query_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListNotificationsRequest) GetStatus() SendStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SendStatus_SEND_STATUS_UNSPECIFIED
+}
+
+func (x *ListNotificationsRequest) GetChannel() Channel {
+	if x != nil {
+		return x.Channel
+	}
+	return Channel_CHANNEL_UNSPECIFIED
+}
+
+func (x *ListNotificationsRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *ListNotificationsRequest) GetReceiver() string {
+	if x != nil {
+		return x.Receiver
+	}
+	return ""
+}
+
+func (x *ListNotificationsRequest) GetStartTimeMilliseconds() int64 {
+	if x != nil {
+		return x.StartTimeMilliseconds
+	}
+	return 0
+}
+
+func (x *ListNotificationsRequest) GetEndTimeMilliseconds() int64 {
+	if x != nil {
+		return x.EndTimeMilliseconds
+	}
+	return 0
+}
+
+func (x *ListNotificationsRequest) GetCursor() uint64 {
+	if x != nil {
+		return x.Cursor
+	}
+	return 0
+}
+
+func (x *ListNotificationsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// 查询通知列表响应
+type ListNotificationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notifications []*NotificationRecord  `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"`
+	// 下一页的游标，为 0 时表示没有下一页
+	NextCursor    uint64 `protobuf:"varint,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotificationsResponse) Reset() {
+	*x = ListNotificationsResponse{}
+	mi := &file_notification_v1_notification_query_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotificationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationsResponse) ProtoMessage() {}
+
+func (x *ListNotificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_query_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationsResponse.ProtoReflect.Descriptor instead.
+func (*ListNotificationsResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_query_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListNotificationsResponse) GetNotifications() []*NotificationRecord {
+	if x != nil {
+		return x.Notifications
+	}
+	return nil
+}
+
+func (x *ListNotificationsResponse) GetNextCursor() uint64 {
+	if x != nil {
+		return x.NextCursor
+	}
+	return 0
+}
+
+// 通知记录
+type NotificationRecord struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 通知平台生成的通知ID
+	NotificationId uint64 `protobuf:"varint,1,opt,name=notification_id,json=notificationId,proto3" json:"notification_id,omitempty"`
+	// 业务方某个业务内部的唯一标识
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// 接收者(手机/邮箱/用户ID)
+	Receivers []string `protobuf:"bytes,3,rep,name=receivers,proto3" json:"receivers,omitempty"`
+	// 发送渠道
+	Channel Channel `protobuf:"varint,4,opt,name=channel,proto3,enum=notification.v1.Channel" json:"channel,omitempty"`
+	// 模版ID
+	TemplateId string `protobuf:"bytes,5,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	// 发送状态
+	Status SendStatus `protobuf:"varint,6,opt,name=status,proto3,enum=notification.v1.SendStatus" json:"status,omitempty"`
+	// 实际送达的渠道
+	DeliveredChannel Channel `protobuf:"varint,7,opt,name=delivered_channel,json=deliveredChannel,proto3,enum=notification.v1.Channel" json:"delivered_channel,omitempty"`
+	// 状态变更原因
+	StatusReason string `protobuf:"bytes,8,opt,name=status_reason,json=statusReason,proto3" json:"status_reason,omitempty"`
+	// 创建时间，毫秒
+	CtimeMilliseconds int64 `protobuf:"varint,9,opt,name=ctime_milliseconds,json=ctimeMilliseconds,proto3" json:"ctime_milliseconds,omitempty"`
+	// 更新时间，毫秒
+	UtimeMilliseconds int64 `protobuf:"varint,10,opt,name=utime_milliseconds,json=utimeMilliseconds,proto3" json:"utime_milliseconds,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *NotificationRecord) Reset() {
+	*x = NotificationRecord{}
+	mi := &file_notification_v1_notification_query_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationRecord) ProtoMessage() {}
+
+func (x *NotificationRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_query_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationRecord.ProtoReflect.Descriptor instead.
+func (*NotificationRecord) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_query_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *NotificationRecord) GetNotificationId() uint64 {
+	if x != nil {
+		return x.NotificationId
+	}
+	return 0
+}
+
+func (x *NotificationRecord) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *NotificationRecord) GetReceivers() []string {
+	if x != nil {
+		return x.Receivers
+	}
+	return nil
+}
+
+func (x *NotificationRecord) GetChannel() Channel {
+	if x != nil {
+		return x.Channel
+	}
+	return Channel_CHANNEL_UNSPECIFIED
+}
+
+func (x *NotificationRecord) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *NotificationRecord) GetStatus() SendStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SendStatus_SEND_STATUS_UNSPECIFIED
+}
+
+func (x *NotificationRecord) GetDeliveredChannel() Channel {
+	if x != nil {
+		return x.DeliveredChannel
+	}
+	return Channel_CHANNEL_UNSPECIFIED
+}
+
+func (x *NotificationRecord) GetStatusReason() string {
+	if x != nil {
+		return x.StatusReason
+	}
+	return ""
+}
+
+func (x *NotificationRecord) GetCtimeMilliseconds() int64 {
+	if x != nil {
+		return x.CtimeMilliseconds
+	}
+	return 0
+}
+
+func (x *NotificationRecord) GetUtimeMilliseconds() int64 {
+	if x != nil {
+		return x.UtimeMilliseconds
+	}
+	return 0
+}
+
+// 查询通知生命周期事件请求
+type ListNotificationEventsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 业务方某个业务内部的唯一标识
+	Key           string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotificationEventsRequest) Reset() {
+	*x = ListNotificationEventsRequest{}
+	mi := &file_notification_v1_notification_query_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotificationEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationEventsRequest) ProtoMessage() {}
+
+func (x *ListNotificationEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_query_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListNotificationEventsRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_query_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListNotificationEventsRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+// 查询通知生命周期事件响应
+type ListNotificationEventsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 通知平台生成的通知ID
+	NotificationId uint64               `protobuf:"varint,1,opt,name=notification_id,json=notificationId,proto3" json:"notification_id,omitempty"`
+	Events         []*NotificationEvent `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+	// 错误代码
+	ErrorCode ErrorCode `protobuf:"varint,3,opt,name=error_code,json=errorCode,proto3,enum=notification.v1.ErrorCode" json:"error_code,omitempty"`
+	// 错误详情
+	ErrorMessage  string `protobuf:"bytes,4,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotificationEventsResponse) Reset() {
+	*x = ListNotificationEventsResponse{}
+	mi := &file_notification_v1_notification_query_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotificationEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationEventsResponse) ProtoMessage() {}
+
+func (x *ListNotificationEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_query_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListNotificationEventsResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_query_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListNotificationEventsResponse) GetNotificationId() uint64 {
+	if x != nil {
+		return x.NotificationId
+	}
+	return 0
+}
+
+func (x *ListNotificationEventsResponse) GetEvents() []*NotificationEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ListNotificationEventsResponse) GetErrorCode() ErrorCode {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ErrorCode_ERROR_CODE_UNSPECIFIED
+}
+
+func (x *ListNotificationEventsResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+// 通知生命周期事件
+type NotificationEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 事件类型
+	Type NotificationEventType `protobuf:"varint,1,opt,name=type,proto3,enum=notification.v1.NotificationEventType" json:"type,omitempty"`
+	// 状态变更时为变更之后的状态，调用供应商时为本次调用的结果
+	Status SendStatus `protobuf:"varint,2,opt,name=status,proto3,enum=notification.v1.SendStatus" json:"status,omitempty"`
+	// 状态变更或者调用失败的原因
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// 发送渠道
+	Channel Channel `protobuf:"varint,4,opt,name=channel,proto3,enum=notification.v1.Channel" json:"channel,omitempty"`
+	// 供应商名称，仅调用供应商时有值
+	Provider string `protobuf:"bytes,5,opt,name=provider,proto3" json:"provider,omitempty"`
+	// 供应商返回的请求ID
+	VendorRequestId string `protobuf:"bytes,6,opt,name=vendor_request_id,json=vendorRequestId,proto3" json:"vendor_request_id,omitempty"`
+	// 供应商返回的状态码
+	VendorCode string `protobuf:"bytes,7,opt,name=vendor_code,json=vendorCode,proto3" json:"vendor_code,omitempty"`
+	// 供应商返回的描述
+	VendorMessage string `protobuf:"bytes,8,opt,name=vendor_message,json=vendorMessage,proto3" json:"vendor_message,omitempty"`
+	// 调用供应商耗时，毫秒
+	LatencyMilliseconds int64 `protobuf:"varint,9,opt,name=latency_milliseconds,json=latencyMilliseconds,proto3" json:"latency_milliseconds,omitempty"`
+	// 事件发生时间，毫秒
+	TimestampMilliseconds int64 `protobuf:"varint,10,opt,name=timestamp_milliseconds,json=timestampMilliseconds,proto3" json:"timestamp_milliseconds,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *NotificationEvent) Reset() {
+	*x = NotificationEvent{}
+	mi := &file_notification_v1_notification_query_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationEvent) ProtoMessage() {}
+
+func (x *NotificationEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_query_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationEvent.ProtoReflect.Descriptor instead.
+func (*NotificationEvent) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_query_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *NotificationEvent) GetType() NotificationEventType {
+	if x != nil {
+		return x.Type
+	}
+	return NotificationEventType_NOTIFICATION_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *NotificationEvent) GetStatus() SendStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SendStatus_SEND_STATUS_UNSPECIFIED
+}
+
+func (x *NotificationEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *NotificationEvent) GetChannel() Channel {
+	if x != nil {
+		return x.Channel
+	}
+	return Channel_CHANNEL_UNSPECIFIED
+}
+
+func (x *NotificationEvent) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *NotificationEvent) GetVendorRequestId() string {
+	if x != nil {
+		return x.VendorRequestId
+	}
+	return ""
+}
+
+func (x *NotificationEvent) GetVendorCode() string {
+	if x != nil {
+		return x.VendorCode
+	}
+	return ""
+}
+
+func (x *NotificationEvent) GetVendorMessage() string {
+	if x != nil {
+		return x.VendorMessage
+	}
+	return ""
+}
+
+func (x *NotificationEvent) GetLatencyMilliseconds() int64 {
+	if x != nil {
+		return x.LatencyMilliseconds
+	}
+	return 0
+}
+
+func (x *NotificationEvent) GetTimestampMilliseconds() int64 {
+	if x != nil {
+		return x.TimestampMilliseconds
+	}
+	return 0
+}
+
 var File_notification_v1_notification_query_proto protoreflect.FileDescriptor
 
 const file_notification_v1_notification_query_proto_rawDesc = "" +
@@ -215,10 +939,75 @@ const file_notification_v1_notification_query_proto_rawDesc = "" +
 	"\x1eBatchQueryNotificationsRequest\x12\x12\n" +
 	"\x04keys\x18\x01 \x03(\tR\x04keys\"f\n" +
 	"\x1fBatchQueryNotificationsResponse\x12C\n" +
-	"\aresults\x18\x01 \x03(\v2).notification.v1.SendNotificationResponseR\aresults2\x84\x02\n" +
+	"\aresults\x18\x01 \x03(\v2).notification.v1.SendNotificationResponseR\aresults\"G\n" +
+	"\x19WatchNotificationsRequest\x12\x12\n" +
+	"\x04keys\x18\x01 \x03(\tR\x04keys\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\tR\x06cursor\"\xdb\x01\n" +
+	"\x1aWatchNotificationsResponse\x12\x16\n" +
+	"\x06cursor\x18\x01 \x01(\tR\x06cursor\x12'\n" +
+	"\x0fnotification_id\x18\x02 \x01(\x04R\x0enotificationId\x12\x10\n" +
+	"\x03key\x18\x03 \x01(\tR\x03key\x123\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x1b.notification.v1.SendStatusR\x06status\x125\n" +
+	"\x16timestamp_milliseconds\x18\x05 \x01(\x03R\x15timestampMilliseconds\"\xda\x02\n" +
+	"\x18ListNotificationsRequest\x123\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x1b.notification.v1.SendStatusR\x06status\x122\n" +
+	"\achannel\x18\x02 \x01(\x0e2\x18.notification.v1.ChannelR\achannel\x12\x1f\n" +
+	"\vtemplate_id\x18\x03 \x01(\tR\n" +
+	"templateId\x12\x1a\n" +
+	"\breceiver\x18\x04 \x01(\tR\breceiver\x126\n" +
+	"\x17start_time_milliseconds\x18\x05 \x01(\x03R\x15startTimeMilliseconds\x122\n" +
+	"\x15end_time_milliseconds\x18\x06 \x01(\x03R\x13endTimeMilliseconds\x12\x16\n" +
+	"\x06cursor\x18\a \x01(\x04R\x06cursor\x12\x14\n" +
+	"\x05limit\x18\b \x01(\x05R\x05limit\"\x87\x01\n" +
+	"\x19ListNotificationsResponse\x12I\n" +
+	"\rnotifications\x18\x01 \x03(\v2#.notification.v1.NotificationRecordR\rnotifications\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\x04R\n" +
+	"nextCursor\"\xc1\x03\n" +
+	"\x12NotificationRecord\x12'\n" +
+	"\x0fnotification_id\x18\x01 \x01(\x04R\x0enotificationId\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12\x1c\n" +
+	"\treceivers\x18\x03 \x03(\tR\treceivers\x122\n" +
+	"\achannel\x18\x04 \x01(\x0e2\x18.notification.v1.ChannelR\achannel\x12\x1f\n" +
+	"\vtemplate_id\x18\x05 \x01(\tR\n" +
+	"templateId\x123\n" +
+	"\x06status\x18\x06 \x01(\x0e2\x1b.notification.v1.SendStatusR\x06status\x12E\n" +
+	"\x11delivered_channel\x18\a \x01(\x0e2\x18.notification.v1.ChannelR\x10deliveredChannel\x12#\n" +
+	"\rstatus_reason\x18\b \x01(\tR\fstatusReason\x12-\n" +
+	"\x12ctime_milliseconds\x18\t \x01(\x03R\x11ctimeMilliseconds\x12-\n" +
+	"\x12utime_milliseconds\x18\n" +
+	" \x01(\x03R\x11utimeMilliseconds\"1\n" +
+	"\x1dListNotificationEventsRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\"\xe5\x01\n" +
+	"\x1eListNotificationEventsResponse\x12'\n" +
+	"\x0fnotification_id\x18\x01 \x01(\x04R\x0enotificationId\x12:\n" +
+	"\x06events\x18\x02 \x03(\v2\".notification.v1.NotificationEventR\x06events\x129\n" +
+	"\n" +
+	"error_code\x18\x03 \x01(\x0e2\x1a.notification.v1.ErrorCodeR\terrorCode\x12#\n" +
+	"\rerror_message\x18\x04 \x01(\tR\ferrorMessage\"\xca\x03\n" +
+	"\x11NotificationEvent\x12:\n" +
+	"\x04type\x18\x01 \x01(\x0e2&.notification.v1.NotificationEventTypeR\x04type\x123\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1b.notification.v1.SendStatusR\x06status\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x122\n" +
+	"\achannel\x18\x04 \x01(\x0e2\x18.notification.v1.ChannelR\achannel\x12\x1a\n" +
+	"\bprovider\x18\x05 \x01(\tR\bprovider\x12*\n" +
+	"\x11vendor_request_id\x18\x06 \x01(\tR\x0fvendorRequestId\x12\x1f\n" +
+	"\vvendor_code\x18\a \x01(\tR\n" +
+	"vendorCode\x12%\n" +
+	"\x0evendor_message\x18\b \x01(\tR\rvendorMessage\x121\n" +
+	"\x14latency_milliseconds\x18\t \x01(\x03R\x13latencyMilliseconds\x125\n" +
+	"\x16timestamp_milliseconds\x18\n" +
+	" \x01(\x03R\x15timestampMilliseconds*f\n" +
+	"\x15NotificationEventType\x12'\n" +
+	"#NOTIFICATION_EVENT_TYPE_UNSPECIFIED\x10\x00\x12\x0e\n" +
+	"\n" +
+	"TRANSITION\x10\x01\x12\x14\n" +
+	"\x10PROVIDER_ATTEMPT\x10\x022\xdc\x04\n" +
 	"\x18NotificationQueryService\x12j\n" +
 	"\x11QueryNotification\x12).notification.v1.QueryNotificationRequest\x1a*.notification.v1.QueryNotificationResponse\x12|\n" +
-	"\x17BatchQueryNotifications\x12/.notification.v1.BatchQueryNotificationsRequest\x1a0.notification.v1.BatchQueryNotificationsResponseB\xe1\x01\n" +
+	"\x17BatchQueryNotifications\x12/.notification.v1.BatchQueryNotificationsRequest\x1a0.notification.v1.BatchQueryNotificationsResponse\x12o\n" +
+	"\x12WatchNotifications\x12*.notification.v1.WatchNotificationsRequest\x1a+.notification.v1.WatchNotificationsResponse0\x01\x12j\n" +
+	"\x11ListNotifications\x12).notification.v1.ListNotificationsRequest\x1a*.notification.v1.ListNotificationsResponse\x12y\n" +
+	"\x16ListNotificationEvents\x12..notification.v1.ListNotificationEventsRequest\x1a/.notification.v1.ListNotificationEventsResponseB\xe1\x01\n" +
 	"\x13com.notification.v1B\x16NotificationQueryProtoP\x01ZUgithub.com/robinlg/notification-platform/api/proto/gen/notification/v1;notificationv1\xa2\x02\x03NXX\xaa\x02\x0fNotification.V1\xca\x02\x0fNotification\\V1\xe2\x02\x1bNotification\\V1\\GPBMetadata\xea\x02\x10Notification::V1b\x06proto3"
 
 var (
@@ -233,26 +1022,57 @@ func file_notification_v1_notification_query_proto_rawDescGZIP() []byte {
 	return file_notification_v1_notification_query_proto_rawDescData
 }
 
-var file_notification_v1_notification_query_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_notification_v1_notification_query_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_notification_v1_notification_query_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_notification_v1_notification_query_proto_goTypes = []any{
-	(*QueryNotificationRequest)(nil),        // 0: notification.v1.QueryNotificationRequest
-	(*QueryNotificationResponse)(nil),       // 1: notification.v1.QueryNotificationResponse
-	(*BatchQueryNotificationsRequest)(nil),  // 2: notification.v1.BatchQueryNotificationsRequest
-	(*BatchQueryNotificationsResponse)(nil), // 3: notification.v1.BatchQueryNotificationsResponse
-	(*SendNotificationResponse)(nil),        // 4: notification.v1.SendNotificationResponse
+	(NotificationEventType)(0),              // 0: notification.v1.NotificationEventType
+	(*QueryNotificationRequest)(nil),        // 1: notification.v1.QueryNotificationRequest
+	(*QueryNotificationResponse)(nil),       // 2: notification.v1.QueryNotificationResponse
+	(*BatchQueryNotificationsRequest)(nil),  // 3: notification.v1.BatchQueryNotificationsRequest
+	(*BatchQueryNotificationsResponse)(nil), // 4: notification.v1.BatchQueryNotificationsResponse
+	(*WatchNotificationsRequest)(nil),       // 5: notification.v1.WatchNotificationsRequest
+	(*WatchNotificationsResponse)(nil),      // 6: notification.v1.WatchNotificationsResponse
+	(*ListNotificationsRequest)(nil),        // 7: notification.v1.ListNotificationsRequest
+	(*ListNotificationsResponse)(nil),       // 8: notification.v1.ListNotificationsResponse
+	(*NotificationRecord)(nil),              // 9: notification.v1.NotificationRecord
+	(*ListNotificationEventsRequest)(nil),   // 10: notification.v1.ListNotificationEventsRequest
+	(*ListNotificationEventsResponse)(nil),  // 11: notification.v1.ListNotificationEventsResponse
+	(*NotificationEvent)(nil),               // 12: notification.v1.NotificationEvent
+	(*SendNotificationResponse)(nil),        // 13: notification.v1.SendNotificationResponse
+	(SendStatus)(0),                         // 14: notification.v1.SendStatus
+	(Channel)(0),                            // 15: notification.v1.Channel
+	(ErrorCode)(0),                          // 16: notification.v1.ErrorCode
 }
 var file_notification_v1_notification_query_proto_depIdxs = []int32{
-	4, // 0: notification.v1.QueryNotificationResponse.result:type_name -> notification.v1.SendNotificationResponse
-	4, // 1: notification.v1.BatchQueryNotificationsResponse.results:type_name -> notification.v1.SendNotificationResponse
-	0, // 2: notification.v1.NotificationQueryService.QueryNotification:input_type -> notification.v1.QueryNotificationRequest
-	2, // 3: notification.v1.NotificationQueryService.BatchQueryNotifications:input_type -> notification.v1.BatchQueryNotificationsRequest
-	1, // 4: notification.v1.NotificationQueryService.QueryNotification:output_type -> notification.v1.QueryNotificationResponse
-	3, // 5: notification.v1.NotificationQueryService.BatchQueryNotifications:output_type -> notification.v1.BatchQueryNotificationsResponse
-	4, // [4:6] is the sub-list for method output_type
-	2, // [2:4] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	13, // 0: notification.v1.QueryNotificationResponse.result:type_name -> notification.v1.SendNotificationResponse
+	13, // 1: notification.v1.BatchQueryNotificationsResponse.results:type_name -> notification.v1.SendNotificationResponse
+	14, // 2: notification.v1.WatchNotificationsResponse.status:type_name -> notification.v1.SendStatus
+	14, // 3: notification.v1.ListNotificationsRequest.status:type_name -> notification.v1.SendStatus
+	15, // 4: notification.v1.ListNotificationsRequest.channel:type_name -> notification.v1.Channel
+	9,  // 5: notification.v1.ListNotificationsResponse.notifications:type_name -> notification.v1.NotificationRecord
+	15, // 6: notification.v1.NotificationRecord.channel:type_name -> notification.v1.Channel
+	14, // 7: notification.v1.NotificationRecord.status:type_name -> notification.v1.SendStatus
+	15, // 8: notification.v1.NotificationRecord.delivered_channel:type_name -> notification.v1.Channel
+	12, // 9: notification.v1.ListNotificationEventsResponse.events:type_name -> notification.v1.NotificationEvent
+	16, // 10: notification.v1.ListNotificationEventsResponse.error_code:type_name -> notification.v1.ErrorCode
+	0,  // 11: notification.v1.NotificationEvent.type:type_name -> notification.v1.NotificationEventType
+	14, // 12: notification.v1.NotificationEvent.status:type_name -> notification.v1.SendStatus
+	15, // 13: notification.v1.NotificationEvent.channel:type_name -> notification.v1.Channel
+	1,  // 14: notification.v1.NotificationQueryService.QueryNotification:input_type -> notification.v1.QueryNotificationRequest
+	3,  // 15: notification.v1.NotificationQueryService.BatchQueryNotifications:input_type -> notification.v1.BatchQueryNotificationsRequest
+	5,  // 16: notification.v1.NotificationQueryService.WatchNotifications:input_type -> notification.v1.WatchNotificationsRequest
+	7,  // 17: notification.v1.NotificationQueryService.ListNotifications:input_type -> notification.v1.ListNotificationsRequest
+	10, // 18: notification.v1.NotificationQueryService.ListNotificationEvents:input_type -> notification.v1.ListNotificationEventsRequest
+	2,  // 19: notification.v1.NotificationQueryService.QueryNotification:output_type -> notification.v1.QueryNotificationResponse
+	4,  // 20: notification.v1.NotificationQueryService.BatchQueryNotifications:output_type -> notification.v1.BatchQueryNotificationsResponse
+	6,  // 21: notification.v1.NotificationQueryService.WatchNotifications:output_type -> notification.v1.WatchNotificationsResponse
+	8,  // 22: notification.v1.NotificationQueryService.ListNotifications:output_type -> notification.v1.ListNotificationsResponse
+	11, // 23: notification.v1.NotificationQueryService.ListNotificationEvents:output_type -> notification.v1.ListNotificationEventsResponse
+	19, // [19:24] is the sub-list for method output_type
+	14, // [14:19] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_notification_v1_notification_query_proto_init() }
@@ -266,13 +1086,14 @@ func file_notification_v1_notification_query_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_notification_v1_notification_query_proto_rawDesc), len(file_notification_v1_notification_query_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   4,
+			NumEnums:      1,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_notification_v1_notification_query_proto_goTypes,
 		DependencyIndexes: file_notification_v1_notification_query_proto_depIdxs,
+		EnumInfos:         file_notification_v1_notification_query_proto_enumTypes,
 		MessageInfos:      file_notification_v1_notification_query_proto_msgTypes,
 	}.Build()
 	File_notification_v1_notification_query_proto = out.File
@@ -509,3 +509,965 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = BatchQueryNotificationsResponseValidationError{}
+
+// Validate checks the field values on WatchNotificationsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchNotificationsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchNotificationsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchNotificationsRequestMultiError, or nil if none found.
+func (m *WatchNotificationsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchNotificationsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Cursor
+
+	if len(errors) > 0 {
+		return WatchNotificationsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchNotificationsRequestMultiError is an error wrapping multiple validation
+// errors returned by WatchNotificationsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type WatchNotificationsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchNotificationsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchNotificationsRequestMultiError) AllErrors() []error { return m }
+
+// WatchNotificationsRequestValidationError is the validation error returned by
+// WatchNotificationsRequest.Validate if the designated constraints aren't met.
+type WatchNotificationsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchNotificationsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchNotificationsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchNotificationsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchNotificationsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchNotificationsRequestValidationError) ErrorName() string {
+	return "WatchNotificationsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchNotificationsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchNotificationsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchNotificationsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchNotificationsRequestValidationError{}
+
+// Validate checks the field values on WatchNotificationsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchNotificationsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchNotificationsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchNotificationsResponseMultiError, or nil if none found.
+func (m *WatchNotificationsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchNotificationsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Cursor
+
+	// no validation rules for NotificationId
+
+	// no validation rules for Key
+
+	// no validation rules for Status
+
+	// no validation rules for TimestampMilliseconds
+
+	if len(errors) > 0 {
+		return WatchNotificationsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchNotificationsResponseMultiError is an error wrapping multiple
+// validation errors returned by WatchNotificationsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type WatchNotificationsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchNotificationsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchNotificationsResponseMultiError) AllErrors() []error { return m }
+
+// WatchNotificationsResponseValidationError is the validation error returned
+// by WatchNotificationsResponse.Validate if the designated constraints aren't met.
+type WatchNotificationsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchNotificationsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchNotificationsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchNotificationsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchNotificationsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchNotificationsResponseValidationError) ErrorName() string {
+	return "WatchNotificationsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchNotificationsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchNotificationsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchNotificationsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchNotificationsResponseValidationError{}
+
+// Validate checks the field values on ListNotificationsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListNotificationsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListNotificationsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListNotificationsRequestMultiError, or nil if none found.
+func (m *ListNotificationsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListNotificationsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	// no validation rules for Channel
+
+	// no validation rules for TemplateId
+
+	// no validation rules for Receiver
+
+	// no validation rules for StartTimeMilliseconds
+
+	// no validation rules for EndTimeMilliseconds
+
+	// no validation rules for Cursor
+
+	// no validation rules for Limit
+
+	if len(errors) > 0 {
+		return ListNotificationsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListNotificationsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListNotificationsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListNotificationsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListNotificationsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListNotificationsRequestMultiError) AllErrors() []error { return m }
+
+// ListNotificationsRequestValidationError is the validation error returned by
+// ListNotificationsRequest.Validate if the designated constraints aren't met.
+type ListNotificationsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListNotificationsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListNotificationsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListNotificationsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListNotificationsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListNotificationsRequestValidationError) ErrorName() string {
+	return "ListNotificationsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListNotificationsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListNotificationsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListNotificationsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListNotificationsRequestValidationError{}
+
+// Validate checks the field values on ListNotificationsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListNotificationsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListNotificationsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListNotificationsResponseMultiError, or nil if none found.
+func (m *ListNotificationsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListNotificationsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetNotifications() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListNotificationsResponseValidationError{
+						field:  fmt.Sprintf("Notifications[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListNotificationsResponseValidationError{
+						field:  fmt.Sprintf("Notifications[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListNotificationsResponseValidationError{
+					field:  fmt.Sprintf("Notifications[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextCursor
+
+	if len(errors) > 0 {
+		return ListNotificationsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListNotificationsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListNotificationsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ListNotificationsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListNotificationsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListNotificationsResponseMultiError) AllErrors() []error { return m }
+
+// ListNotificationsResponseValidationError is the validation error returned by
+// ListNotificationsResponse.Validate if the designated constraints aren't met.
+type ListNotificationsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListNotificationsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListNotificationsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListNotificationsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListNotificationsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListNotificationsResponseValidationError) ErrorName() string {
+	return "ListNotificationsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListNotificationsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListNotificationsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListNotificationsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListNotificationsResponseValidationError{}
+
+// Validate checks the field values on NotificationRecord with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *NotificationRecord) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NotificationRecord with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// NotificationRecordMultiError, or nil if none found.
+func (m *NotificationRecord) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NotificationRecord) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for NotificationId
+
+	// no validation rules for Key
+
+	// no validation rules for Channel
+
+	// no validation rules for TemplateId
+
+	// no validation rules for Status
+
+	// no validation rules for DeliveredChannel
+
+	// no validation rules for StatusReason
+
+	// no validation rules for CtimeMilliseconds
+
+	// no validation rules for UtimeMilliseconds
+
+	if len(errors) > 0 {
+		return NotificationRecordMultiError(errors)
+	}
+
+	return nil
+}
+
+// NotificationRecordMultiError is an error wrapping multiple validation errors
+// returned by NotificationRecord.ValidateAll() if the designated constraints
+// aren't met.
+type NotificationRecordMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NotificationRecordMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NotificationRecordMultiError) AllErrors() []error { return m }
+
+// NotificationRecordValidationError is the validation error returned by
+// NotificationRecord.Validate if the designated constraints aren't met.
+type NotificationRecordValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NotificationRecordValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NotificationRecordValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NotificationRecordValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NotificationRecordValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NotificationRecordValidationError) ErrorName() string {
+	return "NotificationRecordValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e NotificationRecordValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNotificationRecord.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NotificationRecordValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NotificationRecordValidationError{}
+
+// Validate checks the field values on ListNotificationEventsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListNotificationEventsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListNotificationEventsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListNotificationEventsRequestMultiError, or nil if none found.
+func (m *ListNotificationEventsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListNotificationEventsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Key
+
+	if len(errors) > 0 {
+		return ListNotificationEventsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListNotificationEventsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListNotificationEventsRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ListNotificationEventsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListNotificationEventsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListNotificationEventsRequestMultiError) AllErrors() []error { return m }
+
+// ListNotificationEventsRequestValidationError is the validation error
+// returned by ListNotificationEventsRequest.Validate if the designated
+// constraints aren't met.
+type ListNotificationEventsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListNotificationEventsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListNotificationEventsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListNotificationEventsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListNotificationEventsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListNotificationEventsRequestValidationError) ErrorName() string {
+	return "ListNotificationEventsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListNotificationEventsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListNotificationEventsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListNotificationEventsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListNotificationEventsRequestValidationError{}
+
+// Validate checks the field values on ListNotificationEventsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListNotificationEventsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListNotificationEventsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListNotificationEventsResponseMultiError, or nil if none found.
+func (m *ListNotificationEventsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListNotificationEventsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for NotificationId
+
+	for idx, item := range m.GetEvents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListNotificationEventsResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListNotificationEventsResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListNotificationEventsResponseValidationError{
+					field:  fmt.Sprintf("Events[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for ErrorCode
+
+	// no validation rules for ErrorMessage
+
+	if len(errors) > 0 {
+		return ListNotificationEventsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListNotificationEventsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListNotificationEventsResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ListNotificationEventsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListNotificationEventsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListNotificationEventsResponseMultiError) AllErrors() []error { return m }
+
+// ListNotificationEventsResponseValidationError is the validation error
+// returned by ListNotificationEventsResponse.Validate if the designated
+// constraints aren't met.
+type ListNotificationEventsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListNotificationEventsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListNotificationEventsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListNotificationEventsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListNotificationEventsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListNotificationEventsResponseValidationError) ErrorName() string {
+	return "ListNotificationEventsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListNotificationEventsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListNotificationEventsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListNotificationEventsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListNotificationEventsResponseValidationError{}
+
+// Validate checks the field values on NotificationEvent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *NotificationEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NotificationEvent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// NotificationEventMultiError, or nil if none found.
+func (m *NotificationEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NotificationEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Type
+
+	// no validation rules for Status
+
+	// no validation rules for Reason
+
+	// no validation rules for Channel
+
+	// no validation rules for Provider
+
+	// no validation rules for VendorRequestId
+
+	// no validation rules for VendorCode
+
+	// no validation rules for VendorMessage
+
+	// no validation rules for LatencyMilliseconds
+
+	// no validation rules for TimestampMilliseconds
+
+	if len(errors) > 0 {
+		return NotificationEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// NotificationEventMultiError is an error wrapping multiple validation errors
+// returned by NotificationEvent.ValidateAll() if the designated constraints
+// aren't met.
+type NotificationEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NotificationEventMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NotificationEventMultiError) AllErrors() []error { return m }
+
+// NotificationEventValidationError is the validation error returned by
+// NotificationEvent.Validate if the designated constraints aren't met.
+type NotificationEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NotificationEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NotificationEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NotificationEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NotificationEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NotificationEventValidationError) ErrorName() string {
+	return "NotificationEventValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e NotificationEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNotificationEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NotificationEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NotificationEventValidationError{}
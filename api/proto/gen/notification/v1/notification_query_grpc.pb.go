@@ -21,6 +21,9 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	NotificationQueryService_QueryNotification_FullMethodName       = "/notification.v1.NotificationQueryService/QueryNotification"
 	NotificationQueryService_BatchQueryNotifications_FullMethodName = "/notification.v1.NotificationQueryService/BatchQueryNotifications"
+	NotificationQueryService_WatchNotifications_FullMethodName      = "/notification.v1.NotificationQueryService/WatchNotifications"
+	NotificationQueryService_ListNotifications_FullMethodName       = "/notification.v1.NotificationQueryService/ListNotifications"
+	NotificationQueryService_ListNotificationEvents_FullMethodName  = "/notification.v1.NotificationQueryService/ListNotificationEvents"
 )
 
 // NotificationQueryServiceClient is the client API for NotificationQueryService service.
@@ -33,6 +36,14 @@ type NotificationQueryServiceClient interface {
 	QueryNotification(ctx context.Context, in *QueryNotificationRequest, opts ...grpc.CallOption) (*QueryNotificationResponse, error)
 	// 批量查询
 	BatchQueryNotifications(ctx context.Context, in *BatchQueryNotificationsRequest, opts ...grpc.CallOption) (*BatchQueryNotificationsResponse, error)
+	// 订阅通知状态变更，持续推送直到客户端断开
+	// 断线重连时带上最后收到的 cursor，可以接着推送期间错过的状态变更
+	// 状态变更不会丢失，但是同一个状态变更可能推送多次
+	WatchNotifications(ctx context.Context, in *WatchNotificationsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchNotificationsResponse], error)
+	// 按照条件查询通知列表，按照通知ID倒序排列，也就是大致按照创建时间倒序排列，使用游标分页
+	ListNotifications(ctx context.Context, in *ListNotificationsRequest, opts ...grpc.CallOption) (*ListNotificationsResponse, error)
+	// 查询单条通知的生命周期事件，包括每一次状态变更和每一次调用供应商的结果，按照发生顺序排列
+	ListNotificationEvents(ctx context.Context, in *ListNotificationEventsRequest, opts ...grpc.CallOption) (*ListNotificationEventsResponse, error)
 }
 
 type notificationQueryServiceClient struct {
@@ -63,6 +74,45 @@ func (c *notificationQueryServiceClient) BatchQueryNotifications(ctx context.Con
 	return out, nil
 }
 
+func (c *notificationQueryServiceClient) WatchNotifications(ctx context.Context, in *WatchNotificationsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchNotificationsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &NotificationQueryService_ServiceDesc.Streams[0], NotificationQueryService_WatchNotifications_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchNotificationsRequest, WatchNotificationsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type NotificationQueryService_WatchNotificationsClient = grpc.ServerStreamingClient[WatchNotificationsResponse]
+
+func (c *notificationQueryServiceClient) ListNotifications(ctx context.Context, in *ListNotificationsRequest, opts ...grpc.CallOption) (*ListNotificationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListNotificationsResponse)
+	err := c.cc.Invoke(ctx, NotificationQueryService_ListNotifications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationQueryServiceClient) ListNotificationEvents(ctx context.Context, in *ListNotificationEventsRequest, opts ...grpc.CallOption) (*ListNotificationEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListNotificationEventsResponse)
+	err := c.cc.Invoke(ctx, NotificationQueryService_ListNotificationEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NotificationQueryServiceServer is the server API for NotificationQueryService service.
 // All implementations should embed UnimplementedNotificationQueryServiceServer
 // for forward compatibility.
@@ -73,6 +123,14 @@ type NotificationQueryServiceServer interface {
 	QueryNotification(context.Context, *QueryNotificationRequest) (*QueryNotificationResponse, error)
 	// 批量查询
 	BatchQueryNotifications(context.Context, *BatchQueryNotificationsRequest) (*BatchQueryNotificationsResponse, error)
+	// 订阅通知状态变更，持续推送直到客户端断开
+	// 断线重连时带上最后收到的 cursor，可以接着推送期间错过的状态变更
+	// 状态变更不会丢失，但是同一个状态变更可能推送多次
+	WatchNotifications(*WatchNotificationsRequest, grpc.ServerStreamingServer[WatchNotificationsResponse]) error
+	// 按照条件查询通知列表，按照通知ID倒序排列，也就是大致按照创建时间倒序排列，使用游标分页
+	ListNotifications(context.Context, *ListNotificationsRequest) (*ListNotificationsResponse, error)
+	// 查询单条通知的生命周期事件，包括每一次状态变更和每一次调用供应商的结果，按照发生顺序排列
+	ListNotificationEvents(context.Context, *ListNotificationEventsRequest) (*ListNotificationEventsResponse, error)
 }
 
 // UnimplementedNotificationQueryServiceServer should be embedded to have
@@ -88,6 +146,15 @@ func (UnimplementedNotificationQueryServiceServer) QueryNotification(context.Con
 func (UnimplementedNotificationQueryServiceServer) BatchQueryNotifications(context.Context, *BatchQueryNotificationsRequest) (*BatchQueryNotificationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BatchQueryNotifications not implemented")
 }
+func (UnimplementedNotificationQueryServiceServer) WatchNotifications(*WatchNotificationsRequest, grpc.ServerStreamingServer[WatchNotificationsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchNotifications not implemented")
+}
+func (UnimplementedNotificationQueryServiceServer) ListNotifications(context.Context, *ListNotificationsRequest) (*ListNotificationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNotifications not implemented")
+}
+func (UnimplementedNotificationQueryServiceServer) ListNotificationEvents(context.Context, *ListNotificationEventsRequest) (*ListNotificationEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNotificationEvents not implemented")
+}
 func (UnimplementedNotificationQueryServiceServer) testEmbeddedByValue() {}
 
 // UnsafeNotificationQueryServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -144,6 +211,53 @@ func _NotificationQueryService_BatchQueryNotifications_Handler(srv interface{},
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NotificationQueryService_WatchNotifications_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchNotificationsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NotificationQueryServiceServer).WatchNotifications(m, &grpc.GenericServerStream[WatchNotificationsRequest, WatchNotificationsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type NotificationQueryService_WatchNotificationsServer = grpc.ServerStreamingServer[WatchNotificationsResponse]
+
+func _NotificationQueryService_ListNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNotificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationQueryServiceServer).ListNotifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationQueryService_ListNotifications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationQueryServiceServer).ListNotifications(ctx, req.(*ListNotificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationQueryService_ListNotificationEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNotificationEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationQueryServiceServer).ListNotificationEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationQueryService_ListNotificationEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationQueryServiceServer).ListNotificationEvents(ctx, req.(*ListNotificationEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // NotificationQueryService_ServiceDesc is the grpc.ServiceDesc for NotificationQueryService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -159,7 +273,21 @@ var NotificationQueryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BatchQueryNotifications",
 			Handler:    _NotificationQueryService_BatchQueryNotifications_Handler,
 		},
+		{
+			MethodName: "ListNotifications",
+			Handler:    _NotificationQueryService_ListNotifications_Handler,
+		},
+		{
+			MethodName: "ListNotificationEvents",
+			Handler:    _NotificationQueryService_ListNotificationEvents_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchNotifications",
+			Handler:       _NotificationQueryService_WatchNotifications_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "notification/v1/notification_query.proto",
 }
@@ -0,0 +1,190 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: notification/v1/provider.proto
+
+package notificationv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 查询供应商当日剩余请求数请求
+type GetProviderDailyRemainingRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 供应商ID
+	ProviderId    int64 `protobuf:"varint,1,opt,name=provider_id,json=providerId,proto3" json:"provider_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProviderDailyRemainingRequest) Reset() {
+	*x = GetProviderDailyRemainingRequest{}
+	mi := &file_notification_v1_provider_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProviderDailyRemainingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProviderDailyRemainingRequest) ProtoMessage() {}
+
+func (x *GetProviderDailyRemainingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_provider_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProviderDailyRemainingRequest.ProtoReflect.Descriptor instead.
+func (*GetProviderDailyRemainingRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_provider_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetProviderDailyRemainingRequest) GetProviderId() int64 {
+	if x != nil {
+		return x.ProviderId
+	}
+	return 0
+}
+
+// 查询供应商当日剩余请求数响应
+type GetProviderDailyRemainingResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 供应商ID
+	ProviderId int64 `protobuf:"varint,1,opt,name=provider_id,json=providerId,proto3" json:"provider_id,omitempty"`
+	// 当日剩余的请求数
+	Remaining     int64 `protobuf:"varint,2,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProviderDailyRemainingResponse) Reset() {
+	*x = GetProviderDailyRemainingResponse{}
+	mi := &file_notification_v1_provider_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProviderDailyRemainingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProviderDailyRemainingResponse) ProtoMessage() {}
+
+func (x *GetProviderDailyRemainingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_provider_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProviderDailyRemainingResponse.ProtoReflect.Descriptor instead.
+func (*GetProviderDailyRemainingResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_provider_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetProviderDailyRemainingResponse) GetProviderId() int64 {
+	if x != nil {
+		return x.ProviderId
+	}
+	return 0
+}
+
+func (x *GetProviderDailyRemainingResponse) GetRemaining() int64 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+var File_notification_v1_provider_proto protoreflect.FileDescriptor
+
+const file_notification_v1_provider_proto_rawDesc = "" +
+	"\n" +
+	"\x1enotification/v1/provider.proto\x12\x0fnotification.v1\"C\n" +
+	" GetProviderDailyRemainingRequest\x12\x1f\n" +
+	"\vprovider_id\x18\x01 \x01(\x03R\n" +
+	"providerId\"b\n" +
+	"!GetProviderDailyRemainingResponse\x12\x1f\n" +
+	"\vprovider_id\x18\x01 \x01(\x03R\n" +
+	"providerId\x12\x1c\n" +
+	"\tremaining\x18\x02 \x01(\x03R\tremaining2\x96\x01\n" +
+	"\x0fProviderService\x12\x82\x01\n" +
+	"\x19GetProviderDailyRemaining\x121.notification.v1.GetProviderDailyRemainingRequest\x1a2.notification.v1.GetProviderDailyRemainingResponseB\xd8\x01\n" +
+	"\x13com.notification.v1B\rProviderProtoP\x01ZUgithub.com/robinlg/notification-platform/api/proto/gen/notification/v1;notificationv1\xa2\x02\x03NXX\xaa\x02\x0fNotification.V1\xca\x02\x0fNotification\\V1\xe2\x02\x1bNotification\\V1\\GPBMetadata\xea\x02\x10Notification::V1b\x06proto3"
+
+var (
+	file_notification_v1_provider_proto_rawDescOnce sync.Once
+	file_notification_v1_provider_proto_rawDescData []byte
+)
+
+func file_notification_v1_provider_proto_rawDescGZIP() []byte {
+	file_notification_v1_provider_proto_rawDescOnce.Do(func() {
+		file_notification_v1_provider_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_notification_v1_provider_proto_rawDesc), len(file_notification_v1_provider_proto_rawDesc)))
+	})
+	return file_notification_v1_provider_proto_rawDescData
+}
+
+var file_notification_v1_provider_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_notification_v1_provider_proto_goTypes = []any{
+	(*GetProviderDailyRemainingRequest)(nil),  // 0: notification.v1.GetProviderDailyRemainingRequest
+	(*GetProviderDailyRemainingResponse)(nil), // 1: notification.v1.GetProviderDailyRemainingResponse
+}
+var file_notification_v1_provider_proto_depIdxs = []int32{
+	0, // 0: notification.v1.ProviderService.GetProviderDailyRemaining:input_type -> notification.v1.GetProviderDailyRemainingRequest
+	1, // 1: notification.v1.ProviderService.GetProviderDailyRemaining:output_type -> notification.v1.GetProviderDailyRemainingResponse
+	1, // [1:2] is the sub-list for method output_type
+	0, // [0:1] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_notification_v1_provider_proto_init() }
+func file_notification_v1_provider_proto_init() {
+	if File_notification_v1_provider_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_notification_v1_provider_proto_rawDesc), len(file_notification_v1_provider_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_notification_v1_provider_proto_goTypes,
+		DependencyIndexes: file_notification_v1_provider_proto_depIdxs,
+		MessageInfos:      file_notification_v1_provider_proto_msgTypes,
+	}.Build()
+	File_notification_v1_provider_proto = out.File
+	file_notification_v1_provider_proto_goTypes = nil
+	file_notification_v1_provider_proto_depIdxs = nil
+}
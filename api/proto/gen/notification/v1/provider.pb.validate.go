@@ -0,0 +1,252 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: notification/v1/provider.proto
+
+package notificationv1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on GetProviderDailyRemainingRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetProviderDailyRemainingRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetProviderDailyRemainingRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetProviderDailyRemainingRequestMultiError, or nil if none found.
+func (m *GetProviderDailyRemainingRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetProviderDailyRemainingRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ProviderId
+
+	if len(errors) > 0 {
+		return GetProviderDailyRemainingRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetProviderDailyRemainingRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// GetProviderDailyRemainingRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetProviderDailyRemainingRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetProviderDailyRemainingRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetProviderDailyRemainingRequestMultiError) AllErrors() []error { return m }
+
+// GetProviderDailyRemainingRequestValidationError is the validation error
+// returned by GetProviderDailyRemainingRequest.Validate if the designated
+// constraints aren't met.
+type GetProviderDailyRemainingRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetProviderDailyRemainingRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetProviderDailyRemainingRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetProviderDailyRemainingRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetProviderDailyRemainingRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetProviderDailyRemainingRequestValidationError) ErrorName() string {
+	return "GetProviderDailyRemainingRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetProviderDailyRemainingRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetProviderDailyRemainingRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetProviderDailyRemainingRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetProviderDailyRemainingRequestValidationError{}
+
+// Validate checks the field values on GetProviderDailyRemainingResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetProviderDailyRemainingResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetProviderDailyRemainingResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetProviderDailyRemainingResponseMultiError, or nil if none found.
+func (m *GetProviderDailyRemainingResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetProviderDailyRemainingResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ProviderId
+
+	// no validation rules for Remaining
+
+	if len(errors) > 0 {
+		return GetProviderDailyRemainingResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetProviderDailyRemainingResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// GetProviderDailyRemainingResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetProviderDailyRemainingResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetProviderDailyRemainingResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetProviderDailyRemainingResponseMultiError) AllErrors() []error { return m }
+
+// GetProviderDailyRemainingResponseValidationError is the validation error
+// returned by GetProviderDailyRemainingResponse.Validate if the designated
+// constraints aren't met.
+type GetProviderDailyRemainingResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetProviderDailyRemainingResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetProviderDailyRemainingResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetProviderDailyRemainingResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetProviderDailyRemainingResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetProviderDailyRemainingResponseValidationError) ErrorName() string {
+	return "GetProviderDailyRemainingResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetProviderDailyRemainingResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetProviderDailyRemainingResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetProviderDailyRemainingResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetProviderDailyRemainingResponseValidationError{}
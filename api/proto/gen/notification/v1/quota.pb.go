@@ -0,0 +1,414 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: notification/v1/quota.proto
+
+package notificationv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 渠道额度
+type ChannelQuota struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 渠道
+	Channel Channel `protobuf:"varint,1,opt,name=channel,proto3,enum=notification.v1.Channel" json:"channel,omitempty"`
+	// 额度周期，格式为 2006-01
+	Period string `protobuf:"bytes,2,opt,name=period,proto3" json:"period,omitempty"`
+	// 本周期的额度总量，包含手动调整的额度
+	Total int64 `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	// 已使用的额度
+	Used int64 `protobuf:"varint,4,opt,name=used,proto3" json:"used,omitempty"`
+	// 剩余额度
+	Remaining     int64 `protobuf:"varint,5,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChannelQuota) Reset() {
+	*x = ChannelQuota{}
+	mi := &file_notification_v1_quota_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelQuota) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelQuota) ProtoMessage() {}
+
+func (x *ChannelQuota) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_quota_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelQuota.ProtoReflect.Descriptor instead.
+func (*ChannelQuota) Descriptor() ([]byte, []int) {
+	return file_notification_v1_quota_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ChannelQuota) GetChannel() Channel {
+	if x != nil {
+		return x.Channel
+	}
+	return Channel_CHANNEL_UNSPECIFIED
+}
+
+func (x *ChannelQuota) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *ChannelQuota) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ChannelQuota) GetUsed() int64 {
+	if x != nil {
+		return x.Used
+	}
+	return 0
+}
+
+func (x *ChannelQuota) GetRemaining() int64 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+// 查询额度请求
+type GetQuotaRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 渠道，未指定时返回全部渠道
+	Channel       Channel `protobuf:"varint,1,opt,name=channel,proto3,enum=notification.v1.Channel" json:"channel,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQuotaRequest) Reset() {
+	*x = GetQuotaRequest{}
+	mi := &file_notification_v1_quota_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQuotaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuotaRequest) ProtoMessage() {}
+
+func (x *GetQuotaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_quota_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuotaRequest.ProtoReflect.Descriptor instead.
+func (*GetQuotaRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_quota_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetQuotaRequest) GetChannel() Channel {
+	if x != nil {
+		return x.Channel
+	}
+	return Channel_CHANNEL_UNSPECIFIED
+}
+
+// 查询额度响应
+type GetQuotaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Quotas        []*ChannelQuota        `protobuf:"bytes,1,rep,name=quotas,proto3" json:"quotas,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQuotaResponse) Reset() {
+	*x = GetQuotaResponse{}
+	mi := &file_notification_v1_quota_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQuotaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuotaResponse) ProtoMessage() {}
+
+func (x *GetQuotaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_quota_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuotaResponse.ProtoReflect.Descriptor instead.
+func (*GetQuotaResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_quota_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetQuotaResponse) GetQuotas() []*ChannelQuota {
+	if x != nil {
+		return x.Quotas
+	}
+	return nil
+}
+
+// 调整额度请求
+type AdjustQuotaRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 业务ID
+	BizId int64 `protobuf:"varint,1,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	// 渠道
+	Channel Channel `protobuf:"varint,2,opt,name=channel,proto3,enum=notification.v1.Channel" json:"channel,omitempty"`
+	// 调整量，正数为充值，负数为扣减
+	Delta int64 `protobuf:"varint,3,opt,name=delta,proto3" json:"delta,omitempty"`
+	// 操作人
+	Operator string `protobuf:"bytes,4,opt,name=operator,proto3" json:"operator,omitempty"`
+	// 调整原因
+	Reason        string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdjustQuotaRequest) Reset() {
+	*x = AdjustQuotaRequest{}
+	mi := &file_notification_v1_quota_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdjustQuotaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjustQuotaRequest) ProtoMessage() {}
+
+func (x *AdjustQuotaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_quota_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdjustQuotaRequest.ProtoReflect.Descriptor instead.
+func (*AdjustQuotaRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_quota_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AdjustQuotaRequest) GetBizId() int64 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+func (x *AdjustQuotaRequest) GetChannel() Channel {
+	if x != nil {
+		return x.Channel
+	}
+	return Channel_CHANNEL_UNSPECIFIED
+}
+
+func (x *AdjustQuotaRequest) GetDelta() int64 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *AdjustQuotaRequest) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *AdjustQuotaRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// 调整额度响应
+type AdjustQuotaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Quota         *ChannelQuota          `protobuf:"bytes,1,opt,name=quota,proto3" json:"quota,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdjustQuotaResponse) Reset() {
+	*x = AdjustQuotaResponse{}
+	mi := &file_notification_v1_quota_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdjustQuotaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjustQuotaResponse) ProtoMessage() {}
+
+func (x *AdjustQuotaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_quota_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdjustQuotaResponse.ProtoReflect.Descriptor instead.
+func (*AdjustQuotaResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_quota_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AdjustQuotaResponse) GetQuota() *ChannelQuota {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+var File_notification_v1_quota_proto protoreflect.FileDescriptor
+
+const file_notification_v1_quota_proto_rawDesc = "" +
+	"\n" +
+	"\x1bnotification/v1/quota.proto\x12\x0fnotification.v1\x1a\"notification/v1/notification.proto\"\xa2\x01\n" +
+	"\fChannelQuota\x122\n" +
+	"\achannel\x18\x01 \x01(\x0e2\x18.notification.v1.ChannelR\achannel\x12\x16\n" +
+	"\x06period\x18\x02 \x01(\tR\x06period\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x03R\x05total\x12\x12\n" +
+	"\x04used\x18\x04 \x01(\x03R\x04used\x12\x1c\n" +
+	"\tremaining\x18\x05 \x01(\x03R\tremaining\"E\n" +
+	"\x0fGetQuotaRequest\x122\n" +
+	"\achannel\x18\x01 \x01(\x0e2\x18.notification.v1.ChannelR\achannel\"I\n" +
+	"\x10GetQuotaResponse\x125\n" +
+	"\x06quotas\x18\x01 \x03(\v2\x1d.notification.v1.ChannelQuotaR\x06quotas\"\xa9\x01\n" +
+	"\x12AdjustQuotaRequest\x12\x15\n" +
+	"\x06biz_id\x18\x01 \x01(\x03R\x05bizId\x122\n" +
+	"\achannel\x18\x02 \x01(\x0e2\x18.notification.v1.ChannelR\achannel\x12\x14\n" +
+	"\x05delta\x18\x03 \x01(\x03R\x05delta\x12\x1a\n" +
+	"\boperator\x18\x04 \x01(\tR\boperator\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\"J\n" +
+	"\x13AdjustQuotaResponse\x123\n" +
+	"\x05quota\x18\x01 \x01(\v2\x1d.notification.v1.ChannelQuotaR\x05quota2\xb9\x01\n" +
+	"\fQuotaService\x12O\n" +
+	"\bGetQuota\x12 .notification.v1.GetQuotaRequest\x1a!.notification.v1.GetQuotaResponse\x12X\n" +
+	"\vAdjustQuota\x12#.notification.v1.AdjustQuotaRequest\x1a$.notification.v1.AdjustQuotaResponseB\xd5\x01\n" +
+	"\x13com.notification.v1B\n" +
+	"QuotaProtoP\x01ZUgithub.com/robinlg/notification-platform/api/proto/gen/notification/v1;notificationv1\xa2\x02\x03NXX\xaa\x02\x0fNotification.V1\xca\x02\x0fNotification\\V1\xe2\x02\x1bNotification\\V1\\GPBMetadata\xea\x02\x10Notification::V1b\x06proto3"
+
+var (
+	file_notification_v1_quota_proto_rawDescOnce sync.Once
+	file_notification_v1_quota_proto_rawDescData []byte
+)
+
+func file_notification_v1_quota_proto_rawDescGZIP() []byte {
+	file_notification_v1_quota_proto_rawDescOnce.Do(func() {
+		file_notification_v1_quota_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_notification_v1_quota_proto_rawDesc), len(file_notification_v1_quota_proto_rawDesc)))
+	})
+	return file_notification_v1_quota_proto_rawDescData
+}
+
+var file_notification_v1_quota_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_notification_v1_quota_proto_goTypes = []any{
+	(*ChannelQuota)(nil),        // 0: notification.v1.ChannelQuota
+	(*GetQuotaRequest)(nil),     // 1: notification.v1.GetQuotaRequest
+	(*GetQuotaResponse)(nil),    // 2: notification.v1.GetQuotaResponse
+	(*AdjustQuotaRequest)(nil),  // 3: notification.v1.AdjustQuotaRequest
+	(*AdjustQuotaResponse)(nil), // 4: notification.v1.AdjustQuotaResponse
+	(Channel)(0),                // 5: notification.v1.Channel
+}
+var file_notification_v1_quota_proto_depIdxs = []int32{
+	5, // 0: notification.v1.ChannelQuota.channel:type_name -> notification.v1.Channel
+	5, // 1: notification.v1.GetQuotaRequest.channel:type_name -> notification.v1.Channel
+	0, // 2: notification.v1.GetQuotaResponse.quotas:type_name -> notification.v1.ChannelQuota
+	5, // 3: notification.v1.AdjustQuotaRequest.channel:type_name -> notification.v1.Channel
+	0, // 4: notification.v1.AdjustQuotaResponse.quota:type_name -> notification.v1.ChannelQuota
+	1, // 5: notification.v1.QuotaService.GetQuota:input_type -> notification.v1.GetQuotaRequest
+	3, // 6: notification.v1.QuotaService.AdjustQuota:input_type -> notification.v1.AdjustQuotaRequest
+	2, // 7: notification.v1.QuotaService.GetQuota:output_type -> notification.v1.GetQuotaResponse
+	4, // 8: notification.v1.QuotaService.AdjustQuota:output_type -> notification.v1.AdjustQuotaResponse
+	7, // [7:9] is the sub-list for method output_type
+	5, // [5:7] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_notification_v1_quota_proto_init() }
+func file_notification_v1_quota_proto_init() {
+	if File_notification_v1_quota_proto != nil {
+		return
+	}
+	file_notification_v1_notification_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_notification_v1_quota_proto_rawDesc), len(file_notification_v1_quota_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_notification_v1_quota_proto_goTypes,
+		DependencyIndexes: file_notification_v1_quota_proto_depIdxs,
+		MessageInfos:      file_notification_v1_quota_proto_msgTypes,
+	}.Build()
+	File_notification_v1_quota_proto = out.File
+	file_notification_v1_quota_proto_goTypes = nil
+	file_notification_v1_quota_proto_depIdxs = nil
+}
@@ -0,0 +1,624 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: notification/v1/quota.proto
+
+package notificationv1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on ChannelQuota with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ChannelQuota) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ChannelQuota with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ChannelQuotaMultiError, or
+// nil if none found.
+func (m *ChannelQuota) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ChannelQuota) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Channel
+
+	// no validation rules for Period
+
+	// no validation rules for Total
+
+	// no validation rules for Used
+
+	// no validation rules for Remaining
+
+	if len(errors) > 0 {
+		return ChannelQuotaMultiError(errors)
+	}
+
+	return nil
+}
+
+// ChannelQuotaMultiError is an error wrapping multiple validation errors
+// returned by ChannelQuota.ValidateAll() if the designated constraints aren't met.
+type ChannelQuotaMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChannelQuotaMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChannelQuotaMultiError) AllErrors() []error { return m }
+
+// ChannelQuotaValidationError is the validation error returned by
+// ChannelQuota.Validate if the designated constraints aren't met.
+type ChannelQuotaValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChannelQuotaValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChannelQuotaValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChannelQuotaValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChannelQuotaValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChannelQuotaValidationError) ErrorName() string { return "ChannelQuotaValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ChannelQuotaValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChannelQuota.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChannelQuotaValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChannelQuotaValidationError{}
+
+// Validate checks the field values on GetQuotaRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetQuotaRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetQuotaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetQuotaRequestMultiError, or nil if none found.
+func (m *GetQuotaRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetQuotaRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Channel
+
+	if len(errors) > 0 {
+		return GetQuotaRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetQuotaRequestMultiError is an error wrapping multiple validation errors
+// returned by GetQuotaRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetQuotaRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetQuotaRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetQuotaRequestMultiError) AllErrors() []error { return m }
+
+// GetQuotaRequestValidationError is the validation error returned by
+// GetQuotaRequest.Validate if the designated constraints aren't met.
+type GetQuotaRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetQuotaRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetQuotaRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetQuotaRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetQuotaRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetQuotaRequestValidationError) ErrorName() string { return "GetQuotaRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetQuotaRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetQuotaRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetQuotaRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetQuotaRequestValidationError{}
+
+// Validate checks the field values on GetQuotaResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetQuotaResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetQuotaResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetQuotaResponseMultiError, or nil if none found.
+func (m *GetQuotaResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetQuotaResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetQuotas() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetQuotaResponseValidationError{
+						field:  fmt.Sprintf("Quotas[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetQuotaResponseValidationError{
+						field:  fmt.Sprintf("Quotas[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetQuotaResponseValidationError{
+					field:  fmt.Sprintf("Quotas[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetQuotaResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetQuotaResponseMultiError is an error wrapping multiple validation errors
+// returned by GetQuotaResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetQuotaResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetQuotaResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetQuotaResponseMultiError) AllErrors() []error { return m }
+
+// GetQuotaResponseValidationError is the validation error returned by
+// GetQuotaResponse.Validate if the designated constraints aren't met.
+type GetQuotaResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetQuotaResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetQuotaResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetQuotaResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetQuotaResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetQuotaResponseValidationError) ErrorName() string { return "GetQuotaResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetQuotaResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetQuotaResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetQuotaResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetQuotaResponseValidationError{}
+
+// Validate checks the field values on AdjustQuotaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdjustQuotaRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdjustQuotaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdjustQuotaRequestMultiError, or nil if none found.
+func (m *AdjustQuotaRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdjustQuotaRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BizId
+
+	// no validation rules for Channel
+
+	// no validation rules for Delta
+
+	// no validation rules for Operator
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return AdjustQuotaRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdjustQuotaRequestMultiError is an error wrapping multiple validation errors
+// returned by AdjustQuotaRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AdjustQuotaRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdjustQuotaRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdjustQuotaRequestMultiError) AllErrors() []error { return m }
+
+// AdjustQuotaRequestValidationError is the validation error returned by
+// AdjustQuotaRequest.Validate if the designated constraints aren't met.
+type AdjustQuotaRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdjustQuotaRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdjustQuotaRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdjustQuotaRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdjustQuotaRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdjustQuotaRequestValidationError) ErrorName() string {
+	return "AdjustQuotaRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdjustQuotaRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdjustQuotaRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdjustQuotaRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdjustQuotaRequestValidationError{}
+
+// Validate checks the field values on AdjustQuotaResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdjustQuotaResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdjustQuotaResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdjustQuotaResponseMultiError, or nil if none found.
+func (m *AdjustQuotaResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdjustQuotaResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetQuota()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdjustQuotaResponseValidationError{
+					field:  "Quota",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdjustQuotaResponseValidationError{
+					field:  "Quota",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQuota()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdjustQuotaResponseValidationError{
+				field:  "Quota",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AdjustQuotaResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdjustQuotaResponseMultiError is an error wrapping multiple validation
+// errors returned by AdjustQuotaResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AdjustQuotaResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdjustQuotaResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdjustQuotaResponseMultiError) AllErrors() []error { return m }
+
+// AdjustQuotaResponseValidationError is the validation error returned by
+// AdjustQuotaResponse.Validate if the designated constraints aren't met.
+type AdjustQuotaResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdjustQuotaResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdjustQuotaResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdjustQuotaResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdjustQuotaResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdjustQuotaResponseValidationError) ErrorName() string {
+	return "AdjustQuotaResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdjustQuotaResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdjustQuotaResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdjustQuotaResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdjustQuotaResponseValidationError{}
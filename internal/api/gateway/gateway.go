@@ -0,0 +1,188 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	notificationv1 "github.com/robinlg/notification-platform/api/proto/gen/notification/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	// maxBodyBytes 请求体的最大长度，与 gRPC 默认的最大接收消息长度一致
+	maxBodyBytes = 4 << 20
+	// OpenAPIPath OpenAPI 文档的路径
+	OpenAPIPath = "/openapi.json"
+)
+
+// Gateway 将通知服务的 gRPC 接口以 HTTP/JSON 的形式暴露出来
+// 请求在进程内直接调用 gRPC 的实现，并且经过与 gRPC 服务相同的拦截器，
+// 所以鉴权方式(Authorization: Bearer <JWT>)和业务错误码与 gRPC 接口完全一致
+// 流式接口 StreamSendNotifications 和 WatchNotifications 不通过网关暴露
+type Gateway struct {
+	mux         *http.ServeMux
+	routes      []route
+	interceptor grpc.UnaryServerInterceptor
+	openAPI     []byte
+
+	unmarshaler protojson.UnmarshalOptions
+	marshaler   protojson.MarshalOptions
+}
+
+// NewGateway 创建 HTTP/JSON 网关，interceptors 按照顺序执行，应当与 gRPC 服务的一元拦截器保持一致
+func NewGateway(
+	notificationSvr notificationv1.NotificationServiceServer,
+	querySvr notificationv1.NotificationQueryServiceServer,
+	interceptors ...grpc.UnaryServerInterceptor,
+) (*Gateway, error) {
+	g := &Gateway{
+		mux:         http.NewServeMux(),
+		routes:      newRoutes(notificationSvr, querySvr),
+		interceptor: chainUnaryInterceptors(interceptors),
+		unmarshaler: protojson.UnmarshalOptions{DiscardUnknown: true},
+		marshaler:   protojson.MarshalOptions{EmitUnpopulated: true},
+	}
+	openAPI, err := buildOpenAPI(g.routes)
+	if err != nil {
+		return nil, err
+	}
+	g.openAPI = openAPI
+
+	for i := range g.routes {
+		rt := g.routes[i]
+		g.mux.HandleFunc(rt.method+" "+rt.path, func(w http.ResponseWriter, r *http.Request) {
+			g.serveUnary(w, r, rt)
+		})
+	}
+	g.mux.HandleFunc(http.MethodGet+" "+OpenAPIPath, func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(g.openAPI)
+	})
+	return g, nil
+}
+
+func (g *Gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	g.mux.ServeHTTP(w, r)
+}
+
+// serveUnary 解析请求，经过拦截器调用 gRPC 实现，并将结果写回
+func (g *Gateway) serveUnary(w http.ResponseWriter, r *http.Request, rt route) {
+	req := rt.newRequest()
+	r.Body = http.MaxBytesReader(w, r.Body, maxBodyBytes)
+	if err := g.decodeRequest(r, rt, req); err != nil {
+		g.writeError(w, status.Errorf(codes.InvalidArgument, "请求解析失败: %v", err))
+		return
+	}
+
+	// 与 gRPC 客户端一样通过 metadata 传递令牌
+	ctx := r.Context()
+	if auth := r.Header.Get("Authorization"); auth != "" {
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", auth))
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: rt.fullMethod}
+	resp, err := g.interceptor(ctx, req, info, func(ctx context.Context, req any) (any, error) {
+		return rt.invoke(ctx, req.(proto.Message))
+	})
+	if err != nil {
+		g.writeError(w, err)
+		return
+	}
+
+	data, err := g.marshaler.Marshal(resp.(proto.Message))
+	if err != nil {
+		g.writeError(w, status.Errorf(codes.Internal, "响应序列化失败: %v", err))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(data)
+}
+
+// decodeRequest 从请求体和路径参数中解析请求消息，路径参数优先
+func (g *Gateway) decodeRequest(r *http.Request, rt route, req proto.Message) error {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return err
+	}
+	if len(strings.TrimSpace(string(body))) > 0 {
+		if err = g.unmarshaler.Unmarshal(body, req); err != nil {
+			return err
+		}
+	}
+	fields := req.ProtoReflect().Descriptor().Fields()
+	for _, name := range rt.pathParams {
+		fd := fields.ByName(protoreflect.Name(name))
+		if fd == nil || fd.Kind() != protoreflect.StringKind || fd.IsList() {
+			return errors.New("不支持的路径参数: " + name)
+		}
+		req.ProtoReflect().Set(fd, protoreflect.ValueOfString(r.PathValue(name)))
+	}
+	return nil
+}
+
+// writeError 将 gRPC 错误转换为 HTTP 状态码，响应体为 google.rpc.Status 的 JSON 形式
+// 业务错误与 gRPC 接口一样放在响应的 error_code 中，不会走到这里
+func (g *Gateway) writeError(w http.ResponseWriter, err error) {
+	st, _ := status.FromError(err)
+	data, merr := protojson.Marshal(st.Proto())
+	if merr != nil {
+		http.Error(w, st.Message(), HTTPStatusFromCode(st.Code()))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(HTTPStatusFromCode(st.Code()))
+	_, _ = w.Write(data)
+}
+
+// HTTPStatusFromCode 将 gRPC 状态码转换为 HTTP 状态码
+func HTTPStatusFromCode(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		// 客户端关闭了连接，沿用 nginx 的约定
+		return 499
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// chainUnaryInterceptors 将多个拦截器串联为一个，按照顺序执行
+func chainUnaryInterceptors(interceptors []grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		next := handler
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			interceptor, current := interceptors[i], next
+			next = func(ctx context.Context, req any) (any, error) {
+				return interceptor(ctx, req, info, current)
+			}
+		}
+		return next(ctx, req)
+	}
+}
@@ -0,0 +1,163 @@
+//go:build unit
+
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	jwtv4 "github.com/golang-jwt/jwt/v4"
+	notificationv1 "github.com/robinlg/notification-platform/api/proto/gen/notification/v1"
+	"github.com/robinlg/notification-platform/internal/api/grpc/interceptor/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const testKey = "test_key"
+
+type fakeServer struct {
+	notificationv1.UnimplementedNotificationServiceServer
+	notificationv1.UnimplementedNotificationQueryServiceServer
+}
+
+func (f *fakeServer) QueryNotification(ctx context.Context, req *notificationv1.QueryNotificationRequest) (*notificationv1.QueryNotificationResponse, error) {
+	bizID, err := jwt.GetBizIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if req.GetKey() == "missing" {
+		return &notificationv1.QueryNotificationResponse{
+			Result: &notificationv1.SendNotificationResponse{
+				ErrorCode:    notificationv1.ErrorCode_NOTIFICATION_NOT_FOUND,
+				ErrorMessage: "通知记录不存在",
+			},
+		}, nil
+	}
+	return &notificationv1.QueryNotificationResponse{
+		Result: &notificationv1.SendNotificationResponse{
+			NotificationId: uint64(bizID),
+			Status:         notificationv1.SendStatus_SUCCEEDED,
+		},
+	}, nil
+}
+
+func (f *fakeServer) TxCommit(_ context.Context, req *notificationv1.TxCommitRequest) (*notificationv1.TxCommitResponse, error) {
+	if req.GetKey() == "" {
+		return nil, status.Error(codes.InvalidArgument, "key 不能为空")
+	}
+	return &notificationv1.TxCommitResponse{}, nil
+}
+
+func TestGateway(t *testing.T) {
+	t.Parallel()
+
+	builder := jwt.New(testKey)
+	token, err := builder.Encode(jwtv4.MapClaims{jwt.BizIDName: float64(23456)})
+	require.NoError(t, err)
+	svr := &fakeServer{}
+	gw, err := NewGateway(svr, svr, builder.Build())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		method   string
+		path     string
+		body     string
+		token    string
+		wantCode int
+		wantBody string
+	}{
+		{
+			name:     "缺少令牌",
+			method:   http.MethodGet,
+			path:     "/v1/notifications/abc",
+			wantCode: http.StatusUnauthorized,
+			wantBody: `"code":16`,
+		},
+		{
+			name:     "查询成功",
+			method:   http.MethodGet,
+			path:     "/v1/notifications/abc",
+			token:    "Bearer " + token,
+			wantCode: http.StatusOK,
+			wantBody: `"notificationId":"23456"`,
+		},
+		{
+			name:     "业务错误通过错误码返回",
+			method:   http.MethodGet,
+			path:     "/v1/notifications/missing",
+			token:    "Bearer " + token,
+			wantCode: http.StatusOK,
+			wantBody: `"errorCode":"NOTIFICATION_NOT_FOUND"`,
+		},
+		{
+			name:     "请求体不合法",
+			method:   http.MethodPost,
+			path:     "/v1/tx:commit",
+			body:     `{"key":`,
+			token:    "Bearer " + token,
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "gRPC 错误转换为 HTTP 状态码",
+			method:   http.MethodPost,
+			path:     "/v1/tx:commit",
+			body:     `{}`,
+			token:    "Bearer " + token,
+			wantCode: http.StatusBadRequest,
+			wantBody: "不能为空",
+		},
+		{
+			name:     "未实现的方法",
+			method:   http.MethodPost,
+			path:     "/v1/notifications:send",
+			body:     `{}`,
+			token:    "Bearer " + token,
+			wantCode: http.StatusNotImplemented,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			if tt.token != "" {
+				req.Header.Set("Authorization", tt.token)
+			}
+			recorder := httptest.NewRecorder()
+			gw.ServeHTTP(recorder, req)
+			assert.Equal(t, tt.wantCode, recorder.Code)
+			assert.Contains(t, strings.ReplaceAll(recorder.Body.String(), " ", ""), tt.wantBody)
+		})
+	}
+}
+
+func TestGateway_OpenAPI(t *testing.T) {
+	t.Parallel()
+
+	svr := &fakeServer{}
+	gw, err := NewGateway(svr, svr)
+	require.NoError(t, err)
+
+	recorder := httptest.NewRecorder()
+	gw.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, OpenAPIPath, nil))
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var doc struct {
+		Paths      map[string]map[string]any `json:"paths"`
+		Components struct {
+			Schemas map[string]any `json:"schemas"`
+		} `json:"components"`
+	}
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &doc))
+	assert.Contains(t, doc.Paths["/v1/notifications/{key}"], "get")
+	assert.Contains(t, doc.Paths["/v1/tx:prepare"], "post")
+	assert.Contains(t, doc.Components.Schemas, "notification.v1.Notification")
+	assert.Contains(t, doc.Components.Schemas, errorSchemaName)
+}
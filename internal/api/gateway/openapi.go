@@ -0,0 +1,179 @@
+package gateway
+
+import (
+	"encoding/json"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	schemaRefPrefix = "#/components/schemas/"
+	errorSchemaName = "google.rpc.Status"
+)
+
+// buildOpenAPI 根据路由和 protobuf 描述生成 OpenAPI 3 文档
+// 字段名和取值遵循 protojson 的约定：字段名为 lowerCamelCase，枚举为名称字符串，64 位整数为字符串
+func buildOpenAPI(routes []route) ([]byte, error) {
+	b := &openAPIBuilder{schemas: make(map[string]any)}
+	b.schemas[errorSchemaName] = map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"code":    map[string]any{"type": "integer", "format": "int32", "description": "gRPC 状态码"},
+			"message": map[string]any{"type": "string"},
+		},
+	}
+
+	paths := make(map[string]map[string]any)
+	for i := range routes {
+		rt := routes[i]
+		op := map[string]any{
+			"operationId": rt.fullMethod[strings.LastIndex(rt.fullMethod, "/")+1:],
+			"summary":     rt.summary,
+			"tags":        []string{rt.tag},
+			"responses": map[string]any{
+				"200": jsonContent("成功，业务错误通过响应中的错误码返回",
+					b.messageRef(rt.response.ProtoReflect().Descriptor())),
+				"default": jsonContent("请求错误或者系统错误", map[string]any{"$ref": schemaRefPrefix + errorSchemaName}),
+			},
+		}
+		params := make([]any, 0, len(rt.pathParams))
+		for _, name := range rt.pathParams {
+			params = append(params, map[string]any{
+				"name":     name,
+				"in":       "path",
+				"required": true,
+				"schema":   map[string]any{"type": "string"},
+			})
+		}
+		if len(params) > 0 {
+			op["parameters"] = params
+		} else {
+			op["requestBody"] = map[string]any{
+				"required": true,
+				"content": map[string]any{
+					"application/json": map[string]any{
+						"schema": b.messageRef(rt.newRequest().ProtoReflect().Descriptor()),
+					},
+				},
+			}
+		}
+		if paths[rt.path] == nil {
+			paths[rt.path] = make(map[string]any)
+		}
+		paths[rt.path][strings.ToLower(rt.method)] = op
+	}
+
+	doc := map[string]any{
+		"openapi": "3.0.3",
+		"info": map[string]any{
+			"title":       "notification-platform",
+			"description": "通知平台 HTTP/JSON 接口，与 gRPC 接口一一对应",
+			"version":     "v1",
+		},
+		"paths": paths,
+		"components": map[string]any{
+			"schemas": b.schemas,
+			"securitySchemes": map[string]any{
+				"bearerAuth": map[string]any{
+					"type":         "http",
+					"scheme":       "bearer",
+					"bearerFormat": "JWT",
+				},
+			},
+		},
+		"security": []any{map[string]any{"bearerAuth": []string{}}},
+	}
+	return json.MarshalIndent(doc, "", "  ")
+}
+
+func jsonContent(description string, schema map[string]any) map[string]any {
+	return map[string]any{
+		"description": description,
+		"content": map[string]any{
+			"application/json": map[string]any{"schema": schema},
+		},
+	}
+}
+
+type openAPIBuilder struct {
+	schemas map[string]any
+}
+
+// messageRef 返回消息的引用，第一次引用时生成消息的定义
+func (b *openAPIBuilder) messageRef(md protoreflect.MessageDescriptor) map[string]any {
+	name := string(md.FullName())
+	switch name {
+	case "google.protobuf.Timestamp":
+		return map[string]any{"type": "string", "format": "date-time"}
+	case "google.protobuf.Duration":
+		return map[string]any{"type": "string", "example": "1.5s"}
+	}
+	if _, ok := b.schemas[name]; !ok {
+		// 先占位，避免循环引用的消息无限递归
+		b.schemas[name] = nil
+		b.schemas[name] = b.messageSchema(md)
+	}
+	return map[string]any{"$ref": schemaRefPrefix + name}
+}
+
+func (b *openAPIBuilder) messageSchema(md protoreflect.MessageDescriptor) map[string]any {
+	properties := make(map[string]any, md.Fields().Len())
+	for i := 0; i < md.Fields().Len(); i++ {
+		fd := md.Fields().Get(i)
+		properties[fd.JSONName()] = b.fieldSchema(fd)
+	}
+	return map[string]any{
+		"type":       "object",
+		"properties": properties,
+	}
+}
+
+func (b *openAPIBuilder) fieldSchema(fd protoreflect.FieldDescriptor) map[string]any {
+	switch {
+	case fd.IsMap():
+		return map[string]any{
+			"type":                 "object",
+			"additionalProperties": b.singularSchema(fd.MapValue()),
+		}
+	case fd.IsList():
+		return map[string]any{
+			"type":  "array",
+			"items": b.singularSchema(fd),
+		}
+	default:
+		return b.singularSchema(fd)
+	}
+}
+
+func (b *openAPIBuilder) singularSchema(fd protoreflect.FieldDescriptor) map[string]any {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return map[string]any{"type": "boolean"}
+	case protoreflect.StringKind:
+		return map[string]any{"type": "string"}
+	case protoreflect.BytesKind:
+		return map[string]any{"type": "string", "format": "byte"}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return map[string]any{"type": "integer", "format": "int32"}
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return map[string]any{"type": "integer", "format": "int64"}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return map[string]any{"type": "string", "format": "int64"}
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return map[string]any{"type": "string", "format": "uint64"}
+	case protoreflect.FloatKind:
+		return map[string]any{"type": "number", "format": "float"}
+	case protoreflect.DoubleKind:
+		return map[string]any{"type": "number", "format": "double"}
+	case protoreflect.EnumKind:
+		values := fd.Enum().Values()
+		names := make([]string, 0, values.Len())
+		for i := 0; i < values.Len(); i++ {
+			names = append(names, string(values.Get(i).Name()))
+		}
+		return map[string]any{"type": "string", "enum": names}
+	default:
+		return b.messageRef(fd.Message())
+	}
+}
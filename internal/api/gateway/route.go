@@ -0,0 +1,88 @@
+package gateway
+
+import (
+	"context"
+	"net/http"
+
+	notificationv1 "github.com/robinlg/notification-platform/api/proto/gen/notification/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	tagNotification = "NotificationService"
+	tagQuery        = "NotificationQueryService"
+	tagTx           = "TxNotification"
+)
+
+// route 一个 HTTP 接口与一个 gRPC 一元方法的映射
+type route struct {
+	method  string
+	path    string
+	summary string
+	tag     string
+	// fullMethod gRPC 方法全名，拦截器依赖它识别方法
+	fullMethod string
+	// pathParams 从路径中读取的字段，必须是请求消息中的字符串字段
+	pathParams []string
+	newRequest func() proto.Message
+	response   proto.Message
+	invoke     func(ctx context.Context, req proto.Message) (proto.Message, error)
+}
+
+// unary 创建一个 gRPC 一元方法的映射
+func unary[Req, Resp proto.Message](method, path, tag, summary, fullMethod string,
+	call func(context.Context, Req) (Resp, error), pathParams ...string,
+) route {
+	var req Req
+	var resp Resp
+	return route{
+		method:     method,
+		path:       path,
+		summary:    summary,
+		tag:        tag,
+		fullMethod: fullMethod,
+		pathParams: pathParams,
+		newRequest: func() proto.Message {
+			return req.ProtoReflect().Type().New().Interface()
+		},
+		response: resp.ProtoReflect().Type().New().Interface(),
+		invoke: func(ctx context.Context, r proto.Message) (proto.Message, error) {
+			return call(ctx, r.(Req))
+		},
+	}
+}
+
+func newRoutes(n notificationv1.NotificationServiceServer, q notificationv1.NotificationQueryServiceServer) []route {
+	return []route{
+		unary(http.MethodPost, "/v1/notifications:send", tagNotification, "同步单条发送",
+			notificationv1.NotificationService_SendNotification_FullMethodName, n.SendNotification),
+		unary(http.MethodPost, "/v1/notifications:sendAsync", tagNotification, "异步单条发送",
+			notificationv1.NotificationService_SendNotificationAsync_FullMethodName, n.SendNotificationAsync),
+		unary(http.MethodPost, "/v1/notifications:batchSend", tagNotification, "同步批量发送",
+			notificationv1.NotificationService_BatchSendNotifications_FullMethodName, n.BatchSendNotifications),
+		unary(http.MethodPost, "/v1/notifications:batchSendAsync", tagNotification, "异步批量发送",
+			notificationv1.NotificationService_BatchSendNotificationsAsync_FullMethodName, n.BatchSendNotificationsAsync),
+		unary(http.MethodPost, "/v1/notifications:cancel", tagNotification, "取消尚未发送的通知",
+			notificationv1.NotificationService_CancelNotification_FullMethodName, n.CancelNotification),
+		unary(http.MethodPost, "/v1/notifications:updatePending", tagNotification, "修改尚未发送的通知",
+			notificationv1.NotificationService_UpdatePendingNotification_FullMethodName, n.UpdatePendingNotification),
+		unary(http.MethodPost, "/v1/notifications:resend", tagNotification, "重新发送发送失败的通知",
+			notificationv1.NotificationService_ResendNotification_FullMethodName, n.ResendNotification),
+
+		unary(http.MethodPost, "/v1/tx:prepare", tagTx, "准备事务",
+			notificationv1.NotificationService_TxPrepare_FullMethodName, n.TxPrepare),
+		unary(http.MethodPost, "/v1/tx:commit", tagTx, "提交事务",
+			notificationv1.NotificationService_TxCommit_FullMethodName, n.TxCommit),
+		unary(http.MethodPost, "/v1/tx:cancel", tagTx, "取消事务",
+			notificationv1.NotificationService_TxCancel_FullMethodName, n.TxCancel),
+
+		unary(http.MethodGet, "/v1/notifications/{key}", tagQuery, "单条查询",
+			notificationv1.NotificationQueryService_QueryNotification_FullMethodName, q.QueryNotification, "key"),
+		unary(http.MethodPost, "/v1/notifications:batchQuery", tagQuery, "批量查询",
+			notificationv1.NotificationQueryService_BatchQueryNotifications_FullMethodName, q.BatchQueryNotifications),
+		unary(http.MethodPost, "/v1/notifications:list", tagQuery, "按照条件查询通知列表",
+			notificationv1.NotificationQueryService_ListNotifications_FullMethodName, q.ListNotifications),
+		unary(http.MethodGet, "/v1/notifications/{key}/events", tagQuery, "查询通知的生命周期事件",
+			notificationv1.NotificationQueryService_ListNotificationEvents_FullMethodName, q.ListNotificationEvents, "key"),
+	}
+}
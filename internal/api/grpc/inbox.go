@@ -0,0 +1,126 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	notificationv1 "github.com/robinlg/notification-platform/api/proto/gen/notification/v1"
+	"github.com/robinlg/notification-platform/internal/api/grpc/interceptor/jwt"
+	"github.com/robinlg/notification-platform/internal/domain"
+	"github.com/robinlg/notification-platform/internal/errs"
+	inboxsvc "github.com/robinlg/notification-platform/internal/service/inbox"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// InboxServer 站内信收件箱服务的gRPC实现
+type InboxServer struct {
+	notificationv1.UnimplementedInboxServiceServer
+
+	inboxSvc inboxsvc.Service
+}
+
+// NewInboxServer 创建站内信收件箱服务的gRPC实现
+func NewInboxServer(inboxSvc inboxsvc.Service) *InboxServer {
+	return &InboxServer{
+		inboxSvc: inboxSvc,
+	}
+}
+
+// ListInboxMessages 分页查询用户的站内信
+func (s *InboxServer) ListInboxMessages(ctx context.Context, req *notificationv1.ListInboxMessagesRequest) (*notificationv1.ListInboxMessagesResponse, error) {
+	bizID, err := jwt.GetBizIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	messages, nextCursor, err := s.inboxSvc.List(ctx, bizID, req.GetReceiver(), req.GetOnlyUnread(), req.GetCursor(), int(req.GetLimit()))
+	if err != nil {
+		return nil, s.toStatusError(err)
+	}
+
+	res := make([]*notificationv1.InboxMessage, 0, len(messages))
+	for i := range messages {
+		res = append(res, s.toGRPCInboxMessage(messages[i]))
+	}
+	return &notificationv1.ListInboxMessagesResponse{
+		Messages:   res,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+// GetUnreadCount 查询用户的未读站内信数量
+func (s *InboxServer) GetUnreadCount(ctx context.Context, req *notificationv1.GetUnreadCountRequest) (*notificationv1.GetUnreadCountResponse, error) {
+	bizID, err := jwt.GetBizIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	cnt, err := s.inboxSvc.GetUnreadCount(ctx, bizID, req.GetReceiver())
+	if err != nil {
+		return nil, s.toStatusError(err)
+	}
+	return &notificationv1.GetUnreadCountResponse{Count: cnt}, nil
+}
+
+// MarkInboxMessagesRead 将站内信标记为已读
+func (s *InboxServer) MarkInboxMessagesRead(ctx context.Context, req *notificationv1.MarkInboxMessagesReadRequest) (*notificationv1.MarkInboxMessagesReadResponse, error) {
+	bizID, err := jwt.GetBizIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	if len(req.GetIds()) > batchSizeLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "%v: %d > %d", errs.ErrBatchSizeOverLimit, len(req.GetIds()), batchSizeLimit)
+	}
+
+	affected, err := s.inboxSvc.MarkRead(ctx, bizID, req.GetReceiver(), req.GetIds())
+	if err != nil {
+		return nil, s.toStatusError(err)
+	}
+	return &notificationv1.MarkInboxMessagesReadResponse{Affected: affected}, nil
+}
+
+// DeleteInboxMessages 删除站内信
+func (s *InboxServer) DeleteInboxMessages(ctx context.Context, req *notificationv1.DeleteInboxMessagesRequest) (*notificationv1.DeleteInboxMessagesResponse, error) {
+	bizID, err := jwt.GetBizIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	if len(req.GetIds()) > batchSizeLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "%v: %d > %d", errs.ErrBatchSizeOverLimit, len(req.GetIds()), batchSizeLimit)
+	}
+
+	affected, err := s.inboxSvc.Delete(ctx, bizID, req.GetReceiver(), req.GetIds())
+	if err != nil {
+		return nil, s.toStatusError(err)
+	}
+	return &notificationv1.DeleteInboxMessagesResponse{Affected: affected}, nil
+}
+
+func (s *InboxServer) toStatusError(err error) error {
+	if errors.Is(err, errs.ErrInvalidParameter) {
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	return status.Errorf(codes.Internal, "%v", err)
+}
+
+func (s *InboxServer) toGRPCInboxMessage(m domain.InboxMessage) *notificationv1.InboxMessage {
+	msgStatus := notificationv1.InboxMessageStatus_INBOX_MESSAGE_STATUS_UNSPECIFIED
+	switch m.Status {
+	case domain.InboxMessageStatusUnread:
+		msgStatus = notificationv1.InboxMessageStatus_UNREAD
+	case domain.InboxMessageStatusRead:
+		msgStatus = notificationv1.InboxMessageStatus_READ
+	}
+	return &notificationv1.InboxMessage{
+		Id:             m.ID,
+		NotificationId: m.NotificationID,
+		Title:          m.Title,
+		Content:        m.Content,
+		Status:         msgStatus,
+		ReadTime:       m.ReadTime,
+		Ctime:          m.Ctime,
+	}
+}
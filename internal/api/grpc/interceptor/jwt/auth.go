@@ -15,7 +15,13 @@ import (
 	"github.com/golang-jwt/jwt/v4"
 )
 
-const BizIDName = "biz_id"
+const (
+	BizIDName = "biz_id"
+	// AdminName 管理员声明，值为 true 时可以调用管理类接口
+	AdminName = "admin"
+	// PriorityName 优先级声明
+	PriorityName = "Priority"
+)
 
 // Builder token拦截器构建器
 type Builder struct {
@@ -79,42 +85,76 @@ func (a *Builder) Encode(customClaims jwt.MapClaims) (string, error) {
 // Build 构建gRPC一元拦截器
 func (a *Builder) Build() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-		// 提取metadata
-		md, ok := metadata.FromIncomingContext(ctx)
-		if !ok {
-			return nil, status.Errorf(codes.Unauthenticated, "missing metadata")
-		}
-
-		// 获取Authorization头
-		authHeaders := md.Get("Authorization")
-		if len(authHeaders) == 0 {
-			return nil, status.Error(codes.Unauthenticated, "authorization token is required")
+		ctx, err := a.authenticate(ctx)
+		if err != nil {
+			return nil, err
 		}
+		return handler(ctx, req)
+	}
+}
 
-		// 解码认证
-		tokenStr := authHeaders[0]
-		val, err := a.Decode(tokenStr)
+// BuildStream 构建gRPC流式拦截器
+func (a *Builder) BuildStream() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := a.authenticate(ss.Context())
 		if err != nil {
-			// 细化错误类型处理
-			if errors.Is(err, jwt.ErrTokenExpired) {
-				return nil, status.Error(codes.Unauthenticated, "token expired")
-			}
-			if errors.Is(err, jwt.ErrTokenSignatureInvalid) {
-				return nil, status.Error(codes.Unauthenticated, "invalid signature")
-			}
-			return nil, status.Error(codes.Unauthenticated, "invalid token: "+err.Error())
-		}
-		v, ok := val[BizIDName]
-		if ok {
-			bizId := v.(float64)
-			ctx = context.WithValue(ctx, BizIDName, int64(bizId))
+			return err
 		}
+		return handler(srv, &authenticatedStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// authenticate 解析 metadata 中的 Authorization 头，将令牌中的声明放入 context
+func (a *Builder) authenticate(ctx context.Context) (context.Context, error) {
+	// 提取metadata
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "missing metadata")
+	}
 
-		v, ok = val["Priority"]
-		if ok {
-			ctx = context.WithValue(ctx, "Priority", v)
+	// 获取Authorization头
+	authHeaders := md.Get("Authorization")
+	if len(authHeaders) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "authorization token is required")
+	}
+
+	// 解码认证
+	tokenStr := authHeaders[0]
+	val, err := a.Decode(tokenStr)
+	if err != nil {
+		// 细化错误类型处理
+		if errors.Is(err, jwt.ErrTokenExpired) {
+			return nil, status.Error(codes.Unauthenticated, "token expired")
 		}
+		if errors.Is(err, jwt.ErrTokenSignatureInvalid) {
+			return nil, status.Error(codes.Unauthenticated, "invalid signature")
+		}
+		return nil, status.Error(codes.Unauthenticated, "invalid token: "+err.Error())
+	}
 
-		return handler(ctx, req)
+	v, ok := val[BizIDName]
+	if ok {
+		bizId := v.(float64)
+		ctx = context.WithValue(ctx, BizIDName, int64(bizId))
 	}
+	v, ok = val[AdminName]
+	if ok {
+		isAdmin, _ := v.(bool)
+		ctx = context.WithValue(ctx, AdminName, isAdmin)
+	}
+	v, ok = val[PriorityName]
+	if ok {
+		ctx = context.WithValue(ctx, PriorityName, v)
+	}
+	return ctx, nil
+}
+
+// authenticatedStream 替换流的 context，使处理方法可以拿到令牌中的声明
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
 }
@@ -3,6 +3,7 @@ package jwt
 import (
 	"context"
 
+	"github.com/robinlg/notification-platform/internal/domain"
 	"github.com/robinlg/notification-platform/internal/errs"
 )
 
@@ -17,3 +18,14 @@ func GetBizIDFromContext(ctx context.Context) (int64, error) {
 	}
 	return v, nil
 }
+
+// IsAdminFromContext 调用方是否为管理员
+func IsAdminFromContext(ctx context.Context) bool {
+	v, ok := ctx.Value(AdminName).(bool)
+	return ok && v
+}
+
+// GetPriorityFromContext 获取令牌中声明的优先级，没有声明或者声明不合法时第二个返回值为 false
+func GetPriorityFromContext(ctx context.Context) (domain.Priority, bool) {
+	return domain.ParsePriority(ctx.Value(PriorityName))
+}
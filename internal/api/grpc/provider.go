@@ -0,0 +1,49 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	notificationv1 "github.com/robinlg/notification-platform/api/proto/gen/notification/v1"
+	"github.com/robinlg/notification-platform/internal/api/grpc/interceptor/jwt"
+	"github.com/robinlg/notification-platform/internal/errs"
+	providersvc "github.com/robinlg/notification-platform/internal/service/provider/manage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ProviderServer 供应商服务的gRPC实现
+type ProviderServer struct {
+	notificationv1.UnimplementedProviderServiceServer
+
+	providerSvc providersvc.Service
+}
+
+// NewProviderServer 创建供应商服务的gRPC实现
+func NewProviderServer(providerSvc providersvc.Service) *ProviderServer {
+	return &ProviderServer{
+		providerSvc: providerSvc,
+	}
+}
+
+// GetProviderDailyRemaining 查询供应商当日剩余的请求数，仅限管理员调用
+func (s *ProviderServer) GetProviderDailyRemaining(ctx context.Context, req *notificationv1.GetProviderDailyRemainingRequest) (*notificationv1.GetProviderDailyRemainingResponse, error) {
+	if !jwt.IsAdminFromContext(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "仅限管理员调用")
+	}
+	if req.GetProviderId() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "%v: providerID = %d", errs.ErrInvalidParameter, req.GetProviderId())
+	}
+
+	remaining, err := s.providerSvc.GetDailyRemaining(ctx, req.GetProviderId())
+	if err != nil {
+		if errors.Is(err, errs.ErrProviderNotFound) {
+			return nil, status.Errorf(codes.NotFound, "%v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+	return &notificationv1.GetProviderDailyRemainingResponse{
+		ProviderId: req.GetProviderId(),
+		Remaining:  remaining,
+	}, nil
+}
@@ -0,0 +1,89 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	notificationv1 "github.com/robinlg/notification-platform/api/proto/gen/notification/v1"
+	"github.com/robinlg/notification-platform/internal/api/grpc/interceptor/jwt"
+	"github.com/robinlg/notification-platform/internal/domain"
+	"github.com/robinlg/notification-platform/internal/errs"
+	quotasvc "github.com/robinlg/notification-platform/internal/service/quota"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// QuotaServer 额度服务的gRPC实现
+type QuotaServer struct {
+	notificationv1.UnimplementedQuotaServiceServer
+
+	quotaSvc quotasvc.Service
+}
+
+// NewQuotaServer 创建额度服务的gRPC实现
+func NewQuotaServer(quotaSvc quotasvc.Service) *QuotaServer {
+	return &QuotaServer{
+		quotaSvc: quotaSvc,
+	}
+}
+
+// GetQuota 查询调用方各渠道本周期的额度
+func (s *QuotaServer) GetQuota(ctx context.Context, req *notificationv1.GetQuotaRequest) (*notificationv1.GetQuotaResponse, error) {
+	bizID, err := jwt.GetBizIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	balances, err := s.quotaSvc.GetBalances(ctx, bizID)
+	if err != nil {
+		return nil, s.toStatusError(err)
+	}
+
+	quotas := make([]*notificationv1.ChannelQuota, 0, len(balances))
+	for i := range balances {
+		q := s.toGRPCChannelQuota(balances[i])
+		if req.GetChannel() != notificationv1.Channel_CHANNEL_UNSPECIFIED && q.Channel != req.GetChannel() {
+			continue
+		}
+		quotas = append(quotas, q)
+	}
+	return &notificationv1.GetQuotaResponse{Quotas: quotas}, nil
+}
+
+// AdjustQuota 调整业务方某个渠道本周期的额度，仅限管理员调用
+func (s *QuotaServer) AdjustQuota(ctx context.Context, req *notificationv1.AdjustQuotaRequest) (*notificationv1.AdjustQuotaResponse, error) {
+	if !jwt.IsAdminFromContext(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "仅限管理员调用")
+	}
+	if req.GetBizId() <= 0 || req.GetOperator() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "%v: bizID = %d, operator = %q", errs.ErrInvalidParameter, req.GetBizId(), req.GetOperator())
+	}
+
+	channel := domain.Channel(req.GetChannel().String())
+	balance, err := s.quotaSvc.Adjust(ctx, req.GetBizId(), channel, req.GetDelta(), req.GetOperator(), req.GetReason())
+	if err != nil {
+		return nil, s.toStatusError(err)
+	}
+	return &notificationv1.AdjustQuotaResponse{Quota: s.toGRPCChannelQuota(balance)}, nil
+}
+
+func (s *QuotaServer) toStatusError(err error) error {
+	switch {
+	case errors.Is(err, errs.ErrInvalidParameter):
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	case errors.Is(err, errs.ErrQuotaNotFound):
+		return status.Errorf(codes.NotFound, "%v", err)
+	default:
+		return status.Errorf(codes.Internal, "%v", err)
+	}
+}
+
+func (s *QuotaServer) toGRPCChannelQuota(b domain.QuotaBalance) *notificationv1.ChannelQuota {
+	return &notificationv1.ChannelQuota{
+		Channel:   notificationv1.Channel(notificationv1.Channel_value[b.Channel.String()]),
+		Period:    b.Period,
+		Total:     b.Total(),
+		Used:      b.Used(),
+		Remaining: b.Remaining,
+	}
+}
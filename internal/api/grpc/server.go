@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"strconv"
 
 	"github.com/robinlg/notification-platform/internal/errs"
 	templatesvc "github.com/robinlg/notification-platform/internal/service/template/manage"
@@ -11,6 +13,7 @@ import (
 	notificationv1 "github.com/robinlg/notification-platform/api/proto/gen/notification/v1"
 	"github.com/robinlg/notification-platform/internal/api/grpc/interceptor/jwt"
 	"github.com/robinlg/notification-platform/internal/domain"
+	"github.com/robinlg/notification-platform/internal/service/event"
 	notificationsvc "github.com/robinlg/notification-platform/internal/service/notification"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -18,15 +21,45 @@ import (
 
 const (
 	batchSizeLimit = 100
+	// watchBatchSize 订阅通知状态变更时每次读取的事件数量
+	watchBatchSize = 100
+	// streamChunkSize 流式发送时每次批量保存的通知数量
+	streamChunkSize = 100
+	// maxStreamNotifications 单个流最多接收的通知数量，限制响应中每条通知的结果占用的内存
+	maxStreamNotifications = 50000
 )
 
 type NotificationServer struct {
 	notificationv1.UnimplementedNotificationServiceServer
 	notificationv1.UnimplementedNotificationQueryServiceServer
 
-	sendSvc     notificationsvc.SendService
-	templateSvc templatesvc.ChannelTemplateService
-	txnSvc      notificationsvc.TxNotificationService
+	sendSvc notificationsvc.SendService
+	// unlimitedSendSvc 不限流的发送服务，流式发送中整批保存失败之后逐条保存时使用，
+	// 这一批通知在整批保存时已经占用过限流的配额
+	unlimitedSendSvc notificationsvc.SendService
+	notificationSvc  notificationsvc.Service
+	templateSvc      templatesvc.ChannelTemplateService
+	txnSvc           notificationsvc.TxNotificationService
+	eventSvc         event.Service
+}
+
+// NewServer 创建通知服务的gRPC实现
+func NewServer(
+	sendSvc notificationsvc.SendService,
+	unlimitedSendSvc notificationsvc.SendService,
+	notificationSvc notificationsvc.Service,
+	templateSvc templatesvc.ChannelTemplateService,
+	txnSvc notificationsvc.TxNotificationService,
+	eventSvc event.Service,
+) *NotificationServer {
+	return &NotificationServer{
+		sendSvc:          sendSvc,
+		unlimitedSendSvc: unlimitedSendSvc,
+		notificationSvc:  notificationSvc,
+		templateSvc:      templateSvc,
+		txnSvc:           txnSvc,
+		eventSvc:         eventSvc,
+	}
 }
 
 func (s *NotificationServer) SendNotification(ctx context.Context, req *notificationv1.SendNotificationRequest) (*notificationv1.SendNotificationResponse, error) {
@@ -52,6 +85,8 @@ func (s *NotificationServer) SendNotification(ctx context.Context, req *notifica
 	if err != nil {
 		if s.isSystemError(err) {
 			return nil, status.Errorf(codes.Internal, "%v", err)
+		} else if errors.Is(err, errs.ErrRateLimited) {
+			return nil, status.Errorf(codes.ResourceExhausted, "%v", err)
 		} else {
 			response.ErrorCode = s.convertToGRPCErrorCode(err)
 			response.ErrorMessage = err.Error()
@@ -62,9 +97,17 @@ func (s *NotificationServer) SendNotification(ctx context.Context, req *notifica
 
 	response.NotificationId = result.NotificationID
 	response.Status = s.convertToGRPCSendStatus(result.Status)
+	response.DeliveredChannel = s.convertToGRPCChannel(result.Channel)
 	return response, nil
 }
 
+// isItemError 判断批量保存失败是否由个别通知导致，比如 key 重复、参数不合法或者渠道被禁用
+func (s *NotificationServer) isItemError(err error) bool {
+	return errors.Is(err, errs.ErrNotificationDuplicate) ||
+		errors.Is(err, errs.ErrInvalidParameter) ||
+		errors.Is(err, errs.ErrChannelDisabled)
+}
+
 // isSystemError 判断错误是否为系统错误
 func (s *NotificationServer) isSystemError(err error) bool {
 	return errors.Is(err, errs.ErrDatabaseError) ||
@@ -89,11 +132,79 @@ func (s *NotificationServer) buildNotification(ctx context.Context, n *notificat
 		return domain.Notification{}, fmt.Errorf("%w: 模板ID: %s 未发布", errs.ErrInvalidParameter, n.TemplateId)
 	}
 
+	// 降级模版同样需要已发布，并且与降级渠道一致
+	for i := range notification.Fallbacks {
+		target := notification.Fallbacks[i]
+		fallbackTmpl, err1 := s.templateSvc.GetTemplateByID(ctx, target.TemplateID)
+		if err1 != nil {
+			return domain.Notification{}, fmt.Errorf("%w: 降级模板ID: %d", errs.ErrInvalidParameter, target.TemplateID)
+		}
+		if !fallbackTmpl.HasPublished() || fallbackTmpl.Channel != target.Channel {
+			return domain.Notification{}, fmt.Errorf("%w: 降级模板ID: %d 未发布或者与渠道 %s 不匹配", errs.ErrInvalidParameter, target.TemplateID, target.Channel)
+		}
+	}
+
 	notification.BizID = bizID
 	notification.Template.VersionID = tmpl.ActiveVersionID
+	// 优先使用令牌中声明的优先级，没有声明时根据模板的业务类型推断
+	priority, ok := jwt.GetPriorityFromContext(ctx)
+	if !ok {
+		priority = domain.PriorityOf(tmpl.BusinessType)
+	}
+	notification.Priority = priority
 	return notification, nil
 }
 
+// convertToGRPCChannel 将领域渠道转换为gRPC渠道
+func (s *NotificationServer) convertToGRPCChannel(channel domain.Channel) notificationv1.Channel {
+	switch channel {
+	case domain.ChannelSMS:
+		return notificationv1.Channel_SMS
+	case domain.ChannelEmail:
+		return notificationv1.Channel_EMAIL
+	case domain.ChannelInApp:
+		return notificationv1.Channel_IN_APP
+	default:
+		return notificationv1.Channel_CHANNEL_UNSPECIFIED
+	}
+}
+
+// convertToDomainChannel 将gRPC渠道转换为领域渠道，未指定时返回空值
+func (s *NotificationServer) convertToDomainChannel(channel notificationv1.Channel) domain.Channel {
+	switch channel {
+	case notificationv1.Channel_SMS:
+		return domain.ChannelSMS
+	case notificationv1.Channel_EMAIL:
+		return domain.ChannelEmail
+	case notificationv1.Channel_IN_APP:
+		return domain.ChannelInApp
+	default:
+		return ""
+	}
+}
+
+// convertToDomainSendStatus 将gRPC发送状态转换为领域发送状态，未指定时返回空值
+func (s *NotificationServer) convertToDomainSendStatus(status notificationv1.SendStatus) domain.SendStatus {
+	switch status {
+	case notificationv1.SendStatus_PREPARE:
+		return domain.SendStatusPrepare
+	case notificationv1.SendStatus_CANCELED:
+		return domain.SendStatusCanceled
+	case notificationv1.SendStatus_PENDING:
+		return domain.SendStatusPending
+	case notificationv1.SendStatus_SENDING:
+		return domain.SendStatusSending
+	case notificationv1.SendStatus_SUCCEEDED:
+		return domain.SendStatusSucceeded
+	case notificationv1.SendStatus_FAILED:
+		return domain.SendStatusFailed
+	case notificationv1.SendStatus_EXPIRED:
+		return domain.SendStatusExpired
+	default:
+		return ""
+	}
+}
+
 // convertToGRPCSendStatus 将领域发送状态转换为gRPC发送状态
 func (s *NotificationServer) convertToGRPCSendStatus(status domain.SendStatus) notificationv1.SendStatus {
 	switch status {
@@ -103,10 +214,14 @@ func (s *NotificationServer) convertToGRPCSendStatus(status domain.SendStatus) n
 		return notificationv1.SendStatus_CANCELED
 	case domain.SendStatusPending:
 		return notificationv1.SendStatus_PENDING
+	case domain.SendStatusSending:
+		return notificationv1.SendStatus_SENDING
 	case domain.SendStatusSucceeded:
 		return notificationv1.SendStatus_SUCCEEDED
 	case domain.SendStatusFailed:
 		return notificationv1.SendStatus_FAILED
+	case domain.SendStatusExpired:
+		return notificationv1.SendStatus_EXPIRED
 	default:
 		return notificationv1.SendStatus_SEND_STATUS_UNSPECIFIED
 	}
@@ -164,6 +279,12 @@ func (s *NotificationServer) convertToGRPCErrorCode(err error) notificationv1.Er
 	case errors.Is(err, errs.ErrUnknownChannel):
 		return notificationv1.ErrorCode_UNKNOWN_CHANNEL
 
+	case errors.Is(err, errs.ErrInvalidNotificationStatus):
+		return notificationv1.ErrorCode_INVALID_NOTIFICATION_STATUS
+
+	case errors.Is(err, errs.ErrNotificationExpired):
+		return notificationv1.ErrorCode_NOTIFICATION_EXPIRED
+
 	default:
 		return notificationv1.ErrorCode_ERROR_CODE_UNSPECIFIED
 	}
@@ -194,6 +315,9 @@ func (s *NotificationServer) SendNotificationAsync(ctx context.Context, req *not
 		if s.isSystemError(err) {
 			// 系统错误通过gRPC错误返回
 			return nil, status.Errorf(codes.Internal, "%v", err)
+		} else if errors.Is(err, errs.ErrRateLimited) {
+			// 被限流通过 ResourceExhausted 返回，方便客户端退避重试
+			return nil, status.Errorf(codes.ResourceExhausted, "%v", err)
 		} else {
 			// 业务错误通过ErrorCode返回
 			response.ErrorCode = s.convertToGRPCErrorCode(err)
@@ -258,6 +382,8 @@ func (s *NotificationServer) BatchSendNotifications(ctx context.Context, req *no
 	if err != nil {
 		if s.isSystemError(err) {
 			return nil, status.Errorf(codes.Internal, "%v", err)
+		} else if errors.Is(err, errs.ErrRateLimited) {
+			return nil, status.Errorf(codes.ResourceExhausted, "%v", err)
 		} else {
 			for i := range results {
 				results[i] = &notificationv1.SendNotificationResponse{
@@ -295,11 +421,155 @@ func (s *NotificationServer) BatchSendNotifications(ctx context.Context, req *no
 	}, nil
 }
 
+// StreamSendNotifications 处理流式异步发送通知请求
+// 每攒够一批就同步保存，保存完成之前不再接收，由 gRPC 的流量控制把压力传递给客户端
+// 接收到 maxStreamNotifications 条通知之后提前结束流，客户端在新的流中发送剩余的通知
+func (s *NotificationServer) StreamSendNotifications(stream notificationv1.NotificationService_StreamSendNotificationsServer) error {
+	ctx := stream.Context()
+	// 从metadata中解析Authorization JWT Token
+	bizID, err := jwt.GetBizIDFromContext(ctx)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	resp := &notificationv1.StreamSendNotificationsResponse{}
+	// 错误代码对应的汇总
+	summaries := make(map[notificationv1.ErrorCode]*notificationv1.StreamSendErrorSummary)
+	chunk := make([]*notificationv1.Notification, 0, streamChunkSize)
+	flush := func() error {
+		results, err1 := s.sendChunkAsync(ctx, bizID, chunk)
+		if err1 != nil {
+			return err1
+		}
+		for i := range results {
+			resp.Results = append(resp.Results, &notificationv1.StreamSendResult{
+				Index:          int64(resp.TotalCount),
+				Key:            chunk[i].GetKey(),
+				NotificationId: results[i].NotificationId,
+				Status:         results[i].Status,
+				ErrorCode:      results[i].ErrorCode,
+			})
+			resp.TotalCount++
+			if results[i].Status != notificationv1.SendStatus_FAILED {
+				resp.SuccessCount++
+				continue
+			}
+			summary, ok := summaries[results[i].ErrorCode]
+			if !ok {
+				summary = &notificationv1.StreamSendErrorSummary{
+					ErrorCode:    results[i].ErrorCode,
+					ErrorMessage: results[i].ErrorMessage,
+				}
+				summaries[results[i].ErrorCode] = summary
+				resp.Errors = append(resp.Errors, summary)
+			}
+			summary.Count++
+		}
+		chunk = chunk[:0]
+		return nil
+	}
+	for int(resp.TotalCount)+len(chunk) < maxStreamNotifications {
+		req, err1 := stream.Recv()
+		if errors.Is(err1, io.EOF) {
+			break
+		}
+		if err1 != nil {
+			return err1
+		}
+		chunk = append(chunk, req.GetNotification())
+		if len(chunk) == streamChunkSize {
+			if err1 = flush(); err1 != nil {
+				return err1
+			}
+		}
+	}
+	if len(chunk) > 0 {
+		if err = flush(); err != nil {
+			return err
+		}
+	}
+	return stream.SendAndClose(resp)
+}
+
+// sendChunkAsync 批量异步发送一批通知，返回与 chunk 一一对应的结果
+// 不合法的通知单独返回错误，其余的通知一起保存，个别通知导致整批保存失败时逐条保存，使每条通知得到各自的结果
+// 被限流时返回 ResourceExhausted，由调用方结束整个流
+func (s *NotificationServer) sendChunkAsync(ctx context.Context, bizID int64, chunk []*notificationv1.Notification) ([]*notificationv1.SendNotificationResponse, error) {
+	results := make([]*notificationv1.SendNotificationResponse, len(chunk))
+	notifications := make([]domain.Notification, 0, len(chunk))
+	// 合法通知在 chunk 中的下标
+	indexes := make([]int, 0, len(chunk))
+	for i := range chunk {
+		notification, err := s.buildNotification(ctx, chunk[i], bizID)
+		if err == nil {
+			err = notification.Validate()
+		}
+		if err != nil {
+			results[i] = &notificationv1.SendNotificationResponse{
+				ErrorCode:    notificationv1.ErrorCode_INVALID_PARAMETER,
+				ErrorMessage: err.Error(),
+				Status:       notificationv1.SendStatus_FAILED,
+			}
+			continue
+		}
+		notifications = append(notifications, notification)
+		indexes = append(indexes, i)
+	}
+	if len(notifications) == 0 {
+		return results, nil
+	}
+
+	result, err := s.sendSvc.BatchSendNotificationsAsync(ctx, notifications...)
+	if err == nil {
+		for j, idx := range indexes {
+			results[idx] = &notificationv1.SendNotificationResponse{
+				NotificationId: result.NotificationIDs[j],
+				Status:         notificationv1.SendStatus_PENDING,
+			}
+		}
+		return results, nil
+	}
+	if errors.Is(err, errs.ErrRateLimited) {
+		return nil, status.Errorf(codes.ResourceExhausted, "%v", err)
+	}
+	if !s.isItemError(err) {
+		// 与单条通知无关的错误，逐条保存也会失败，整批返回失败
+		for _, idx := range indexes {
+			results[idx] = &notificationv1.SendNotificationResponse{
+				ErrorCode:    s.convertToGRPCErrorCode(err),
+				ErrorMessage: err.Error(),
+				Status:       notificationv1.SendStatus_FAILED,
+			}
+		}
+		return results, nil
+	}
+
+	// 整批失败是其中个别通知导致的，比如有重复的 key，逐条保存找出失败的通知
+	// 整批保存时已经按照通知数量占用了限流的配额，逐条保存不再限流
+	for j, idx := range indexes {
+		resp, err1 := s.unlimitedSendSvc.SendNotificationAsync(ctx, notifications[j])
+		if err1 != nil {
+			results[idx] = &notificationv1.SendNotificationResponse{
+				ErrorCode:    s.convertToGRPCErrorCode(err1),
+				ErrorMessage: err1.Error(),
+				Status:       notificationv1.SendStatus_FAILED,
+			}
+			continue
+		}
+		results[idx] = &notificationv1.SendNotificationResponse{
+			NotificationId: resp.NotificationID,
+			Status:         s.convertToGRPCSendStatus(resp.Status),
+		}
+	}
+	return results, nil
+}
+
 // buildGRPCSendResponse 将领域响应转换为gRPC响应
 func (s *NotificationServer) buildGRPCSendResponse(result domain.SendResponse, err error) *notificationv1.SendNotificationResponse {
 	response := &notificationv1.SendNotificationResponse{
-		NotificationId: result.NotificationID,
-		Status:         s.convertToGRPCSendStatus(result.Status),
+		NotificationId:   result.NotificationID,
+		Status:           s.convertToGRPCSendStatus(result.Status),
+		DeliveredChannel: s.convertToGRPCChannel(result.Channel),
 	}
 	// 如果有错误，提取错误代码和消息
 	if err != nil {
@@ -348,6 +618,8 @@ func (s *NotificationServer) BatchSendNotificationsAsync(ctx context.Context, re
 	if err != nil {
 		if s.isSystemError(err) {
 			return nil, status.Errorf(codes.Internal, "%v", err)
+		} else if errors.Is(err, errs.ErrRateLimited) {
+			return nil, status.Errorf(codes.ResourceExhausted, "%v", err)
 		} else {
 			return nil, status.Errorf(codes.InvalidArgument, "批量异步发送失败: %v", err)
 		}
@@ -375,6 +647,12 @@ func (s *NotificationServer) TxPrepare(ctx context.Context, request *notificatio
 
 	// 执行操作
 	_, err = s.txnSvc.Prepare(ctx, txn.Notification)
+	switch {
+	case errors.Is(err, errs.ErrRateLimited):
+		return nil, status.Errorf(codes.ResourceExhausted, "%v", err)
+	case errors.Is(err, errs.ErrChannelDisabled):
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
 	return &notificationv1.TxPrepareResponse{}, err
 }
 
@@ -419,3 +697,336 @@ func (s *NotificationServer) TxCancel(ctx context.Context, request *notification
 	err = s.txnSvc.Cancel(ctx, bizID, request.GetKey())
 	return &notificationv1.TxCancelResponse{}, err
 }
+
+// CancelNotification 取消尚未发送的通知
+func (s *NotificationServer) CancelNotification(ctx context.Context, req *notificationv1.CancelNotificationRequest) (*notificationv1.CancelNotificationResponse, error) {
+	// 从metadata中解析Authorization JWT Token
+	bizID, err := jwt.GetBizIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	n, err := s.notificationSvc.Cancel(ctx, bizID, req.GetKey())
+	if err != nil {
+		if s.isSystemError(err) {
+			return nil, status.Errorf(codes.Internal, "%v", err)
+		}
+		response := &notificationv1.CancelNotificationResponse{
+			NotificationId: n.ID,
+			ErrorCode:      s.convertToGRPCErrorCode(err),
+			ErrorMessage:   err.Error(),
+		}
+		if n.ID != 0 {
+			// 返回通知的当前状态，方便业务方判断
+			response.Status = s.convertToGRPCSendStatus(n.Status)
+		}
+		return response, nil
+	}
+	return &notificationv1.CancelNotificationResponse{
+		NotificationId: n.ID,
+		Status:         s.convertToGRPCSendStatus(n.Status),
+	}, nil
+}
+
+// UpdatePendingNotification 修改尚未发送的通知的发送策略和模版参数
+func (s *NotificationServer) UpdatePendingNotification(ctx context.Context, req *notificationv1.UpdatePendingNotificationRequest) (*notificationv1.UpdatePendingNotificationResponse, error) {
+	// 从metadata中解析Authorization JWT Token
+	bizID, err := jwt.GetBizIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	var strategy *domain.SendStrategyConfig
+	if req.GetStrategy() != nil {
+		cfg := domain.NewSendStrategyConfigFromAPI(req.GetStrategy())
+		strategy = &cfg
+	}
+	var params map[string]string
+	if len(req.GetTemplateParams()) > 0 {
+		params = req.GetTemplateParams()
+	}
+
+	n, err := s.notificationSvc.UpdatePending(ctx, bizID, req.GetKey(), strategy, params)
+	if err != nil {
+		if s.isSystemError(err) {
+			return nil, status.Errorf(codes.Internal, "%v", err)
+		}
+		response := &notificationv1.UpdatePendingNotificationResponse{
+			NotificationId: n.ID,
+			ErrorCode:      s.convertToGRPCErrorCode(err),
+			ErrorMessage:   err.Error(),
+		}
+		if n.ID != 0 {
+			// 返回通知的当前状态，方便业务方判断
+			response.Status = s.convertToGRPCSendStatus(n.Status)
+		}
+		return response, nil
+	}
+	return &notificationv1.UpdatePendingNotificationResponse{
+		NotificationId: n.ID,
+		Status:         s.convertToGRPCSendStatus(n.Status),
+	}, nil
+}
+
+// ResendNotification 重新发送发送失败的通知
+func (s *NotificationServer) ResendNotification(ctx context.Context, req *notificationv1.ResendNotificationRequest) (*notificationv1.ResendNotificationResponse, error) {
+	// 从metadata中解析Authorization JWT Token
+	bizID, err := jwt.GetBizIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	result, err := s.sendSvc.ResendNotification(ctx, bizID, req.GetKey(), req.GetSendImmediately())
+	if err != nil {
+		if s.isSystemError(err) {
+			return nil, status.Errorf(codes.Internal, "%v", err)
+		}
+		// 返回通知的当前状态，方便业务方判断
+		response := &notificationv1.SendNotificationResponse{
+			NotificationId: result.NotificationID,
+			ErrorCode:      s.convertToGRPCErrorCode(err),
+			ErrorMessage:   err.Error(),
+		}
+		if result.NotificationID != 0 {
+			response.Status = s.convertToGRPCSendStatus(result.Status)
+		}
+		return &notificationv1.ResendNotificationResponse{Result: response}, nil
+	}
+	return &notificationv1.ResendNotificationResponse{
+		Result: s.buildGRPCSendResponse(result, nil),
+	}, nil
+}
+
+// QueryNotification 根据业务内唯一标识查询单条通知的发送结果
+func (s *NotificationServer) QueryNotification(ctx context.Context, req *notificationv1.QueryNotificationRequest) (*notificationv1.QueryNotificationResponse, error) {
+	// 从metadata中解析Authorization JWT Token
+	bizID, err := jwt.GetBizIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	n, err := s.notificationSvc.GetByKey(ctx, bizID, req.GetKey())
+	if err != nil {
+		if s.isSystemError(err) {
+			return nil, status.Errorf(codes.Internal, "%v", err)
+		}
+		return &notificationv1.QueryNotificationResponse{
+			Result: &notificationv1.SendNotificationResponse{
+				ErrorCode:    s.convertToGRPCErrorCode(err),
+				ErrorMessage: err.Error(),
+			},
+		}, nil
+	}
+	return &notificationv1.QueryNotificationResponse{
+		Result: s.buildGRPCSendResponse(domain.SendResponse{
+			NotificationID: n.ID,
+			Status:         n.Status,
+			Channel:        n.DeliveredChannel,
+		}, nil),
+	}, nil
+}
+
+// BatchQueryNotifications 根据业务内唯一标识批量查询通知的发送结果，结果顺序与请求中的 keys 一致
+func (s *NotificationServer) BatchQueryNotifications(ctx context.Context, req *notificationv1.BatchQueryNotificationsRequest) (*notificationv1.BatchQueryNotificationsResponse, error) {
+	// 从metadata中解析Authorization JWT Token
+	bizID, err := jwt.GetBizIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	keys := req.GetKeys()
+	if len(keys) > batchSizeLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "%v: %d > %d", errs.ErrBatchSizeOverLimit, len(keys), batchSizeLimit)
+	}
+
+	notifications, err := s.notificationSvc.BatchGetByKeys(ctx, bizID, keys)
+	if err != nil {
+		if s.isSystemError(err) {
+			return nil, status.Errorf(codes.Internal, "%v", err)
+		}
+		return nil, status.Errorf(codes.InvalidArgument, "批量查询失败: %v", err)
+	}
+
+	results := make([]*notificationv1.SendNotificationResponse, 0, len(keys))
+	for _, key := range keys {
+		n, ok := notifications[key]
+		if !ok {
+			results = append(results, &notificationv1.SendNotificationResponse{
+				ErrorCode:    notificationv1.ErrorCode_NOTIFICATION_NOT_FOUND,
+				ErrorMessage: fmt.Sprintf("%v: key = %s", errs.ErrNotificationNotFound, key),
+			})
+			continue
+		}
+		results = append(results, s.buildGRPCSendResponse(domain.SendResponse{
+			NotificationID: n.ID,
+			Status:         n.Status,
+			Channel:        n.DeliveredChannel,
+		}, nil))
+	}
+	return &notificationv1.BatchQueryNotificationsResponse{
+		Results: results,
+	}, nil
+}
+
+// WatchNotifications 持续推送业务方通知的状态变更，直到客户端断开
+func (s *NotificationServer) WatchNotifications(req *notificationv1.WatchNotificationsRequest, stream notificationv1.NotificationQueryService_WatchNotificationsServer) error {
+	ctx := stream.Context()
+	// 从metadata中解析Authorization JWT Token
+	bizID, err := jwt.GetBizIDFromContext(ctx)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	keys := req.GetKeys()
+	if len(keys) > batchSizeLimit {
+		return status.Errorf(codes.InvalidArgument, "%v: %d > %d", errs.ErrBatchSizeOverLimit, len(keys), batchSizeLimit)
+	}
+	keySet := make(map[string]struct{}, len(keys))
+	for _, key := range keys {
+		keySet[key] = struct{}{}
+	}
+
+	cursor := req.GetCursor()
+	for {
+		if ctx.Err() != nil {
+			// 客户端断开
+			return nil
+		}
+		events, next, err1 := s.notificationSvc.ReadStatusEvents(ctx, bizID, cursor, watchBatchSize)
+		if err1 != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			if errors.Is(err1, errs.ErrInvalidParameter) {
+				return status.Errorf(codes.InvalidArgument, "%v", err1)
+			}
+			return status.Errorf(codes.Internal, "%v", err1)
+		}
+		for i := range events {
+			event := events[i]
+			if _, ok := keySet[event.Key]; len(keySet) > 0 && !ok {
+				continue
+			}
+			err1 = stream.Send(&notificationv1.WatchNotificationsResponse{
+				Cursor:                event.Cursor,
+				NotificationId:        event.NotificationID,
+				Key:                   event.Key,
+				Status:                s.convertToGRPCSendStatus(event.Status),
+				TimestampMilliseconds: event.Ctime,
+			})
+			if err1 != nil {
+				return err1
+			}
+		}
+		cursor = next
+	}
+}
+
+// ListNotifications 按照条件查询通知列表
+func (s *NotificationServer) ListNotifications(ctx context.Context, req *notificationv1.ListNotificationsRequest) (*notificationv1.ListNotificationsResponse, error) {
+	// 从metadata中解析Authorization JWT Token
+	bizID, err := jwt.GetBizIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	filter := domain.NotificationFilter{
+		BizID:     bizID,
+		Status:    s.convertToDomainSendStatus(req.GetStatus()),
+		Channel:   s.convertToDomainChannel(req.GetChannel()),
+		Receiver:  req.GetReceiver(),
+		StartTime: req.GetStartTimeMilliseconds(),
+		EndTime:   req.GetEndTimeMilliseconds(),
+	}
+	if req.GetTemplateId() != "" {
+		filter.TemplateID, err = strconv.ParseInt(req.GetTemplateId(), 10, 64)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%v: 模版ID: %s", errs.ErrInvalidParameter, req.GetTemplateId())
+		}
+	}
+
+	ns, nextCursor, err := s.notificationSvc.List(ctx, filter, req.GetCursor(), int(req.GetLimit()))
+	if err != nil {
+		if s.isSystemError(err) {
+			return nil, status.Errorf(codes.Internal, "%v", err)
+		}
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	records := make([]*notificationv1.NotificationRecord, 0, len(ns))
+	for i := range ns {
+		n := ns[i]
+		records = append(records, &notificationv1.NotificationRecord{
+			NotificationId:    n.ID,
+			Key:               n.Key,
+			Receivers:         n.Receivers,
+			Channel:           s.convertToGRPCChannel(n.Channel),
+			TemplateId:        strconv.FormatInt(n.Template.ID, 10),
+			Status:            s.convertToGRPCSendStatus(n.Status),
+			DeliveredChannel:  s.convertToGRPCChannel(n.DeliveredChannel),
+			StatusReason:      n.StatusReason,
+			CtimeMilliseconds: n.Ctime.UnixMilli(),
+			UtimeMilliseconds: n.Utime.UnixMilli(),
+		})
+	}
+	return &notificationv1.ListNotificationsResponse{
+		Notifications: records,
+		NextCursor:    nextCursor,
+	}, nil
+}
+
+// ListNotificationEvents 查询单条通知的生命周期事件
+func (s *NotificationServer) ListNotificationEvents(ctx context.Context, req *notificationv1.ListNotificationEventsRequest) (*notificationv1.ListNotificationEventsResponse, error) {
+	// 从metadata中解析Authorization JWT Token
+	bizID, err := jwt.GetBizIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	// 先按照 key 查询通知，保证只能查询本业务方的通知
+	n, err := s.notificationSvc.GetByKey(ctx, bizID, req.GetKey())
+	if err != nil {
+		if s.isSystemError(err) {
+			return nil, status.Errorf(codes.Internal, "%v", err)
+		}
+		return &notificationv1.ListNotificationEventsResponse{
+			ErrorCode:    s.convertToGRPCErrorCode(err),
+			ErrorMessage: err.Error(),
+		}, nil
+	}
+
+	events, err := s.eventSvc.ListByNotificationID(ctx, n.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+	results := make([]*notificationv1.NotificationEvent, 0, len(events))
+	for i := range events {
+		results = append(results, s.convertToGRPCNotificationEvent(events[i]))
+	}
+	return &notificationv1.ListNotificationEventsResponse{
+		NotificationId: n.ID,
+		Events:         results,
+	}, nil
+}
+
+func (s *NotificationServer) convertToGRPCNotificationEvent(e domain.NotificationEvent) *notificationv1.NotificationEvent {
+	eventType := notificationv1.NotificationEventType_NOTIFICATION_EVENT_TYPE_UNSPECIFIED
+	switch e.Type {
+	case domain.NotificationEventTypeTransition:
+		eventType = notificationv1.NotificationEventType_TRANSITION
+	case domain.NotificationEventTypeProviderAttempt:
+		eventType = notificationv1.NotificationEventType_PROVIDER_ATTEMPT
+	}
+	return &notificationv1.NotificationEvent{
+		Type:                  eventType,
+		Status:                s.convertToGRPCSendStatus(e.Status),
+		Reason:                e.Reason,
+		Channel:               s.convertToGRPCChannel(e.Channel),
+		Provider:              e.Provider.Name,
+		VendorRequestId:       e.Provider.RequestID,
+		VendorCode:            e.Provider.Code,
+		VendorMessage:         e.Provider.Message,
+		LatencyMilliseconds:   e.Latency.Milliseconds(),
+		TimestampMilliseconds: e.Ctime.UnixMilli(),
+	}
+}
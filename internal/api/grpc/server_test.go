@@ -0,0 +1,345 @@
+//go:build unit
+
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"testing"
+	"time"
+
+	notificationv1 "github.com/robinlg/notification-platform/api/proto/gen/notification/v1"
+	"github.com/robinlg/notification-platform/internal/api/grpc/interceptor/jwt"
+	"github.com/robinlg/notification-platform/internal/domain"
+	"github.com/robinlg/notification-platform/internal/errs"
+	eventmocks "github.com/robinlg/notification-platform/internal/service/event/mocks"
+	notificationmocks "github.com/robinlg/notification-platform/internal/service/notification/mocks"
+	templatemocks "github.com/robinlg/notification-platform/internal/service/template/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// fakeSendStream 按顺序返回预先准备好的请求，并且保存最终的响应
+type fakeSendStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	reqs []*notificationv1.StreamSendNotificationsRequest
+	resp *notificationv1.StreamSendNotificationsResponse
+}
+
+func (f *fakeSendStream) Context() context.Context {
+	return f.ctx
+}
+
+func (f *fakeSendStream) Recv() (*notificationv1.StreamSendNotificationsRequest, error) {
+	if len(f.reqs) == 0 {
+		return nil, io.EOF
+	}
+	req := f.reqs[0]
+	f.reqs = f.reqs[1:]
+	return req, nil
+}
+
+func (f *fakeSendStream) SendAndClose(resp *notificationv1.StreamSendNotificationsResponse) error {
+	f.resp = resp
+	return nil
+}
+
+func TestNotificationServer_StreamSendNotifications(t *testing.T) {
+	t.Parallel()
+
+	const bizID = int64(1)
+	newRequests := func(cnt int) []*notificationv1.StreamSendNotificationsRequest {
+		reqs := make([]*notificationv1.StreamSendNotificationsRequest, 0, cnt)
+		for i := 0; i < cnt; i++ {
+			reqs = append(reqs, &notificationv1.StreamSendNotificationsRequest{
+				Notification: &notificationv1.Notification{
+					Key:            "key-" + strconv.Itoa(i),
+					Receivers:      []string{"13800000000"},
+					Channel:        notificationv1.Channel_SMS,
+					TemplateId:     "10",
+					TemplateParams: map[string]string{"code": "1234"},
+				},
+			})
+		}
+		return reqs
+	}
+	// 批量保存成功时按顺序生成通知ID
+	batchSucceed := func(_ context.Context, ns ...domain.Notification) (domain.BatchSendAsyncResponse, error) {
+		ids := make([]uint64, 0, len(ns))
+		for i := range ns {
+			ids = append(ids, uint64(i+1))
+		}
+		return domain.BatchSendAsyncResponse{NotificationIDs: ids}, nil
+	}
+
+	// 失败的通知在 results 中的位置、key 和错误代码
+	type failure struct {
+		index     int64
+		key       string
+		errorCode notificationv1.ErrorCode
+	}
+	testCases := []struct {
+		name         string
+		reqs         func() []*notificationv1.StreamSendNotificationsRequest
+		mock         func(sendSvc, unlimitedSendSvc *notificationmocks.MockSendService)
+		wantTotal    int32
+		wantSuccess  int32
+		wantFailures []failure
+		wantErrors   map[notificationv1.ErrorCode]int32
+		// 服务端提前结束流时没有接收的请求数
+		wantUnread int
+		wantCode   codes.Code
+	}{
+		{
+			// 按照分批大小保存，不合法的通知不影响同一批中的其他通知
+			name: "分批保存",
+			reqs: func() []*notificationv1.StreamSendNotificationsRequest {
+				reqs := newRequests(150)
+				reqs[120].Notification.Key = ""
+				return reqs
+			},
+			mock: func(sendSvc, _ *notificationmocks.MockSendService) {
+				gomock.InOrder(
+					sendSvc.EXPECT().BatchSendNotificationsAsync(gomock.Any(), gomock.Len(streamChunkSize)).DoAndReturn(batchSucceed),
+					sendSvc.EXPECT().BatchSendNotificationsAsync(gomock.Any(), gomock.Len(49)).DoAndReturn(batchSucceed),
+				)
+			},
+			wantTotal:   150,
+			wantSuccess: 149,
+			wantFailures: []failure{
+				{index: 120, errorCode: notificationv1.ErrorCode_INVALID_PARAMETER},
+			},
+			wantErrors: map[notificationv1.ErrorCode]int32{notificationv1.ErrorCode_INVALID_PARAMETER: 1},
+		},
+		{
+			// 个别通知的 key 重复导致整批失败，逐条保存得到各自的结果，逐条保存不再占用限流的配额
+			name: "key重复时逐条保存",
+			reqs: func() []*notificationv1.StreamSendNotificationsRequest {
+				return newRequests(3)
+			},
+			mock: func(sendSvc, unlimitedSendSvc *notificationmocks.MockSendService) {
+				sendSvc.EXPECT().BatchSendNotificationsAsync(gomock.Any(), gomock.Len(3)).
+					Return(domain.BatchSendAsyncResponse{}, fmt.Errorf("%w: %w", errs.ErrSendNotificationFailed, errs.ErrNotificationDuplicate))
+				unlimitedSendSvc.EXPECT().SendNotificationAsync(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, n domain.Notification) (domain.SendResponse, error) {
+					if n.Key == "key-1" {
+						return domain.SendResponse{}, errs.ErrInvalidParameter
+					}
+					return domain.SendResponse{NotificationID: 1, Status: domain.SendStatusPending}, nil
+				}).Times(3)
+			},
+			wantTotal:   3,
+			wantSuccess: 2,
+			wantFailures: []failure{
+				{index: 1, key: "key-1", errorCode: notificationv1.ErrorCode_INVALID_PARAMETER},
+			},
+			wantErrors: map[notificationv1.ErrorCode]int32{notificationv1.ErrorCode_INVALID_PARAMETER: 1},
+		},
+		{
+			// 与单条通知无关的错误不会逐条保存，逐条保存也会失败
+			name: "系统错误时整批失败",
+			reqs: func() []*notificationv1.StreamSendNotificationsRequest {
+				return newRequests(2)
+			},
+			mock: func(sendSvc, _ *notificationmocks.MockSendService) {
+				sendSvc.EXPECT().BatchSendNotificationsAsync(gomock.Any(), gomock.Len(2)).
+					Return(domain.BatchSendAsyncResponse{}, fmt.Errorf("%w: %w", errs.ErrSendNotificationFailed, errs.ErrNoQuota))
+			},
+			wantTotal: 2,
+			wantFailures: []failure{
+				{index: 0, key: "key-0", errorCode: notificationv1.ErrorCode_SEND_NOTIFICATION_FAILED},
+				{index: 1, key: "key-1", errorCode: notificationv1.ErrorCode_SEND_NOTIFICATION_FAILED},
+			},
+			wantErrors: map[notificationv1.ErrorCode]int32{notificationv1.ErrorCode_SEND_NOTIFICATION_FAILED: 2},
+		},
+		{
+			// 达到单个流的上限之后不再接收，返回已经接收的通知的结果
+			name: "达到上限时提前结束流",
+			reqs: func() []*notificationv1.StreamSendNotificationsRequest {
+				return newRequests(maxStreamNotifications + 1)
+			},
+			mock: func(sendSvc, _ *notificationmocks.MockSendService) {
+				sendSvc.EXPECT().BatchSendNotificationsAsync(gomock.Any(), gomock.Len(streamChunkSize)).DoAndReturn(batchSucceed).
+					Times(maxStreamNotifications / streamChunkSize)
+			},
+			wantTotal:   maxStreamNotifications,
+			wantSuccess: maxStreamNotifications,
+			wantUnread:  1,
+		},
+		{
+			name: "被限流时结束整个流",
+			reqs: func() []*notificationv1.StreamSendNotificationsRequest {
+				return newRequests(2)
+			},
+			mock: func(sendSvc, _ *notificationmocks.MockSendService) {
+				sendSvc.EXPECT().BatchSendNotificationsAsync(gomock.Any(), gomock.Len(2)).
+					Return(domain.BatchSendAsyncResponse{}, errs.ErrRateLimited)
+			},
+			wantCode: codes.ResourceExhausted,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			sendSvc := notificationmocks.NewMockSendService(ctrl)
+			unlimitedSendSvc := notificationmocks.NewMockSendService(ctrl)
+			templateSvc := templatemocks.NewMockChannelTemplateService(ctrl)
+			templateSvc.EXPECT().GetTemplateByID(gomock.Any(), int64(10)).
+				Return(domain.ChannelTemplate{ID: 10, Channel: domain.ChannelSMS, ActiveVersionID: 100}, nil).AnyTimes()
+			tc.mock(sendSvc, unlimitedSendSvc)
+
+			stream := &fakeSendStream{
+				ctx:  context.WithValue(context.Background(), jwt.BizIDName, bizID),
+				reqs: tc.reqs(),
+			}
+			err := NewServer(sendSvc, unlimitedSendSvc, nil, templateSvc, nil, nil).StreamSendNotifications(stream)
+			if tc.wantCode != codes.OK {
+				assert.Equal(t, tc.wantCode, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, stream.reqs, tc.wantUnread)
+			assert.Equal(t, tc.wantTotal, stream.resp.TotalCount)
+			assert.Equal(t, tc.wantSuccess, stream.resp.SuccessCount)
+			require.Len(t, stream.resp.Results, int(tc.wantTotal))
+			var failures []failure
+			for i, result := range stream.resp.Results {
+				assert.Equal(t, int64(i), result.Index)
+				if result.Status != notificationv1.SendStatus_FAILED {
+					assert.NotZero(t, result.NotificationId)
+					continue
+				}
+				failures = append(failures, failure{index: result.Index, key: result.Key, errorCode: result.ErrorCode})
+			}
+			assert.Equal(t, tc.wantFailures, failures)
+			require.Len(t, stream.resp.Errors, len(tc.wantErrors))
+			for _, summary := range stream.resp.Errors {
+				assert.Equal(t, tc.wantErrors[summary.ErrorCode], summary.Count)
+				assert.NotEmpty(t, summary.ErrorMessage)
+			}
+		})
+	}
+}
+
+func TestNotificationServer_ListNotificationEvents(t *testing.T) {
+	t.Parallel()
+
+	const bizID = int64(1)
+	ctime := time.UnixMilli(1700000000000)
+	testCases := []struct {
+		name     string
+		mock     func(ctrl *gomock.Controller) (*notificationmocks.MockService, *eventmocks.MockService)
+		wantResp *notificationv1.ListNotificationEventsResponse
+		wantCode codes.Code
+	}{
+		{
+			name: "按照发生顺序返回事件",
+			mock: func(ctrl *gomock.Controller) (*notificationmocks.MockService, *eventmocks.MockService) {
+				notificationSvc := notificationmocks.NewMockService(ctrl)
+				eventSvc := eventmocks.NewMockService(ctrl)
+				notificationSvc.EXPECT().GetByKey(gomock.Any(), bizID, "key-1").Return(domain.Notification{ID: 10, BizID: bizID}, nil)
+				eventSvc.EXPECT().ListByNotificationID(gomock.Any(), uint64(10)).Return([]domain.NotificationEvent{
+					{
+						Type:    domain.NotificationEventTypeTransition,
+						Status:  domain.SendStatusSending,
+						Channel: domain.ChannelSMS,
+						Ctime:   ctime,
+					},
+					{
+						Type:     domain.NotificationEventTypeProviderAttempt,
+						Status:   domain.SendStatusFailed,
+						Reason:   "触发流控",
+						Channel:  domain.ChannelSMS,
+						Provider: domain.ProviderResult{Name: "aliyun", RequestID: "req-1", Code: "isv.BUSINESS_LIMIT_CONTROL", Message: "触发流控"},
+						Latency:  120 * time.Millisecond,
+						Ctime:    ctime,
+					},
+				}, nil)
+				return notificationSvc, eventSvc
+			},
+			wantResp: &notificationv1.ListNotificationEventsResponse{
+				NotificationId: 10,
+				Events: []*notificationv1.NotificationEvent{
+					{
+						Type:                  notificationv1.NotificationEventType_TRANSITION,
+						Status:                notificationv1.SendStatus_SENDING,
+						Channel:               notificationv1.Channel_SMS,
+						TimestampMilliseconds: 1700000000000,
+					},
+					{
+						Type:                  notificationv1.NotificationEventType_PROVIDER_ATTEMPT,
+						Status:                notificationv1.SendStatus_FAILED,
+						Reason:                "触发流控",
+						Channel:               notificationv1.Channel_SMS,
+						Provider:              "aliyun",
+						VendorRequestId:       "req-1",
+						VendorCode:            "isv.BUSINESS_LIMIT_CONTROL",
+						VendorMessage:         "触发流控",
+						LatencyMilliseconds:   120,
+						TimestampMilliseconds: 1700000000000,
+					},
+				},
+			},
+		},
+		{
+			// 只能查询本业务方的通知
+			name: "通知不存在",
+			mock: func(ctrl *gomock.Controller) (*notificationmocks.MockService, *eventmocks.MockService) {
+				notificationSvc := notificationmocks.NewMockService(ctrl)
+				notificationSvc.EXPECT().GetByKey(gomock.Any(), bizID, "key-1").Return(domain.Notification{}, errs.ErrNotificationNotFound)
+				return notificationSvc, eventmocks.NewMockService(ctrl)
+			},
+			wantResp: &notificationv1.ListNotificationEventsResponse{
+				ErrorCode:    notificationv1.ErrorCode_NOTIFICATION_NOT_FOUND,
+				ErrorMessage: errs.ErrNotificationNotFound.Error(),
+			},
+		},
+		{
+			name: "查询事件失败",
+			mock: func(ctrl *gomock.Controller) (*notificationmocks.MockService, *eventmocks.MockService) {
+				notificationSvc := notificationmocks.NewMockService(ctrl)
+				eventSvc := eventmocks.NewMockService(ctrl)
+				notificationSvc.EXPECT().GetByKey(gomock.Any(), bizID, "key-1").Return(domain.Notification{ID: 10, BizID: bizID}, nil)
+				eventSvc.EXPECT().ListByNotificationID(gomock.Any(), uint64(10)).Return(nil, errs.ErrDatabaseError)
+				return notificationSvc, eventSvc
+			},
+			wantCode: codes.Internal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			notificationSvc, eventSvc := tc.mock(ctrl)
+			ctx := context.WithValue(context.Background(), jwt.BizIDName, bizID)
+			resp, err := NewServer(nil, nil, notificationSvc, nil, nil, eventSvc).ListNotificationEvents(ctx,
+				&notificationv1.ListNotificationEventsRequest{Key: "key-1"})
+			if tc.wantCode != codes.OK {
+				assert.Equal(t, tc.wantCode, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantResp.NotificationId, resp.NotificationId)
+			assert.Equal(t, tc.wantResp.ErrorCode, resp.ErrorCode)
+			assert.Equal(t, tc.wantResp.ErrorMessage, resp.ErrorMessage)
+			require.Len(t, resp.Events, len(tc.wantResp.Events))
+			for i := range resp.Events {
+				assert.True(t, proto.Equal(tc.wantResp.Events[i], resp.Events[i]))
+			}
+		})
+	}
+}
@@ -1,6 +1,10 @@
 package domain
 
-import "github.com/robinlg/notification-platform/internal/pkg/retry"
+import (
+	"sort"
+
+	"github.com/robinlg/notification-platform/internal/pkg/retry"
+)
 
 // BusinessConfig 业务配置领域对象
 type BusinessConfig struct {
@@ -19,11 +23,44 @@ type BusinessConfig struct {
 type ChannelConfig struct {
 	Channels    []ChannelItem `json:"channels"`
 	RetryPolicy *retry.Config `json:"retryPolicy"`
+	// Fallback 是否开启渠道降级，开启后原渠道发送失败时按照 Priority 从小到大依次尝试其余启用的渠道
+	Fallback bool `json:"fallback"`
+}
+
+// IsChannelDisabled 渠道是否被显式禁用，未配置的渠道视为可用
+func (c *ChannelConfig) IsChannelDisabled(channel Channel) bool {
+	for i := range c.Channels {
+		if c.Channels[i].Channel == channel.String() {
+			return !c.Channels[i].Enabled
+		}
+	}
+	return false
+}
+
+// FallbackChannels 按照优先级返回除 channel 外所有启用的渠道，未开启降级时返回空
+func (c *ChannelConfig) FallbackChannels(channel Channel) []Channel {
+	if !c.Fallback {
+		return nil
+	}
+	items := make([]ChannelItem, 0, len(c.Channels))
+	for i := range c.Channels {
+		if c.Channels[i].Enabled && c.Channels[i].Channel != channel.String() {
+			items = append(items, c.Channels[i])
+		}
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].Priority < items[j].Priority
+	})
+	res := make([]Channel, 0, len(items))
+	for i := range items {
+		res = append(res, Channel(items[i].Channel))
+	}
+	return res
 }
 
 type ChannelItem struct {
 	Channel  string `json:"channel"`
-	Priority int    `json:"priority"`
+	Priority int    `json:"priority"` // 优先级，值越小越优先
 	Enabled  bool   `json:"enabled"`
 }
 
@@ -40,9 +77,36 @@ type QuotaConfig struct {
 	Monthly MonthlyConfig `json:"monthly"`
 }
 
+// UnlimitedQuota 不限制额度时使用的每月额度
+// 额度相关的 Lua 脚本按照双精度浮点数计算，不能超过 2^53
+const UnlimitedQuota int64 = 1 << 50
+
+// Limit 获取渠道的每月额度，短信和邮件未配置时为0
+// 站内信渠道是后来加入的，已有业务的配置中没有这一项，未配置时不限制额度
+func (q *QuotaConfig) Limit(channel Channel) int64 {
+	if channel == ChannelInApp && (q == nil || q.Monthly.InApp == nil) {
+		return UnlimitedQuota
+	}
+	if q == nil {
+		return 0
+	}
+	switch channel {
+	case ChannelSMS:
+		return int64(q.Monthly.SMS)
+	case ChannelEmail:
+		return int64(q.Monthly.EMAIL)
+	case ChannelInApp:
+		return int64(*q.Monthly.InApp)
+	default:
+		return 0
+	}
+}
+
 type MonthlyConfig struct {
 	SMS   int `json:"sms"`
 	EMAIL int `json:"email"`
+	// InApp 站内信的每月额度，为 nil 时不限制
+	InApp *int `json:"inApp,omitempty"`
 }
 
 type CallbackConfig struct {
@@ -0,0 +1,33 @@
+//go:build unit
+
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuotaConfig_Limit(t *testing.T) {
+	t.Parallel()
+
+	inApp := 30
+	testCases := []struct {
+		name    string
+		cfg     *QuotaConfig
+		channel Channel
+		want    int64
+	}{
+		{name: "没有额度配置时站内信不限制", channel: ChannelInApp, want: UnlimitedQuota},
+		{name: "没有额度配置时短信为0", channel: ChannelSMS, want: 0},
+		{name: "未配置站内信额度", cfg: &QuotaConfig{Monthly: MonthlyConfig{SMS: 10}}, channel: ChannelInApp, want: UnlimitedQuota},
+		{name: "配置了站内信额度", cfg: &QuotaConfig{Monthly: MonthlyConfig{InApp: &inApp}}, channel: ChannelInApp, want: 30},
+		{name: "短信额度", cfg: &QuotaConfig{Monthly: MonthlyConfig{SMS: 10}}, channel: ChannelSMS, want: 10},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.want, tc.cfg.Limit(tc.channel))
+		})
+	}
+}
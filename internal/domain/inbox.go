@@ -0,0 +1,28 @@
+package domain
+
+// InboxMessageStatus 站内信状态
+type InboxMessageStatus string
+
+const (
+	InboxMessageStatusUnread  InboxMessageStatus = "UNREAD"  // 未读
+	InboxMessageStatusRead    InboxMessageStatus = "READ"    // 已读
+	InboxMessageStatusDeleted InboxMessageStatus = "DELETED" // 已删除
+)
+
+func (s InboxMessageStatus) String() string {
+	return string(s)
+}
+
+// InboxMessage 站内信，一条站内信通知会为每个接收者生成一条站内信
+type InboxMessage struct {
+	ID             int64
+	NotificationID uint64 // 对应的通知ID
+	BizID          int64  // 业务ID
+	Receiver       string // 接收者（用户ID）
+	Title          string // 标题
+	Content        string // 内容
+	Status         InboxMessageStatus
+	ReadTime       int64 // 阅读时间
+	Ctime          int64
+	Utime          int64
+}
@@ -8,6 +8,8 @@ import (
 
 	notificationv1 "github.com/robinlg/notification-platform/api/proto/gen/notification/v1"
 	"github.com/robinlg/notification-platform/internal/errs"
+	idgen "github.com/robinlg/notification-platform/internal/pkg/id_generator"
+	"github.com/robinlg/notification-platform/internal/pkg/retry"
 )
 
 // SendStatus 通知状态
@@ -20,6 +22,7 @@ const (
 	SendStatusSending   SendStatus = "SENDING"   // 发送中
 	SendStatusSucceeded SendStatus = "SUCCEEDED" // 发送成功
 	SendStatusFailed    SendStatus = "FAILED"    // 发送失败
+	SendStatusExpired   SendStatus = "EXPIRED"   // 已过期，超出计划发送时间仍未发送
 )
 
 func (s SendStatus) String() string {
@@ -48,6 +51,68 @@ type Notification struct {
 	ScheduledETime     time.Time          `json:"scheduledETime"`     // 计划发送结束时间
 	Version            int                `json:"version"`            // 版本号
 	SendStrategyConfig SendStrategyConfig `json:"sendStrategyConfig"` // 发送策略配置
+	Fallbacks          []FallbackTarget   `json:"fallbacks"`          // 降级目标
+	DeliveredChannel   Channel            `json:"deliveredChannel"`   // 实际送达的渠道
+	RetryCount         int32              `json:"retryCount"`         // 发送失败后的重试次数
+	NextRetryTime      int64              `json:"nextRetryTime"`      // 下次重试时间，毫秒时间戳
+	StatusReason       string             `json:"statusReason"`       // 状态变更原因，由系统任务修正状态时写入
+	Priority           Priority           `json:"priority"`           // 优先级
+	Ctime              time.Time          `json:"ctime"`              // 创建时间
+	Utime              time.Time          `json:"utime"`              // 更新时间
+}
+
+// FallbackTarget 降级目标，原渠道发送失败后使用的渠道、接收者和模版，模版参数与原通知共用
+type FallbackTarget struct {
+	Channel    Channel  `json:"channel"`
+	Receivers  []string `json:"receivers"`
+	TemplateID int64    `json:"templateId"`
+}
+
+// Fallback 使用降级目标构造一个新的通知，除渠道、接收者和模版外其余字段不变
+func (n *Notification) Fallback(target FallbackTarget) Notification {
+	fn := *n
+	fn.Channel = target.Channel
+	fn.Receivers = target.Receivers
+	fn.Template.ID = target.TemplateID
+	fn.Template.VersionID = 0
+	fn.Fallbacks = nil
+	return fn
+}
+
+// retryWindow 重试窗口，从第一次发送的时间开始计算
+const retryWindow = 30 * time.Minute
+
+// SetNextRetryTimeAndStatus 发送失败后根据渠道配置中的重试策略设置下次重试时间和状态
+// 可以重试时回到 PENDING 等待调度器再次发送；没有重试策略、达到最大重试次数或者下次重试时间超出计划发送结束时间时标记为 FAILED
+// 第一次发送失败时计划发送结束时间至少延长到发送时间之后的重试窗口，
+// 否则定时发送这种在计划发送时间就结束窗口的通知永远无法重试
+func (n *Notification) SetNextRetryTimeAndStatus(cfg *ChannelConfig) {
+	now := time.Now()
+	if n.RetryCount == 0 {
+		if end := now.Add(retryWindow); end.After(n.ScheduledETime) {
+			n.ScheduledETime = end
+		}
+	}
+	interval, ok := n.nextRetryInterval(cfg)
+	nextTime := now.Add(interval)
+	if ok && !nextTime.After(n.ScheduledETime) {
+		n.RetryCount++
+		n.NextRetryTime = nextTime.UnixMilli()
+		n.Status = SendStatusPending
+		return
+	}
+	n.Status = SendStatusFailed
+}
+
+func (n *Notification) nextRetryInterval(cfg *ChannelConfig) (time.Duration, bool) {
+	if cfg == nil || cfg.RetryPolicy == nil {
+		return 0, false
+	}
+	s, err := retry.NewRetry(*cfg.RetryPolicy)
+	if err != nil {
+		return 0, false
+	}
+	return s.NextWithRetries(n.RetryCount)
 }
 
 func (n *Notification) SetSendTime() {
@@ -56,6 +121,22 @@ func (n *Notification) SetSendTime() {
 	n.ScheduledETime = etime
 }
 
+// IsReady 通知是否已经可以调度发送
+func (n *Notification) IsReady(now time.Time) bool {
+	return n.Status == SendStatusPending &&
+		!n.ScheduledSTime.After(now) &&
+		!n.ScheduledETime.Before(now) &&
+		n.NextRetryTime <= now.UnixMilli()
+}
+
+// ReadyTime 通知最早可以调度发送的时间，等待重试的通知要等到下次重试时间
+func (n *Notification) ReadyTime() time.Time {
+	if retryTime := time.UnixMilli(n.NextRetryTime); retryTime.After(n.ScheduledSTime) {
+		return retryTime
+	}
+	return n.ScheduledSTime
+}
+
 func (n *Notification) IsImmediate() bool {
 	return n.SendStrategyConfig.Type == SendStrategyImmediate
 }
@@ -101,9 +182,35 @@ func (n *Notification) Validate() error {
 		return err
 	}
 
+	for i := range n.Fallbacks {
+		if err := n.validateFallback(n.Fallbacks[i]); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+func (n *Notification) validateFallback(target FallbackTarget) error {
+	if !target.Channel.IsValid() || target.Channel == n.Channel {
+		return fmt.Errorf("%w: Fallback.Channel = %q", errs.ErrInvalidParameter, target.Channel)
+	}
+
+	if len(target.Receivers) == 0 {
+		return fmt.Errorf("%w: Fallback.Receivers = %v", errs.ErrInvalidParameter, target.Receivers)
+	}
+
+	if target.TemplateID <= 0 {
+		return fmt.Errorf("%w: Fallback.TemplateID = %d", errs.ErrInvalidParameter, target.TemplateID)
+	}
+	return nil
+}
+
+// HashSlot 通知ID中嵌入的 hash 值，调度器按照它划分分区
+func (n *Notification) HashSlot() int64 {
+	return idgen.HashValue(n.BizID, n.Key)
+}
+
 func (n *Notification) MarshalReceivers() (string, error) {
 	return n.marshal(n.Receivers)
 }
@@ -112,6 +219,13 @@ func (n *Notification) MarshalTemplateParams() (string, error) {
 	return n.marshal(n.Template.Params)
 }
 
+func (n *Notification) MarshalFallbacks() (string, error) {
+	if len(n.Fallbacks) == 0 {
+		return "", nil
+	}
+	return n.marshal(n.Fallbacks)
+}
+
 func (n *Notification) marshal(v any) (string, error) {
 	jsonBytes, err := json.Marshal(v)
 	if err != nil {
@@ -132,7 +246,12 @@ func NewNotificationFromAPI(n *notificationv1.Notification) (Notification, error
 	}
 
 	// 获取领域通知渠道
-	channel, err := getDomainChannel(n)
+	channel, err := getDomainChannel(n.Channel)
+	if err != nil {
+		return Notification{}, err
+	}
+
+	fallbacks, err := getDomainFallbacks(n.Fallbacks)
 	if err != nil {
 		return Notification{}, err
 	}
@@ -146,12 +265,40 @@ func NewNotificationFromAPI(n *notificationv1.Notification) (Notification, error
 			Params: n.TemplateParams,
 		},
 		SendStrategyConfig: getDomainSendStrategyConfig(n),
+		Fallbacks:          fallbacks,
 	}, nil
 }
 
+// getDomainFallbacks 获取领域降级目标
+func getDomainFallbacks(targets []*notificationv1.FallbackTarget) ([]FallbackTarget, error) {
+	if len(targets) == 0 {
+		return nil, nil
+	}
+	fallbacks := make([]FallbackTarget, 0, len(targets))
+	for _, t := range targets {
+		if t == nil {
+			continue
+		}
+		channel, err := getDomainChannel(t.Channel)
+		if err != nil {
+			return nil, err
+		}
+		tid, err := strconv.ParseInt(t.TemplateId, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: 降级模版ID: %s", errs.ErrInvalidParameter, t.TemplateId)
+		}
+		fallbacks = append(fallbacks, FallbackTarget{
+			Channel:    channel,
+			Receivers:  t.Receivers,
+			TemplateID: tid,
+		})
+	}
+	return fallbacks, nil
+}
+
 // getDomainChannel 获取领域通知渠道
-func getDomainChannel(n *notificationv1.Notification) (Channel, error) {
-	switch n.Channel {
+func getDomainChannel(channel notificationv1.Channel) (Channel, error) {
+	switch channel {
 	case notificationv1.Channel_SMS:
 		return ChannelSMS, nil
 	case notificationv1.Channel_EMAIL:
@@ -165,6 +312,11 @@ func getDomainChannel(n *notificationv1.Notification) (Channel, error) {
 
 // getDomainSendStrategyConfig 获取领域发送策略配置
 func getDomainSendStrategyConfig(n *notificationv1.Notification) SendStrategyConfig {
+	return NewSendStrategyConfigFromAPI(n.Strategy)
+}
+
+// NewSendStrategyConfigFromAPI 将 API 中的发送策略转换为领域发送策略配置，没有指定时为立即发送
+func NewSendStrategyConfigFromAPI(strategy *notificationv1.SendStrategy) SendStrategyConfig {
 	// 构建发送策略
 	sendStrategyType := SendStrategyImmediate // 默认为立即发送
 	var delaySeconds int64
@@ -174,8 +326,8 @@ func getDomainSendStrategyConfig(n *notificationv1.Notification) SendStrategyCon
 	var deadlineTime time.Time
 
 	// 处理发送策略
-	if n.Strategy != nil {
-		switch s := n.Strategy.StrategyType.(type) {
+	if strategy != nil {
+		switch s := strategy.StrategyType.(type) {
 		case *notificationv1.SendStrategy_Immediate:
 			sendStrategyType = SendStrategyImmediate
 		case *notificationv1.SendStrategy_Delayed:
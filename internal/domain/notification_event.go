@@ -0,0 +1,50 @@
+package domain
+
+import "time"
+
+// NotificationStatusEvent 通知状态变更事件
+type NotificationStatusEvent struct {
+	// EventID 对应的通知事件ID，投递到事件流之后用于标记事件已经投递
+	EventID int64
+	// Cursor 事件在事件流中的位置，读取时填充，断线重连时从这个位置之后继续读取
+	Cursor         string
+	NotificationID uint64
+	BizID          int64
+	Key            string
+	Status         SendStatus
+	// Ctime 状态变更时间，毫秒
+	Ctime int64
+}
+
+// NotificationEventType 通知事件类型
+type NotificationEventType string
+
+const (
+	// NotificationEventTypeTransition 状态变更
+	NotificationEventTypeTransition NotificationEventType = "TRANSITION"
+	// NotificationEventTypeProviderAttempt 调用一次供应商发送
+	NotificationEventTypeProviderAttempt NotificationEventType = "PROVIDER_ATTEMPT"
+)
+
+func (t NotificationEventType) String() string {
+	return string(t)
+}
+
+// NotificationEvent 通知生命周期事件，每次状态变更和每次调用供应商都会记录一条，用于排查发送问题
+type NotificationEvent struct {
+	ID             int64
+	NotificationID uint64
+	BizID          int64
+	Type           NotificationEventType
+	// Status 状态变更之后的状态，调用供应商时为该次调用的结果 SUCCEEDED 或者 FAILED
+	Status SendStatus
+	// Reason 状态变更的原因或者调用供应商失败的原因
+	Reason string
+	// Channel 状态变更时为通知的原始渠道，调用供应商时为实际使用的渠道，发生渠道降级时两者不同
+	Channel Channel
+	// Provider 供应商的调用结果，只有调用供应商的事件才有
+	Provider ProviderResult
+	// Latency 调用供应商的耗时
+	Latency time.Duration
+	Ctime   time.Time
+}
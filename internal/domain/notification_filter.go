@@ -0,0 +1,35 @@
+package domain
+
+import (
+	"fmt"
+
+	"github.com/robinlg/notification-platform/internal/errs"
+)
+
+// NotificationFilter 查询通知列表的过滤条件，除 BizID 之外的零值表示不按照该条件过滤
+type NotificationFilter struct {
+	BizID      int64
+	Status     SendStatus
+	Channel    Channel
+	TemplateID int64
+	// Receiver 接收者(手机/邮箱/用户ID)，精确匹配
+	Receiver string
+	// StartTime 创建时间的下限(包含)，毫秒
+	StartTime int64
+	// EndTime 创建时间的上限(不包含)，毫秒
+	EndTime int64
+}
+
+// Validate 校验过滤条件
+func (f NotificationFilter) Validate() error {
+	if f.BizID <= 0 {
+		return fmt.Errorf("%w: BizID = %d", errs.ErrInvalidParameter, f.BizID)
+	}
+	if f.TemplateID < 0 {
+		return fmt.Errorf("%w: TemplateID = %d", errs.ErrInvalidParameter, f.TemplateID)
+	}
+	if f.StartTime < 0 || f.EndTime < 0 || (f.EndTime > 0 && f.StartTime >= f.EndTime) {
+		return fmt.Errorf("%w: 创建时间范围 [%d, %d)", errs.ErrInvalidParameter, f.StartTime, f.EndTime)
+	}
+	return nil
+}
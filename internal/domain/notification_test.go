@@ -0,0 +1,98 @@
+//go:build unit
+
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/robinlg/notification-platform/internal/pkg/retry"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotification_SetNextRetryTimeAndStatus(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	cfg := &ChannelConfig{
+		RetryPolicy: &retry.Config{
+			Type:          "fixed",
+			FixedInterval: &retry.FixedIntervalConfig{MaxRetries: 2, Interval: time.Minute},
+		},
+	}
+
+	testCases := []struct {
+		name         string
+		notification Notification
+		cfg          *ChannelConfig
+		wantStatus   SendStatus
+		wantRetry    int32
+		// wantETimeAfter 可以重试时计划发送结束时间应该晚于这个时间
+		wantETimeAfter time.Time
+	}{
+		{
+			name: "定时发送在计划发送时间之后依旧可以重试",
+			notification: Notification{
+				ScheduledSTime: now.Add(-3 * time.Second),
+				ScheduledETime: now,
+			},
+			cfg:            cfg,
+			wantStatus:     SendStatusPending,
+			wantRetry:      1,
+			wantETimeAfter: now.Add(retryWindow - time.Second),
+		},
+		{
+			name: "后续重试不再延长窗口",
+			notification: Notification{
+				RetryCount:     1,
+				ScheduledETime: now.Add(30 * time.Second),
+			},
+			cfg:        cfg,
+			wantStatus: SendStatusFailed,
+			wantRetry:  1,
+		},
+		{
+			name: "达到最大重试次数",
+			notification: Notification{
+				RetryCount:     3,
+				ScheduledETime: now.Add(time.Hour),
+			},
+			cfg:        cfg,
+			wantStatus: SendStatusFailed,
+			wantRetry:  3,
+		},
+		{
+			name: "没有重试策略",
+			notification: Notification{
+				ScheduledETime: now.Add(time.Hour),
+			},
+			wantStatus: SendStatusFailed,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			n := tc.notification
+			n.SetNextRetryTimeAndStatus(tc.cfg)
+			assert.Equal(t, tc.wantStatus, n.Status)
+			assert.Equal(t, tc.wantRetry, n.RetryCount)
+			if tc.wantStatus == SendStatusPending {
+				assert.True(t, n.ScheduledETime.After(tc.wantETimeAfter))
+				assert.LessOrEqual(t, n.NextRetryTime, n.ScheduledETime.UnixMilli())
+			}
+		})
+	}
+}
+
+func TestNotification_ReadyTime(t *testing.T) {
+	t.Parallel()
+
+	stime := time.UnixMilli(1_700_000_000_000)
+	n := Notification{ScheduledSTime: stime}
+	assert.Equal(t, stime, n.ReadyTime())
+
+	// 等待重试的通知到达下次重试时间才能发送
+	n.NextRetryTime = stime.Add(time.Minute).UnixMilli()
+	assert.Equal(t, stime.Add(time.Minute), n.ReadyTime())
+}
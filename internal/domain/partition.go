@@ -0,0 +1,47 @@
+package domain
+
+import (
+	idgen "github.com/robinlg/notification-platform/internal/pkg/id_generator"
+)
+
+// Partition 调度分区，负责 hash 值在 [SlotStart, SlotEnd) 范围内的通知
+type Partition struct {
+	ID        int
+	SlotStart int64
+	SlotEnd   int64
+}
+
+// Partitions 将所有 hash 值尽量均匀地划分为 n 个连续的分区
+func Partitions(n int) []Partition {
+	if n <= 0 {
+		n = 1
+	}
+	if n > idgen.HashSlots {
+		n = idgen.HashSlots
+	}
+	res := make([]Partition, 0, n)
+	for i := 0; i < n; i++ {
+		res = append(res, Partition{
+			ID:        i,
+			SlotStart: int64(i * idgen.HashSlots / n),
+			SlotEnd:   int64((i + 1) * idgen.HashSlots / n),
+		})
+	}
+	return res
+}
+
+// PartitionOf 计算 hash 值所属的分区，与 Partitions(n) 的划分方式一致
+func PartitionOf(slot int64, n int) int {
+	if n <= 0 {
+		n = 1
+	}
+	if n > idgen.HashSlots {
+		n = idgen.HashSlots
+	}
+	id := int(slot) * n / idgen.HashSlots
+	// 整除带来的误差最多为1
+	if int(slot) >= (id+1)*idgen.HashSlots/n {
+		id++
+	}
+	return id
+}
@@ -0,0 +1,68 @@
+package domain
+
+import "strings"
+
+// Priority 通知优先级，调度器优先调度高优先级的通知
+type Priority int32
+
+const (
+	PriorityLow    Priority = 1 // 低优先级，例如推广营销
+	PriorityMedium Priority = 2 // 中优先级，例如普通通知
+	PriorityHigh   Priority = 3 // 高优先级，例如验证码
+)
+
+// Priorities 所有优先级，从高到低排列
+var Priorities = []Priority{PriorityHigh, PriorityMedium, PriorityLow}
+
+func (p Priority) IsValid() bool {
+	return p == PriorityLow || p == PriorityMedium || p == PriorityHigh
+}
+
+func (p Priority) String() string {
+	switch p {
+	case PriorityLow:
+		return "LOW"
+	case PriorityMedium:
+		return "MEDIUM"
+	case PriorityHigh:
+		return "HIGH"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// ParsePriority 解析优先级，支持数字和 LOW/MEDIUM/HIGH 两种写法
+func ParsePriority(v any) (Priority, bool) {
+	var p Priority
+	switch val := v.(type) {
+	case float64:
+		// JWT 中的数字解析出来都是 float64
+		p = Priority(val)
+	case int:
+		p = Priority(val)
+	case int64:
+		p = Priority(val)
+	case string:
+		switch strings.ToUpper(val) {
+		case "LOW":
+			p = PriorityLow
+		case "MEDIUM":
+			p = PriorityMedium
+		case "HIGH":
+			p = PriorityHigh
+		}
+	}
+	return p, p.IsValid()
+}
+
+// PriorityOf 根据模板的业务类型推断优先级
+func PriorityOf(businessType BusinessType) Priority {
+	switch businessType {
+	case BusinessTypeVerificationCode:
+		return PriorityHigh
+	case BusinessTypePromotion:
+		return PriorityLow
+	default:
+		return PriorityMedium
+	}
+}
@@ -1,7 +1,89 @@
 package domain
 
+import "time"
+
 type Quota struct {
 	BizID   int64
 	Quota   int32
 	Channel Channel
 }
+
+// QuotaRecord 业务方在某个渠道上当前周期的额度，剩余额度以 Redis 中的计数为准
+type QuotaRecord struct {
+	BizID    int64
+	Channel  Channel
+	Period   string // 额度周期，格式为 2006-01
+	Monthly  int64  // 业务配置中的每月额度
+	Adjusted int64  // 本周期内手动调整的额度
+}
+
+// Total 本周期的额度总量
+func (q QuotaRecord) Total() int64 {
+	return q.Monthly + q.Adjusted
+}
+
+// QuotaBalance 额度及其使用情况
+type QuotaBalance struct {
+	QuotaRecord
+	Remaining int64 // 剩余额度
+}
+
+// Used 已使用的额度
+func (b QuotaBalance) Used() int64 {
+	return b.Total() - b.Remaining
+}
+
+// QuotaLedgerType 额度流水类型
+type QuotaLedgerType string
+
+const (
+	QuotaLedgerTypeInit      QuotaLedgerType = "INIT"      // 首次初始化
+	QuotaLedgerTypeReset     QuotaLedgerType = "RESET"     // 每月重置
+	QuotaLedgerTypeConfig    QuotaLedgerType = "CONFIG"    // 业务配置中的每月额度发生变更
+	QuotaLedgerTypeReseed    QuotaLedgerType = "RESEED"    // Redis 数据丢失后重新写入
+	QuotaLedgerTypeAdjust    QuotaLedgerType = "ADJUST"    // 管理员手动调整
+	QuotaLedgerTypeReconcile QuotaLedgerType = "RECONCILE" // 对账修正
+)
+
+func (t QuotaLedgerType) String() string {
+	return string(t)
+}
+
+// QuotaLedger 额度流水，记录每一次额度变更
+type QuotaLedger struct {
+	ID        int64
+	BizID     int64
+	Channel   Channel
+	Period    string
+	Type      QuotaLedgerType
+	Delta     int64  // 额度总量的变化量
+	Total     int64  // 变更后本周期的额度总量
+	Remaining int64  // 变更后的剩余额度
+	Reason    string // 变更原因
+	Operator  string // 操作人，系统任务为空
+	Ctime     int64
+}
+
+// QuotaDiscrepancy 对账发现的额度差异
+type QuotaDiscrepancy struct {
+	BizID    int64
+	Channel  Channel
+	Period   string
+	Total    int64 // 本周期的额度总量
+	Used     int64 // 根据通知记录统计的已使用额度
+	Expected int64 // 应有的剩余额度
+	Actual   int64 // Redis 中的剩余额度
+	Fixed    bool  // 是否已经修正
+}
+
+// Diff Redis 中的剩余额度与应有的剩余额度之差
+func (d QuotaDiscrepancy) Diff() int64 {
+	return d.Actual - d.Expected
+}
+
+// QuotaPeriod 计算 t 在时区 loc 下所处的额度周期，额度按自然月计算，返回周期标识以及周期的起止时间 [start, end)
+func QuotaPeriod(t time.Time, loc *time.Location) (period string, start, end time.Time) {
+	t = t.In(loc)
+	start = time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, loc)
+	return start.Format("2006-01"), start, start.AddDate(0, 1, 0)
+}
@@ -2,8 +2,20 @@ package domain
 
 // SendResponse 发送响应
 type SendResponse struct {
-	NotificationID uint64     // 通知ID
-	Status         SendStatus // 发送状态
+	NotificationID uint64         // 通知ID
+	Status         SendStatus     // 发送状态
+	Channel        Channel        // 实际发送的渠道
+	Provider       ProviderResult // 供应商的调用结果，供应商调用失败时同样会返回
+	// InboxMessages 站内信渠道生成的站内信，由发送器在标记通知成功的同一个事务中写入
+	InboxMessages []InboxMessage
+}
+
+// ProviderResult 一次供应商调用的结果，用于记录通知事件
+type ProviderResult struct {
+	Name      string // 供应商名称
+	RequestID string // 供应商返回的请求ID
+	Code      string // 供应商返回的状态码
+	Message   string // 供应商返回的描述信息
 }
 
 // BatchSendResponse 批量发送响应
@@ -2,6 +2,9 @@ package domain
 
 import (
 	"fmt"
+	"regexp"
+	"sort"
+	"strings"
 
 	"github.com/robinlg/notification-platform/internal/errs"
 )
@@ -156,6 +159,9 @@ func (t *ChannelTemplate) ActiveVersion() *ChannelTemplateVersion {
 	return nil
 }
 
+// templateVariablePattern 匹配模板内容中的 ${key} 变量
+var templateVariablePattern = regexp.MustCompile(`\$\{([^{}]+)\}`)
+
 // ChannelTemplateVersion 渠道模板版本
 type ChannelTemplateVersion struct {
 	ID                       int64       // 版本ID
@@ -176,6 +182,63 @@ type ChannelTemplateVersion struct {
 	Providers []ChannelTemplateProvider // 关联的所有供应商
 }
 
+// Render 使用参数替换模板内容中的 ${key} 变量
+// 同一位置有多个变量可以匹配时 strings.Replacer 使用先传入的，所以按照变量名从长到短排序，保证结果确定
+func (v *ChannelTemplateVersion) Render(params map[string]string) string {
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if len(keys[i]) != len(keys[j]) {
+			return len(keys[i]) > len(keys[j])
+		}
+		return keys[i] < keys[j]
+	})
+
+	oldnew := make([]string, 0, 2*len(keys))
+	for _, k := range keys {
+		oldnew = append(oldnew, "${"+k+"}", params[k])
+	}
+	return strings.NewReplacer(oldnew...).Replace(v.Content)
+}
+
+// Variables 模板内容中出现的所有变量名，按照首次出现的顺序排列，不重复
+func (v *ChannelTemplateVersion) Variables() []string {
+	matches := templateVariablePattern.FindAllStringSubmatch(v.Content, -1)
+	res := make([]string, 0, len(matches))
+	seen := make(map[string]struct{}, len(matches))
+	for _, m := range matches {
+		if _, ok := seen[m[1]]; ok {
+			continue
+		}
+		seen[m[1]] = struct{}{}
+		res = append(res, m[1])
+	}
+	return res
+}
+
+// ValidateParams 校验参数是否覆盖了模板内容中的所有变量，缺少变量时渲染结果会保留 ${key}
+func (v *ChannelTemplateVersion) ValidateParams(params map[string]string) error {
+	var missing []string
+	for _, k := range v.Variables() {
+		if _, ok := params[k]; !ok {
+			missing = append(missing, k)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("%w: 模板版本ID: %d 缺少参数 %s", errs.ErrInvalidParameter, v.ID, strings.Join(missing, ","))
+	}
+	return nil
+}
+
+// RenderTitleAndBody 渲染模板内容，第一行作为标题，其余部分作为正文
+// 邮件和站内信这类需要标题的渠道使用
+func (v *ChannelTemplateVersion) RenderTitleAndBody(params map[string]string) (title, body string) {
+	title, body, _ = strings.Cut(v.Render(params), "\n")
+	return strings.TrimSpace(title), strings.TrimLeft(body, "\r\n")
+}
+
 // ChannelTemplateProvider 渠道模板供应商关联
 type ChannelTemplateProvider struct {
 	ID                       int64       // 关联ID
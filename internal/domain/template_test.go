@@ -0,0 +1,94 @@
+//go:build unit
+
+package domain
+
+import (
+	"testing"
+
+	"github.com/robinlg/notification-platform/internal/errs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChannelTemplateVersion_Render(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		content string
+		params  map[string]string
+		want    string
+	}{
+		{
+			name:    "替换所有变量",
+			content: "您的验证码是${code}，${minutes}分钟内有效",
+			params:  map[string]string{"code": "1234", "minutes": "5"},
+			want:    "您的验证码是1234，5分钟内有效",
+		},
+		{
+			name:    "没有对应参数的变量保持原样",
+			content: "您好，${name}",
+			params:  map[string]string{"code": "1234"},
+			want:    "您好，${name}",
+		},
+		{
+			name:    "多个变量可以匹配时使用更长的变量",
+			content: "${a}b}",
+			params:  map[string]string{"a": "X", "a}b": "Y"},
+			want:    "Y",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			v := &ChannelTemplateVersion{Content: tc.content}
+			// 多次渲染，避免 map 遍历顺序恰好正确
+			for i := 0; i < 20; i++ {
+				assert.Equal(t, tc.want, v.Render(tc.params))
+			}
+		})
+	}
+}
+
+func TestChannelTemplateVersion_RenderTitleAndBody(t *testing.T) {
+	t.Parallel()
+
+	v := &ChannelTemplateVersion{Content: "订单 ${id} 已发货 \r\n\r\n您的订单 ${id} 已经发货"}
+	title, body := v.RenderTitleAndBody(map[string]string{"id": "42"})
+	assert.Equal(t, "订单 42 已发货", title)
+	assert.Equal(t, "您的订单 42 已经发货", body)
+}
+
+func TestChannelTemplateVersion_ValidateParams(t *testing.T) {
+	t.Parallel()
+
+	v := &ChannelTemplateVersion{ID: 1, Content: "${name}您好，您的验证码是${code}，${name}请勿泄露"}
+	assert.Equal(t, []string{"name", "code"}, v.Variables())
+
+	testCases := []struct {
+		name    string
+		params  map[string]string
+		wantErr error
+	}{
+		{
+			name:   "参数覆盖所有变量",
+			params: map[string]string{"name": "Tom", "code": "1234"},
+		},
+		{
+			name:   "多余的参数不影响",
+			params: map[string]string{"name": "Tom", "code": "1234", "extra": "x"},
+		},
+		{
+			name:    "缺少变量",
+			params:  map[string]string{"name": "Tom"},
+			wantErr: errs.ErrInvalidParameter,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.ErrorIs(t, v.ValidateParams(tc.params), tc.wantErr)
+		})
+	}
+}
@@ -25,8 +25,12 @@ var (
 	ErrNoQuota                              = errors.New("额度已经用完")
 	ErrQuotaNotFound                        = errors.New("额度记录不存在")
 	ErrProviderNotFound                     = errors.New("供应商记录不存在")
+	ErrProviderQPSLimited                   = errors.New("供应商每秒请求数达到上限")
+	ErrProviderDailyLimited                 = errors.New("供应商每日请求数达到上限")
 	ErrUnknownChannel                       = errors.New("未知渠道类型")
 	ErrInvalidOperation                     = errors.New("无效的操作")
+	ErrNotificationExpired                  = errors.New("通知已过期，超出计划发送时间仍未发送")
+	ErrInvalidNotificationStatus            = errors.New("通知当前状态不允许该操作")
 
 	ErrCreateTemplateFailed                    = errors.New("创建模版失败")
 	ErrUpdateTemplateFailed                    = errors.New("更新模版失败")
@@ -14,7 +14,12 @@ import (
 	"github.com/robinlg/notification-platform/internal/api/grpc/interceptor/tracing"
 )
 
-func InitGrpc(noserver *grpcapi.NotificationServer, etcdClint *eetcd.Component) *egrpc.Component {
+func InitGrpc(noserver *grpcapi.NotificationServer,
+	inboxServer *grpcapi.InboxServer,
+	quotaServer *grpcapi.QuotaServer,
+	providerServer *grpcapi.ProviderServer,
+	etcdClint *eetcd.Component,
+) *egrpc.Component {
 	// 注册全局的注册中心
 	type Config struct {
 		Key string `yaml:"key"`
@@ -36,13 +41,17 @@ func InitGrpc(noserver *grpcapi.NotificationServer, etcdClint *eetcd.Component)
 	// 创建跟踪(全链路日志)拦截器
 	traceInterceptor := tracing.New().Build()
 	// 创建token拦截器
-	tokenInterceptor := jwt.New(cfg.Key).Build()
+	tokenBuilder := jwt.New(cfg.Key)
 	server := egrpc.Load("server.grpc").Build(
-		egrpc.WithUnaryInterceptor(metricsInterceptor, logInterceptor, traceInterceptor, tokenInterceptor),
+		egrpc.WithUnaryInterceptor(metricsInterceptor, logInterceptor, traceInterceptor, tokenBuilder.Build()),
+		egrpc.WithStreamInterceptor(tokenBuilder.BuildStream()),
 	)
 
 	notificationv1.RegisterNotificationServiceServer(server.Server, noserver)
 	notificationv1.RegisterNotificationQueryServiceServer(server.Server, noserver)
+	notificationv1.RegisterInboxServiceServer(server.Server, inboxServer)
+	notificationv1.RegisterQuotaServiceServer(server.Server, quotaServer)
+	notificationv1.RegisterProviderServiceServer(server.Server, providerServer)
 
 	return server
 }
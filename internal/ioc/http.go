@@ -0,0 +1,50 @@
+package ioc
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gotomicro/ego/core/econf"
+	"github.com/robinlg/notification-platform/internal/api/gateway"
+	grpcapi "github.com/robinlg/notification-platform/internal/api/grpc"
+	"github.com/robinlg/notification-platform/internal/api/grpc/interceptor/jwt"
+	"github.com/robinlg/notification-platform/internal/api/grpc/interceptor/log"
+	"github.com/robinlg/notification-platform/internal/api/grpc/interceptor/tracing"
+)
+
+// InitGateway 初始化 HTTP/JSON 网关，与 gRPC 服务使用同一个 jwt 密钥
+// 指标拦截器注册的是全局的 gRPC 指标，这里不重复注册
+func InitGateway(noserver *grpcapi.NotificationServer) *http.Server {
+	type Config struct {
+		Key string `yaml:"key"`
+	}
+	var cfg Config
+	err := econf.UnmarshalKey("jwt", &cfg)
+	if err != nil {
+		panic("config err:" + err.Error())
+	}
+
+	type ServerConfig struct {
+		Addr string `yaml:"addr"`
+	}
+	serverCfg := ServerConfig{Addr: ":8080"}
+	err = econf.UnmarshalKey("server.http", &serverCfg)
+	if err != nil {
+		panic("config err:" + err.Error())
+	}
+
+	gw, err := gateway.NewGateway(noserver, noserver,
+		log.New().Build(),
+		tracing.New().Build(),
+		jwt.New(cfg.Key).Build(),
+	)
+	if err != nil {
+		panic(err)
+	}
+	const readHeaderTimeout = 5 * time.Second
+	return &http.Server{
+		Addr:              serverCfg.Addr,
+		Handler:           gw,
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+}
@@ -13,6 +13,9 @@ const (
 	hashBits      = 10 // hash值位数
 	sequenceBits  = 12 // 序列号位数
 
+	// HashSlots hash 值的取值个数，ID 中嵌入的 hash 值范围为 [0, HashSlots)
+	HashSlots = 1 << hashBits
+
 	// 位移常量
 	sequenceShift  = 0
 	hashShift      = sequenceBits
@@ -74,6 +77,11 @@ func ExtractHashValue(id int64) int64 {
 	return (id >> hashShift) & hashMask
 }
 
+// HashValue 计算业务ID和业务关键字对应的 hash 值，与 GenerateID 嵌入 ID 中的 hash 值一致
+func HashValue(bizID int64, key string) int64 {
+	return hash.Hash(bizID, key) & hashMask
+}
+
 // ExtractSequence 从ID中提取序列号部分
 func ExtractSequence(id int64) int64 {
 	return id & sequenceMask
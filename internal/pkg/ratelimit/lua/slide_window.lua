@@ -0,0 +1,21 @@
+local key = KEYS[1]                 -- 限流对象的键
+local window = tonumber(ARGV[1])    -- 窗口大小，单位毫秒
+local limit = tonumber(ARGV[2])     -- 窗口内允许的最大次数
+local now = tonumber(ARGV[3])       -- 当前时间戳，单位毫秒
+local n = tonumber(ARGV[4])         -- 本次请求占用的次数
+local member = ARGV[5]              -- 本次请求的唯一标识
+
+-- 移除窗口之外的记录
+redis.call('ZREMRANGEBYSCORE', key, '-inf', now - window)
+
+local cnt = redis.call('ZCARD', key)
+if cnt + n > limit then
+    -- 超过限制，本次请求不占用次数
+    return 1
+end
+
+for i = 1, n do
+    redis.call('ZADD', key, now, member .. ':' .. i)
+end
+redis.call('PEXPIRE', key, window)
+return 0
@@ -0,0 +1,42 @@
+package ratelimit
+
+import (
+	"context"
+	_ "embed"
+	"math/rand/v2"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+//go:embed lua/slide_window.lua
+var slideWindowScript string
+
+var _ Limiter = (*RedisSlidingWindowLimiter)(nil)
+
+// RedisSlidingWindowLimiter 基于 Redis 有序集合的滑动窗口限流器，可以在多个实例之间共享
+type RedisSlidingWindowLimiter struct {
+	client redis.Cmdable
+	window time.Duration
+}
+
+// NewRedisSlidingWindowLimiter 创建滑动窗口限流器，window 是窗口大小
+func NewRedisSlidingWindowLimiter(client redis.Cmdable, window time.Duration) *RedisSlidingWindowLimiter {
+	return &RedisSlidingWindowLimiter{
+		client: client,
+		window: window,
+	}
+}
+
+func (r *RedisSlidingWindowLimiter) Limit(ctx context.Context, key string, n, limit int) (bool, error) {
+	now := time.Now()
+	// 同一毫秒内可能有多个请求，使用纳秒时间戳和随机数区分
+	member := strconv.FormatInt(now.UnixNano(), 10) + "-" + strconv.FormatUint(rand.Uint64(), 36)
+	res, err := r.client.Eval(ctx, slideWindowScript, []string{key},
+		r.window.Milliseconds(), limit, now.UnixMilli(), n, member).Int()
+	if err != nil {
+		return false, err
+	}
+	return res == 1, nil
+}
@@ -0,0 +1,13 @@
+package ratelimit
+
+import "context"
+
+// Limiter 限流器
+//
+//go:generate mockgen -source=./types.go -destination=./mocks/limiter.mock.go -package=limitmocks -typed Limiter
+type Limiter interface {
+	// Limit 判断本次请求是否需要被限流
+	// key 是限流对象，n 是本次请求占用的次数，limit 是窗口内允许的最大次数
+	// 返回 true 表示需要限流，此时不会占用次数
+	Limit(ctx context.Context, key string, n, limit int) (bool, error)
+}
@@ -0,0 +1,25 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/robinlg/notification-platform/internal/domain"
+)
+
+// DelayQueue 延迟队列，按照最早可以调度发送的时间保存待调度的通知ID
+// 每次将通知置为 PENDING 之后都要加入队列，否则只能等待调度器兜底扫描数据库
+// 队列按照调度分区和优先级拆分，生产者和调度器必须使用相同的分区数
+//
+//go:generate mockgen -source=./delay_queue.go -destination=./mocks/delay_queue.mock.go -package=cachemocks -typed DelayQueue
+type DelayQueue interface {
+	// Add 将通知加入队列，到达计划发送开始时间和下次重试时间后才会被取出，已经在队列中的通知会更新时间
+	Add(ctx context.Context, notifications ...domain.Notification) error
+	// Pop 原子地取出并删除指定分区、指定优先级下已经到期的通知ID，最多 limit 个
+	Pop(ctx context.Context, partition domain.Partition, priority domain.Priority, now time.Time, limit int) ([]uint64, error)
+}
+
+func DelayQueueKey(partitionID int, priority domain.Priority) string {
+	return fmt.Sprintf("notification:delay_queue:%d:%d", partitionID, priority)
+}
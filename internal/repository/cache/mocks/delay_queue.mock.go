@@ -0,0 +1,125 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./delay_queue.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./delay_queue.go -destination=./mocks/delay_queue.mock.go -package=cachemocks -typed DelayQueue
+//
+
+// Package cachemocks is a generated GoMock package.
+package cachemocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	domain "github.com/robinlg/notification-platform/internal/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDelayQueue is a mock of DelayQueue interface.
+type MockDelayQueue struct {
+	ctrl     *gomock.Controller
+	recorder *MockDelayQueueMockRecorder
+	isgomock struct{}
+}
+
+// MockDelayQueueMockRecorder is the mock recorder for MockDelayQueue.
+type MockDelayQueueMockRecorder struct {
+	mock *MockDelayQueue
+}
+
+// NewMockDelayQueue creates a new mock instance.
+func NewMockDelayQueue(ctrl *gomock.Controller) *MockDelayQueue {
+	mock := &MockDelayQueue{ctrl: ctrl}
+	mock.recorder = &MockDelayQueueMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDelayQueue) EXPECT() *MockDelayQueueMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockDelayQueue) Add(ctx context.Context, notifications ...domain.Notification) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range notifications {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Add", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockDelayQueueMockRecorder) Add(ctx any, notifications ...any) *MockDelayQueueAddCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, notifications...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockDelayQueue)(nil).Add), varargs...)
+	return &MockDelayQueueAddCall{Call: call}
+}
+
+// MockDelayQueueAddCall wrap *gomock.Call
+type MockDelayQueueAddCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockDelayQueueAddCall) Return(arg0 error) *MockDelayQueueAddCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockDelayQueueAddCall) Do(f func(context.Context, ...domain.Notification) error) *MockDelayQueueAddCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockDelayQueueAddCall) DoAndReturn(f func(context.Context, ...domain.Notification) error) *MockDelayQueueAddCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Pop mocks base method.
+func (m *MockDelayQueue) Pop(ctx context.Context, partition domain.Partition, priority domain.Priority, now time.Time, limit int) ([]uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pop", ctx, partition, priority, now, limit)
+	ret0, _ := ret[0].([]uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Pop indicates an expected call of Pop.
+func (mr *MockDelayQueueMockRecorder) Pop(ctx, partition, priority, now, limit any) *MockDelayQueuePopCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pop", reflect.TypeOf((*MockDelayQueue)(nil).Pop), ctx, partition, priority, now, limit)
+	return &MockDelayQueuePopCall{Call: call}
+}
+
+// MockDelayQueuePopCall wrap *gomock.Call
+type MockDelayQueuePopCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockDelayQueuePopCall) Return(arg0 []uint64, arg1 error) *MockDelayQueuePopCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockDelayQueuePopCall) Do(f func(context.Context, domain.Partition, domain.Priority, time.Time, int) ([]uint64, error)) *MockDelayQueuePopCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockDelayQueuePopCall) DoAndReturn(f func(context.Context, domain.Partition, domain.Priority, time.Time, int) ([]uint64, error)) *MockDelayQueuePopCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,164 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./notification_event.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./notification_event.go -destination=./mocks/notification_event.mock.go -package=cachemocks -typed NotificationStatusStream
+//
+
+// Package cachemocks is a generated GoMock package.
+package cachemocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	domain "github.com/robinlg/notification-platform/internal/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockNotificationStatusStream is a mock of NotificationStatusStream interface.
+type MockNotificationStatusStream struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationStatusStreamMockRecorder
+	isgomock struct{}
+}
+
+// MockNotificationStatusStreamMockRecorder is the mock recorder for MockNotificationStatusStream.
+type MockNotificationStatusStreamMockRecorder struct {
+	mock *MockNotificationStatusStream
+}
+
+// NewMockNotificationStatusStream creates a new mock instance.
+func NewMockNotificationStatusStream(ctrl *gomock.Controller) *MockNotificationStatusStream {
+	mock := &MockNotificationStatusStream{ctrl: ctrl}
+	mock.recorder = &MockNotificationStatusStreamMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotificationStatusStream) EXPECT() *MockNotificationStatusStreamMockRecorder {
+	return m.recorder
+}
+
+// LatestCursor mocks base method.
+func (m *MockNotificationStatusStream) LatestCursor(ctx context.Context, bizID int64) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LatestCursor", ctx, bizID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LatestCursor indicates an expected call of LatestCursor.
+func (mr *MockNotificationStatusStreamMockRecorder) LatestCursor(ctx, bizID any) *MockNotificationStatusStreamLatestCursorCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LatestCursor", reflect.TypeOf((*MockNotificationStatusStream)(nil).LatestCursor), ctx, bizID)
+	return &MockNotificationStatusStreamLatestCursorCall{Call: call}
+}
+
+// MockNotificationStatusStreamLatestCursorCall wrap *gomock.Call
+type MockNotificationStatusStreamLatestCursorCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNotificationStatusStreamLatestCursorCall) Return(arg0 string, arg1 error) *MockNotificationStatusStreamLatestCursorCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNotificationStatusStreamLatestCursorCall) Do(f func(context.Context, int64) (string, error)) *MockNotificationStatusStreamLatestCursorCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNotificationStatusStreamLatestCursorCall) DoAndReturn(f func(context.Context, int64) (string, error)) *MockNotificationStatusStreamLatestCursorCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Publish mocks base method.
+func (m *MockNotificationStatusStream) Publish(ctx context.Context, events ...domain.NotificationStatusEvent) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range events {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Publish", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Publish indicates an expected call of Publish.
+func (mr *MockNotificationStatusStreamMockRecorder) Publish(ctx any, events ...any) *MockNotificationStatusStreamPublishCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, events...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockNotificationStatusStream)(nil).Publish), varargs...)
+	return &MockNotificationStatusStreamPublishCall{Call: call}
+}
+
+// MockNotificationStatusStreamPublishCall wrap *gomock.Call
+type MockNotificationStatusStreamPublishCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNotificationStatusStreamPublishCall) Return(arg0 error) *MockNotificationStatusStreamPublishCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNotificationStatusStreamPublishCall) Do(f func(context.Context, ...domain.NotificationStatusEvent) error) *MockNotificationStatusStreamPublishCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNotificationStatusStreamPublishCall) DoAndReturn(f func(context.Context, ...domain.NotificationStatusEvent) error) *MockNotificationStatusStreamPublishCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Read mocks base method.
+func (m *MockNotificationStatusStream) Read(ctx context.Context, bizID int64, cursor string, limit int, block time.Duration) ([]domain.NotificationStatusEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Read", ctx, bizID, cursor, limit, block)
+	ret0, _ := ret[0].([]domain.NotificationStatusEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Read indicates an expected call of Read.
+func (mr *MockNotificationStatusStreamMockRecorder) Read(ctx, bizID, cursor, limit, block any) *MockNotificationStatusStreamReadCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockNotificationStatusStream)(nil).Read), ctx, bizID, cursor, limit, block)
+	return &MockNotificationStatusStreamReadCall{Call: call}
+}
+
+// MockNotificationStatusStreamReadCall wrap *gomock.Call
+type MockNotificationStatusStreamReadCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNotificationStatusStreamReadCall) Return(arg0 []domain.NotificationStatusEvent, arg1 error) *MockNotificationStatusStreamReadCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNotificationStatusStreamReadCall) Do(f func(context.Context, int64, string, int, time.Duration) ([]domain.NotificationStatusEvent, error)) *MockNotificationStatusStreamReadCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNotificationStatusStreamReadCall) DoAndReturn(f func(context.Context, int64, string, int, time.Duration) ([]domain.NotificationStatusEvent, error)) *MockNotificationStatusStreamReadCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
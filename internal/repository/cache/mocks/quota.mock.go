@@ -0,0 +1,351 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./quota.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./quota.go -destination=./mocks/quota.mock.go -package=cachemocks -typed QuotaCache
+//
+
+// Package cachemocks is a generated GoMock package.
+package cachemocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/robinlg/notification-platform/internal/domain"
+	cache "github.com/robinlg/notification-platform/internal/repository/cache"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockQuotaCache is a mock of QuotaCache interface.
+type MockQuotaCache struct {
+	ctrl     *gomock.Controller
+	recorder *MockQuotaCacheMockRecorder
+	isgomock struct{}
+}
+
+// MockQuotaCacheMockRecorder is the mock recorder for MockQuotaCache.
+type MockQuotaCacheMockRecorder struct {
+	mock *MockQuotaCache
+}
+
+// NewMockQuotaCache creates a new mock instance.
+func NewMockQuotaCache(ctrl *gomock.Controller) *MockQuotaCache {
+	mock := &MockQuotaCache{ctrl: ctrl}
+	mock.recorder = &MockQuotaCacheMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockQuotaCache) EXPECT() *MockQuotaCacheMockRecorder {
+	return m.recorder
+}
+
+// Adjust mocks base method.
+func (m *MockQuotaCache) Adjust(ctx context.Context, bizID int64, channel domain.Channel, delta int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Adjust", ctx, bizID, channel, delta)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Adjust indicates an expected call of Adjust.
+func (mr *MockQuotaCacheMockRecorder) Adjust(ctx, bizID, channel, delta any) *MockQuotaCacheAdjustCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Adjust", reflect.TypeOf((*MockQuotaCache)(nil).Adjust), ctx, bizID, channel, delta)
+	return &MockQuotaCacheAdjustCall{Call: call}
+}
+
+// MockQuotaCacheAdjustCall wrap *gomock.Call
+type MockQuotaCacheAdjustCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockQuotaCacheAdjustCall) Return(arg0 int64, arg1 error) *MockQuotaCacheAdjustCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockQuotaCacheAdjustCall) Do(f func(context.Context, int64, domain.Channel, int64) (int64, error)) *MockQuotaCacheAdjustCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockQuotaCacheAdjustCall) DoAndReturn(f func(context.Context, int64, domain.Channel, int64) (int64, error)) *MockQuotaCacheAdjustCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CompareAndSet mocks base method.
+func (m *MockQuotaCache) CompareAndSet(ctx context.Context, bizID int64, channel domain.Channel, old, quota int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompareAndSet", ctx, bizID, channel, old, quota)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompareAndSet indicates an expected call of CompareAndSet.
+func (mr *MockQuotaCacheMockRecorder) CompareAndSet(ctx, bizID, channel, old, quota any) *MockQuotaCacheCompareAndSetCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompareAndSet", reflect.TypeOf((*MockQuotaCache)(nil).CompareAndSet), ctx, bizID, channel, old, quota)
+	return &MockQuotaCacheCompareAndSetCall{Call: call}
+}
+
+// MockQuotaCacheCompareAndSetCall wrap *gomock.Call
+type MockQuotaCacheCompareAndSetCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockQuotaCacheCompareAndSetCall) Return(arg0 bool, arg1 error) *MockQuotaCacheCompareAndSetCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockQuotaCacheCompareAndSetCall) Do(f func(context.Context, int64, domain.Channel, int64, int64) (bool, error)) *MockQuotaCacheCompareAndSetCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockQuotaCacheCompareAndSetCall) DoAndReturn(f func(context.Context, int64, domain.Channel, int64, int64) (bool, error)) *MockQuotaCacheCompareAndSetCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Decr mocks base method.
+func (m *MockQuotaCache) Decr(ctx context.Context, bizID int64, channel domain.Channel, quota int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Decr", ctx, bizID, channel, quota)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Decr indicates an expected call of Decr.
+func (mr *MockQuotaCacheMockRecorder) Decr(ctx, bizID, channel, quota any) *MockQuotaCacheDecrCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decr", reflect.TypeOf((*MockQuotaCache)(nil).Decr), ctx, bizID, channel, quota)
+	return &MockQuotaCacheDecrCall{Call: call}
+}
+
+// MockQuotaCacheDecrCall wrap *gomock.Call
+type MockQuotaCacheDecrCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockQuotaCacheDecrCall) Return(arg0 error) *MockQuotaCacheDecrCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockQuotaCacheDecrCall) Do(f func(context.Context, int64, domain.Channel, int32) error) *MockQuotaCacheDecrCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockQuotaCacheDecrCall) DoAndReturn(f func(context.Context, int64, domain.Channel, int32) error) *MockQuotaCacheDecrCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Get mocks base method.
+func (m *MockQuotaCache) Get(ctx context.Context, bizID int64, channel domain.Channel) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, bizID, channel)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockQuotaCacheMockRecorder) Get(ctx, bizID, channel any) *MockQuotaCacheGetCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockQuotaCache)(nil).Get), ctx, bizID, channel)
+	return &MockQuotaCacheGetCall{Call: call}
+}
+
+// MockQuotaCacheGetCall wrap *gomock.Call
+type MockQuotaCacheGetCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockQuotaCacheGetCall) Return(arg0 int64, arg1 error) *MockQuotaCacheGetCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockQuotaCacheGetCall) Do(f func(context.Context, int64, domain.Channel) (int64, error)) *MockQuotaCacheGetCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockQuotaCacheGetCall) DoAndReturn(f func(context.Context, int64, domain.Channel) (int64, error)) *MockQuotaCacheGetCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Incr mocks base method.
+func (m *MockQuotaCache) Incr(ctx context.Context, bizID int64, channel domain.Channel, quota int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Incr", ctx, bizID, channel, quota)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Incr indicates an expected call of Incr.
+func (mr *MockQuotaCacheMockRecorder) Incr(ctx, bizID, channel, quota any) *MockQuotaCacheIncrCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Incr", reflect.TypeOf((*MockQuotaCache)(nil).Incr), ctx, bizID, channel, quota)
+	return &MockQuotaCacheIncrCall{Call: call}
+}
+
+// MockQuotaCacheIncrCall wrap *gomock.Call
+type MockQuotaCacheIncrCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockQuotaCacheIncrCall) Return(arg0 error) *MockQuotaCacheIncrCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockQuotaCacheIncrCall) Do(f func(context.Context, int64, domain.Channel, int32) error) *MockQuotaCacheIncrCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockQuotaCacheIncrCall) DoAndReturn(f func(context.Context, int64, domain.Channel, int32) error) *MockQuotaCacheIncrCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MutiDecr mocks base method.
+func (m *MockQuotaCache) MutiDecr(ctx context.Context, items []cache.IncrItem) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MutiDecr", ctx, items)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MutiDecr indicates an expected call of MutiDecr.
+func (mr *MockQuotaCacheMockRecorder) MutiDecr(ctx, items any) *MockQuotaCacheMutiDecrCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MutiDecr", reflect.TypeOf((*MockQuotaCache)(nil).MutiDecr), ctx, items)
+	return &MockQuotaCacheMutiDecrCall{Call: call}
+}
+
+// MockQuotaCacheMutiDecrCall wrap *gomock.Call
+type MockQuotaCacheMutiDecrCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockQuotaCacheMutiDecrCall) Return(arg0 error) *MockQuotaCacheMutiDecrCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockQuotaCacheMutiDecrCall) Do(f func(context.Context, []cache.IncrItem) error) *MockQuotaCacheMutiDecrCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockQuotaCacheMutiDecrCall) DoAndReturn(f func(context.Context, []cache.IncrItem) error) *MockQuotaCacheMutiDecrCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MutiIncr mocks base method.
+func (m *MockQuotaCache) MutiIncr(ctx context.Context, items []cache.IncrItem) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MutiIncr", ctx, items)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MutiIncr indicates an expected call of MutiIncr.
+func (mr *MockQuotaCacheMockRecorder) MutiIncr(ctx, items any) *MockQuotaCacheMutiIncrCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MutiIncr", reflect.TypeOf((*MockQuotaCache)(nil).MutiIncr), ctx, items)
+	return &MockQuotaCacheMutiIncrCall{Call: call}
+}
+
+// MockQuotaCacheMutiIncrCall wrap *gomock.Call
+type MockQuotaCacheMutiIncrCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockQuotaCacheMutiIncrCall) Return(arg0 error) *MockQuotaCacheMutiIncrCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockQuotaCacheMutiIncrCall) Do(f func(context.Context, []cache.IncrItem) error) *MockQuotaCacheMutiIncrCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockQuotaCacheMutiIncrCall) DoAndReturn(f func(context.Context, []cache.IncrItem) error) *MockQuotaCacheMutiIncrCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SetNX mocks base method.
+func (m *MockQuotaCache) SetNX(ctx context.Context, bizID int64, channel domain.Channel, quota int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetNX", ctx, bizID, channel, quota)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetNX indicates an expected call of SetNX.
+func (mr *MockQuotaCacheMockRecorder) SetNX(ctx, bizID, channel, quota any) *MockQuotaCacheSetNXCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNX", reflect.TypeOf((*MockQuotaCache)(nil).SetNX), ctx, bizID, channel, quota)
+	return &MockQuotaCacheSetNXCall{Call: call}
+}
+
+// MockQuotaCacheSetNXCall wrap *gomock.Call
+type MockQuotaCacheSetNXCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockQuotaCacheSetNXCall) Return(arg0 bool, arg1 error) *MockQuotaCacheSetNXCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockQuotaCacheSetNXCall) Do(f func(context.Context, int64, domain.Channel, int64) (bool, error)) *MockQuotaCacheSetNXCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockQuotaCacheSetNXCall) DoAndReturn(f func(context.Context, int64, domain.Channel, int64) (bool, error)) *MockQuotaCacheSetNXCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
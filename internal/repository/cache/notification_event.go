@@ -0,0 +1,26 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/robinlg/notification-platform/internal/domain"
+)
+
+// NotificationStatusStream 通知状态变更事件流，每个业务方一个事件流，只保留最近的一部分事件
+//
+//go:generate mockgen -source=./notification_event.go -destination=./mocks/notification_event.mock.go -package=cachemocks -typed NotificationStatusStream
+type NotificationStatusStream interface {
+	// Publish 发布状态变更事件
+	Publish(ctx context.Context, events ...domain.NotificationStatusEvent) error
+	// LatestCursor 业务方事件流中最新事件的位置，没有事件时返回最早的位置
+	LatestCursor(ctx context.Context, bizID int64) (string, error)
+	// Read 读取 cursor 之后的事件，最多 limit 个，没有事件时最多阻塞 block，超时返回空结果，block 不大于 0 时不阻塞
+	// cursor 格式不正确时返回 errs.ErrInvalidParameter
+	Read(ctx context.Context, bizID int64, cursor string, limit int, block time.Duration) ([]domain.NotificationStatusEvent, error)
+}
+
+func NotificationStatusStreamKey(bizID int64) string {
+	return fmt.Sprintf("notification:status_stream:%d", bizID)
+}
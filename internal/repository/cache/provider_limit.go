@@ -0,0 +1,26 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// ProviderLimitCache 供应商限流缓存，用于控制每秒请求数和每日请求数
+type ProviderLimitCache interface {
+	// Acquire 为供应商占用一次发送额度，超过每秒或每日上限时分别返回
+	// errs.ErrProviderQPSLimited 和 errs.ErrProviderDailyLimited，此时不会占用额度
+	Acquire(ctx context.Context, providerID int64, qpsLimit, dailyLimit int) error
+	// DailyUsed 获取供应商当日已经使用的请求数
+	DailyUsed(ctx context.Context, providerID int64) (int64, error)
+}
+
+// ProviderQPSKey 供应商每秒计数的键，同一个供应商的键使用相同的 hash tag，保证在 Redis Cluster 中落在同一个槽
+func ProviderQPSKey(providerID int64, now time.Time) string {
+	return fmt.Sprintf("provider_limit:{%d}:qps:%d", providerID, now.Unix())
+}
+
+// ProviderDailyKey 供应商每日计数的键，按照 now 所在时区的自然日划分
+func ProviderDailyKey(providerID int64, now time.Time) string {
+	return fmt.Sprintf("provider_limit:{%d}:daily:%s", providerID, now.Format("20060102"))
+}
@@ -12,7 +12,18 @@ type IncrItem struct {
 	Val     int32
 }
 
+// QuotaCache 业务方各渠道的剩余额度
+//
+//go:generate mockgen -source=./quota.go -destination=./mocks/quota.mock.go -package=cachemocks -typed QuotaCache
 type QuotaCache interface {
+	// Get 获取剩余额度，额度不存在时返回 ErrKeyNotFound
+	Get(ctx context.Context, bizID int64, channel domain.Channel) (int64, error)
+	// SetNX 额度不存在时设置剩余额度，返回是否设置成功
+	SetNX(ctx context.Context, bizID int64, channel domain.Channel, quota int64) (bool, error)
+	// Adjust 原子地调整剩余额度，返回调整后的剩余额度，额度不存在时返回 ErrKeyNotFound
+	Adjust(ctx context.Context, bizID int64, channel domain.Channel, delta int64) (int64, error)
+	// CompareAndSet 剩余额度等于 old 时设置为 quota，返回是否设置成功
+	CompareAndSet(ctx context.Context, bizID int64, channel domain.Channel, old, quota int64) (bool, error)
 	Incr(ctx context.Context, bizID int64, channel domain.Channel, quota int32) error
 	Decr(ctx context.Context, bizID int64, channel domain.Channel, quota int32) error
 	MutiIncr(ctx context.Context, items []IncrItem) error
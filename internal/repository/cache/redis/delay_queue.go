@@ -0,0 +1,68 @@
+package redis
+
+import (
+	"context"
+	_ "embed"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/robinlg/notification-platform/internal/domain"
+	"github.com/robinlg/notification-platform/internal/repository/cache"
+)
+
+//go:embed lua/pop_delay_queue.lua
+var popDelayQueueScript string
+
+type delayQueue struct {
+	client         redis.Cmdable
+	partitionCount int
+}
+
+// NewDelayQueue 创建基于 Redis 有序集合的延迟队列，partitionCount 必须与调度器的分区数一致
+func NewDelayQueue(client redis.Cmdable, partitionCount int) cache.DelayQueue {
+	return &delayQueue{
+		client:         client,
+		partitionCount: partitionCount,
+	}
+}
+
+func (q *delayQueue) Add(ctx context.Context, notifications ...domain.Notification) error {
+	if len(notifications) == 0 {
+		return nil
+	}
+	members := make(map[string][]redis.Z, len(notifications))
+	for i := range notifications {
+		n := notifications[i]
+		key := cache.DelayQueueKey(domain.PartitionOf(n.HashSlot(), q.partitionCount), n.Priority)
+		members[key] = append(members[key], redis.Z{
+			Score:  float64(n.ReadyTime().UnixMilli()),
+			Member: strconv.FormatUint(n.ID, 10),
+		})
+	}
+	pipe := q.client.Pipeline()
+	for key, zs := range members {
+		pipe.ZAdd(ctx, key, zs...)
+	}
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (q *delayQueue) Pop(ctx context.Context, partition domain.Partition, priority domain.Priority, now time.Time, limit int) ([]uint64, error) {
+	res, err := q.client.Eval(ctx, popDelayQueueScript, []string{
+		cache.DelayQueueKey(partition.ID, priority),
+	}, now.UnixMilli(), limit).StringSlice()
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]uint64, 0, len(res))
+	for _, member := range res {
+		id, err1 := strconv.ParseUint(member, 10, 64)
+		if err1 != nil {
+			// 不可能出现，忽略即可
+			continue
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
@@ -0,0 +1,9 @@
+local key = KEYS[1]
+local delta = tonumber(ARGV[1])
+
+-- 额度不存在时不能凭空创建，需要先初始化
+if redis.call('EXISTS', key) == 0 then
+    return false
+end
+
+return redis.call('INCRBY', key, delta)
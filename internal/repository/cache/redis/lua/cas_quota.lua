@@ -0,0 +1,12 @@
+local key = KEYS[1]
+local expected = tonumber(ARGV[1])
+local target = tonumber(ARGV[2])
+
+-- 只有剩余额度仍然是读取时的值才修正，避免覆盖期间发生的扣减和归还
+local current = redis.call('GET', key)
+if current == false or tonumber(current) ~= expected then
+    return 0
+end
+
+redis.call('SET', key, target)
+return 1
@@ -0,0 +1,10 @@
+local key = KEYS[1]
+local now = ARGV[1]
+local limit = tonumber(ARGV[2])
+
+-- 取出到期的成员后立刻删除，保证同一个通知只会被一个调度器取出
+local members = redis.call('ZRANGEBYSCORE', key, '-inf', now, 'LIMIT', 0, limit)
+if #members > 0 then
+    redis.call('ZREM', key, unpack(members))
+end
+return members
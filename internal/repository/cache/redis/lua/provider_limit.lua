@@ -0,0 +1,26 @@
+local qpsKey = KEYS[1]                  -- 每秒请求数计数的键
+local dailyKey = KEYS[2]                -- 每日请求数计数的键
+local qpsLimit = tonumber(ARGV[1])      -- 每秒请求数上限
+local dailyLimit = tonumber(ARGV[2])    -- 每日请求数上限
+local dailyTTL = tonumber(ARGV[3])      -- 每日计数的过期时间，单位秒
+
+local qps = tonumber(redis.call('GET', qpsKey) or 0)
+if qps >= qpsLimit then
+    -- 达到每秒上限
+    return 1
+end
+
+local daily = tonumber(redis.call('GET', dailyKey) or 0)
+if daily >= dailyLimit then
+    -- 达到每日上限
+    return 2
+end
+
+-- 两个计数同时增加，首次写入时设置过期时间
+if redis.call('INCR', qpsKey) == 1 then
+    redis.call('EXPIRE', qpsKey, 2)
+end
+if redis.call('INCR', dailyKey) == 1 then
+    redis.call('EXPIRE', dailyKey, dailyTTL)
+end
+return 0
@@ -0,0 +1,133 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/robinlg/notification-platform/internal/domain"
+	"github.com/robinlg/notification-platform/internal/errs"
+	"github.com/robinlg/notification-platform/internal/repository/cache"
+)
+
+const (
+	// 每个业务方的事件流大致保留的事件数，超出的部分由 Redis 近似裁剪
+	defaultStatusStreamMaxLen = 100000
+	// 空事件流的起始位置
+	firstStreamCursor = "0-0"
+
+	fieldNotificationID = "notification_id"
+	fieldKey            = "key"
+	fieldStatus         = "status"
+	fieldCtime          = "ctime"
+)
+
+var streamCursorRegexp = regexp.MustCompile(`^\d+-\d+$`)
+
+type notificationStatusStream struct {
+	client redis.Cmdable
+	// blockingClient 阻塞读取使用的客户端
+	blockingClient redis.Cmdable
+	maxLen         int64
+}
+
+// NewNotificationStatusStream 创建基于 Redis Stream 的通知状态变更事件流
+// 每个阻塞中的 XREAD 都会占用一个连接直到超时，blockingClient 需要使用独立的连接池，
+// 避免订阅者过多时占满额度扣减、限流和延迟队列共用的连接池，连接池大小决定了单个实例同时阻塞读取的订阅者数量
+func NewNotificationStatusStream(client, blockingClient redis.Cmdable) cache.NotificationStatusStream {
+	return &notificationStatusStream{
+		client:         client,
+		blockingClient: blockingClient,
+		maxLen:         defaultStatusStreamMaxLen,
+	}
+}
+
+func (s *notificationStatusStream) Publish(ctx context.Context, events ...domain.NotificationStatusEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+	pipe := s.client.Pipeline()
+	for i := range events {
+		e := events[i]
+		pipe.XAdd(ctx, &redis.XAddArgs{
+			Stream: cache.NotificationStatusStreamKey(e.BizID),
+			MaxLen: s.maxLen,
+			Approx: true,
+			Values: []any{
+				fieldNotificationID, strconv.FormatUint(e.NotificationID, 10),
+				fieldKey, e.Key,
+				fieldStatus, e.Status.String(),
+				fieldCtime, strconv.FormatInt(e.Ctime, 10),
+			},
+		})
+	}
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (s *notificationStatusStream) LatestCursor(ctx context.Context, bizID int64) (string, error) {
+	msgs, err := s.client.XRevRangeN(ctx, cache.NotificationStatusStreamKey(bizID), "+", "-", 1).Result()
+	if err != nil {
+		return "", err
+	}
+	if len(msgs) == 0 {
+		return firstStreamCursor, nil
+	}
+	return msgs[0].ID, nil
+}
+
+func (s *notificationStatusStream) Read(ctx context.Context, bizID int64, cursor string, limit int, block time.Duration) ([]domain.NotificationStatusEvent, error) {
+	if !streamCursorRegexp.MatchString(cursor) {
+		return nil, fmt.Errorf("%w: cursor = %s", errs.ErrInvalidParameter, cursor)
+	}
+	args := &redis.XReadArgs{
+		Streams: []string{cache.NotificationStatusStreamKey(bizID), cursor},
+		Count:   int64(limit),
+		// BLOCK 0 表示一直阻塞，不阻塞时不能传
+		Block: -1,
+	}
+	client := s.client
+	if block > 0 {
+		args.Block = block
+		client = s.blockingClient
+	}
+	streams, err := client.XRead(ctx, args).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			// 阻塞超时
+			return nil, nil
+		}
+		return nil, err
+	}
+	var events []domain.NotificationStatusEvent
+	for _, stream := range streams {
+		for _, msg := range stream.Messages {
+			events = append(events, s.toEvent(bizID, msg))
+		}
+	}
+	return events, nil
+}
+
+func (s *notificationStatusStream) toEvent(bizID int64, msg redis.XMessage) domain.NotificationStatusEvent {
+	e := domain.NotificationStatusEvent{
+		Cursor: msg.ID,
+		BizID:  bizID,
+	}
+	if v, ok := msg.Values[fieldNotificationID].(string); ok {
+		e.NotificationID, _ = strconv.ParseUint(v, 10, 64)
+	}
+	if v, ok := msg.Values[fieldKey].(string); ok {
+		e.Key = v
+	}
+	if v, ok := msg.Values[fieldStatus].(string); ok {
+		e.Status = domain.SendStatus(v)
+	}
+	if v, ok := msg.Values[fieldCtime].(string); ok {
+		e.Ctime, _ = strconv.ParseInt(v, 10, 64)
+	}
+	return e
+}
@@ -0,0 +1,75 @@
+package redis
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/robinlg/notification-platform/internal/errs"
+	"github.com/robinlg/notification-platform/internal/repository/cache"
+)
+
+const (
+	providerLimitOK           = 0
+	providerLimitQPSLimited   = 1
+	providerLimitDailyLimited = 2
+
+	// 每日计数多保留一天，避免跨天时刚写入的计数立刻过期
+	providerDailyTTL = 48 * time.Hour
+)
+
+//go:embed lua/provider_limit.lua
+var providerLimitScript string
+
+type providerLimitCache struct {
+	client redis.Cmdable
+	loc    *time.Location
+}
+
+// NewProviderLimitCache 创建供应商限流缓存，loc 为划分每日计数使用的时区，为 nil 时使用本地时区
+func NewProviderLimitCache(client redis.Cmdable, loc *time.Location) cache.ProviderLimitCache {
+	if loc == nil {
+		loc = time.Local
+	}
+	return &providerLimitCache{
+		client: client,
+		loc:    loc,
+	}
+}
+
+func (p *providerLimitCache) Acquire(ctx context.Context, providerID int64, qpsLimit, dailyLimit int) error {
+	now := time.Now().In(p.loc)
+	res, err := p.client.Eval(ctx, providerLimitScript, []string{
+		cache.ProviderQPSKey(providerID, now),
+		cache.ProviderDailyKey(providerID, now),
+	}, qpsLimit, dailyLimit, int64(providerDailyTTL/time.Second)).Int()
+	if err != nil {
+		return err
+	}
+	switch res {
+	case providerLimitOK:
+		return nil
+	case providerLimitQPSLimited:
+		return fmt.Errorf("%w: providerID = %d, qpsLimit = %d", errs.ErrProviderQPSLimited, providerID, qpsLimit)
+	case providerLimitDailyLimited:
+		return fmt.Errorf("%w: providerID = %d, dailyLimit = %d", errs.ErrProviderDailyLimited, providerID, dailyLimit)
+	default:
+		return fmt.Errorf("返回值不正确: %d", res)
+	}
+}
+
+func (p *providerLimitCache) DailyUsed(ctx context.Context, providerID int64) (int64, error) {
+	val, err := p.client.Get(ctx, cache.ProviderDailyKey(providerID, time.Now().In(p.loc))).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			// 当日还没有发送过
+			return 0, nil
+		}
+		return 0, err
+	}
+	return strconv.ParseInt(val, 10, 64)
+}
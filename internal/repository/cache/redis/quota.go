@@ -20,6 +20,10 @@ var (
 	batchDecrQuotaScript string
 	//go:embed lua/batch_incr_quota.lua
 	batchIncrQuotaScript string
+	//go:embed lua/adjust_quota.lua
+	adjustQuotaScript string
+	//go:embed lua/cas_quota.lua
+	casQuotaScript string
 )
 
 type quotaCache struct {
@@ -34,6 +38,44 @@ func NewQuotaCache(client redis.Cmdable) cache.QuotaCache {
 	}
 }
 
+func (q *quotaCache) Get(ctx context.Context, bizID int64, channel domain.Channel) (int64, error) {
+	res, err := q.client.Get(ctx, q.key(domain.Quota{
+		BizID:   bizID,
+		Channel: channel,
+	})).Int64()
+	if errors.Is(err, redis.Nil) {
+		return 0, fmt.Errorf("%w", cache.ErrKeyNotFound)
+	}
+	return res, err
+}
+
+func (q *quotaCache) SetNX(ctx context.Context, bizID int64, channel domain.Channel, quota int64) (bool, error) {
+	// 额度按月重置，不设置过期时间
+	return q.client.SetNX(ctx, q.key(domain.Quota{
+		BizID:   bizID,
+		Channel: channel,
+	}), quota, 0).Result()
+}
+
+func (q *quotaCache) Adjust(ctx context.Context, bizID int64, channel domain.Channel, delta int64) (int64, error) {
+	res, err := q.client.Eval(ctx, adjustQuotaScript, []string{q.key(domain.Quota{
+		BizID:   bizID,
+		Channel: channel,
+	})}, delta).Int64()
+	if errors.Is(err, redis.Nil) {
+		return 0, fmt.Errorf("%w", cache.ErrKeyNotFound)
+	}
+	return res, err
+}
+
+func (q *quotaCache) CompareAndSet(ctx context.Context, bizID int64, channel domain.Channel, old, quota int64) (bool, error) {
+	res, err := q.client.Eval(ctx, casQuotaScript, []string{q.key(domain.Quota{
+		BizID:   bizID,
+		Channel: channel,
+	})}, old, quota).Int()
+	return res == 1, err
+}
+
 func (q *quotaCache) Incr(ctx context.Context, bizID int64, channel domain.Channel, quota int32) error {
 	return q.client.Eval(ctx, quotaScript, []string{q.key(domain.Quota{
 		BizID:   bizID,
@@ -9,7 +9,11 @@ import (
 )
 
 // CallbackLogRepository 回调记录仓储接口
+//
+//go:generate mockgen -source=./callback_log.go -destination=./mocks/callback_log.mock.go -package=repositorymocks -typed CallbackLogRepository
 type CallbackLogRepository interface {
+	// Find 分批查找到了重试时间且处于待回调状态的回调记录
+	Find(ctx context.Context, startTime, batchSize, startID int64) (logs []domain.CallbackLog, nextStartID int64, err error)
 	Update(ctx context.Context, logs []domain.CallbackLog) error
 	FindByNotificationIDs(ctx context.Context, notificationIDs []uint64) ([]domain.CallbackLog, error)
 }
@@ -45,6 +49,26 @@ func (c *callbackLogRepository) toEntity(log domain.CallbackLog) dao.CallbackLog
 	}
 }
 
+func (c *callbackLogRepository) Find(ctx context.Context, startTime, batchSize, startID int64) (logs []domain.CallbackLog, nextStartID int64, err error) {
+	entities, nextStartID, err := c.dao.Find(ctx, startTime, batchSize, startID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(entities) == 0 {
+		return nil, nextStartID, nil
+	}
+	notificationIDs := slice.Map(entities, func(_ int, src dao.CallbackLog) uint64 {
+		return src.NotificationID
+	})
+	ns, err := c.notificationRepo.BatchGetByIDs(ctx, notificationIDs)
+	if err != nil {
+		return nil, 0, err
+	}
+	return slice.Map(entities, func(_ int, src dao.CallbackLog) domain.CallbackLog {
+		return c.toDomain(src, ns[src.NotificationID])
+	}), nextStartID, nil
+}
+
 func (c *callbackLogRepository) FindByNotificationIDs(ctx context.Context, notificationIDs []uint64) ([]domain.CallbackLog, error) {
 	logs, err := c.dao.FindByNotificationIDs(ctx, notificationIDs)
 	if err != nil {
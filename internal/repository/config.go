@@ -14,8 +14,14 @@ import (
 	"github.com/robinlg/notification-platform/internal/repository/dao"
 )
 
+// BusinessConfigRepository 业务配置仓储接口
+//
+//go:generate mockgen -source=./config.go -destination=./mocks/config.mock.go -package=repositorymocks -typed BusinessConfigRepository
 type BusinessConfigRepository interface {
 	GetByID(ctx context.Context, id int64) (domain.BusinessConfig, error)
+	GetByIDs(ctx context.Context, ids []int64) (map[int64]domain.BusinessConfig, error)
+	// Find 分页查询业务配置，直接查询数据库
+	Find(ctx context.Context, offset, limit int) ([]domain.BusinessConfig, error)
 }
 
 type businessConfigRepository struct {
@@ -9,7 +9,7 @@ import (
 	"gorm.io/gorm"
 )
 
-// CallbackLog 只有同步立刻发送会缺乏这条记录
+// CallbackLog 只有同步立刻发送并且没有进入重试的通知会缺乏这条记录
 type CallbackLog struct {
 	ID             int64  `gorm:"primaryKey;autoIncrement;comment:'回调记录ID'"`
 	NotificationID uint64 `gorm:"column:notification_id;NOT NULL;uniqueIndex:idx_notification_id;comment:'待回调通知ID'"`
@@ -44,7 +44,7 @@ func (c *callbackLogDAO) Find(ctx context.Context, startTime, batchSize, startID
 
 	result := c.db.WithContext(ctx).Model(&CallbackLog{}).
 		Where("next_retry_time <= ?", startTime).
-		Where("status = ?", domain.CallbackLogStatusPending).
+		Where("status = ?", domain.CallbackLogStatusPending.String()).
 		Where("id > ?", startID).
 		Order("id ASC").
 		Limit(int(batchSize)).
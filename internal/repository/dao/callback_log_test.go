@@ -0,0 +1,29 @@
+//go:build unit
+
+package dao
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCallbackLogDAO_Find(t *testing.T) {
+	t.Parallel()
+
+	db, mock := newMockDB(t)
+	mock.ExpectQuery("SELECT * FROM `callback_logs` WHERE next_retry_time <= ? AND status = ? AND id > ? ORDER BY id ASC LIMIT ?").
+		WithArgs(100, "PENDING", 2, 10).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "notification_id", "status"}).
+			AddRow(3, 30, "PENDING").
+			AddRow(5, 50, "PENDING"))
+
+	logs, nextStartID, err := NewCallbackLogDAO(db).Find(context.Background(), 100, 10, 2)
+	require.NoError(t, err)
+	assert.Len(t, logs, 2)
+	// 下一批从最后一条记录之后开始
+	assert.Equal(t, int64(5), nextStartID)
+}
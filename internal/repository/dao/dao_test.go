@@ -0,0 +1,53 @@
+//go:build unit
+
+package dao
+
+import (
+	"database/sql/driver"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/ego-component/egorm"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+// newMockDB 创建基于 sqlmock 的数据库，SQL 按照书写顺序精确匹配
+func newMockDB(t *testing.T) (*egorm.Component, sqlmock.Sqlmock) {
+	t.Helper()
+	return newMockDBWithMatcher(t, sqlmock.QueryMatcherEqual)
+}
+
+// newMockDBWithMatcher 创建使用指定匹配方式的数据库，用于 SQL 中直接拼接了当前时间等参数的场景
+func newMockDBWithMatcher(t *testing.T, matcher sqlmock.QueryMatcher) (*egorm.Component, sqlmock.Sqlmock) {
+	t.Helper()
+	sqlDB, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(matcher))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, mock.ExpectationsWereMet())
+		_ = sqlDB.Close()
+	})
+
+	db, err := gorm.Open(mysql.New(mysql.Config{
+		Conn:                      sqlDB,
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{})
+	require.NoError(t, err)
+	return db, mock
+}
+
+// expectTransitionEvents 期望在同一个事务中写入状态变更事件，只比较通知ID、业务ID、状态、原因和渠道
+func expectTransitionEvents(mock sqlmock.Sqlmock, events ...NotificationEvent) {
+	values := make([]string, 0, len(events))
+	args := make([]driver.Value, 0, len(events)*13)
+	for i := range events {
+		values = append(values, "(?,?,?,?,?,?,?,?,?,?,?,?,?)")
+		args = append(args, events[i].NotificationID, events[i].BizID, "TRANSITION", events[i].Status, events[i].Reason, events[i].Channel,
+			"", "", "", "", 0, false, sqlmock.AnyArg())
+	}
+	mock.ExpectExec("INSERT INTO `notification_events` (`notification_id`,`biz_id`,`type`,`status`,`reason`,`channel`,`provider`,`vendor_request_id`,`vendor_code`,`vendor_message`,`latency`,`published`,`ctime`) VALUES " + strings.Join(values, ",")).
+		WithArgs(args...).
+		WillReturnResult(sqlmock.NewResult(1, int64(len(events))))
+}
@@ -0,0 +1,117 @@
+package dao
+
+import (
+	"context"
+	"time"
+
+	"github.com/ego-component/egorm"
+	"github.com/robinlg/notification-platform/internal/domain"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// InboxMessage 站内信
+// 查询未读站内信使用 idx_biz_id_receiver_status，查询全部站内信使用 idx_biz_id_receiver_id，两者都可以按照 ID 倒序分页而无需排序
+type InboxMessage struct {
+	ID             int64  `gorm:"primaryKey;autoIncrement;index:idx_biz_id_receiver_id,priority:3;comment:'站内信ID'"`
+	NotificationID uint64 `gorm:"column:notification_id;NOT NULL;uniqueIndex:idx_notification_id_receiver,priority:1;comment:'对应的通知ID'"`
+	BizID          int64  `gorm:"type:BIGINT;NOT NULL;index:idx_biz_id_receiver_status,priority:1;index:idx_biz_id_receiver_id,priority:1;comment:'业务配表ID'"`
+	Receiver       string `gorm:"type:VARCHAR(256);NOT NULL;uniqueIndex:idx_notification_id_receiver,priority:2;index:idx_biz_id_receiver_status,priority:2;index:idx_biz_id_receiver_id,priority:2;comment:'接收者（用户ID）'"`
+	Title          string `gorm:"type:VARCHAR(256);NOT NULL;comment:'标题'"`
+	Content        string `gorm:"type:TEXT;NOT NULL;comment:'内容'"`
+	Status         string `gorm:"type:ENUM('UNREAD','READ','DELETED');NOT NULL;DEFAULT:'UNREAD';index:idx_biz_id_receiver_status,priority:3;comment:'状态'"`
+	ReadTime       int64  `gorm:"type:BIGINT;NOT NULL;DEFAULT:0;comment:'阅读时间'"`
+	Ctime          int64
+	Utime          int64
+}
+
+// TableName 重命名表
+func (InboxMessage) TableName() string {
+	return "inbox_messages"
+}
+
+type InboxDAO interface {
+	// List 分页查询接收者未删除的站内信，按照ID倒序排列，只返回 ID 小于 cursor 的站内信，cursor 为 0 时从最新的站内信开始
+	List(ctx context.Context, bizID int64, receiver string, onlyUnread bool, cursor int64, limit int) ([]InboxMessage, error)
+	// Count 统计接收者未删除的站内信数量
+	Count(ctx context.Context, bizID int64, receiver string, onlyUnread bool) (int64, error)
+	// MarkRead 将未读站内信标记为已读，ids 为空时标记全部
+	MarkRead(ctx context.Context, bizID int64, receiver string, ids []int64) (int64, error)
+	// Delete 删除站内信
+	Delete(ctx context.Context, bizID int64, receiver string, ids []int64) (int64, error)
+}
+
+type inboxDAO struct {
+	db *egorm.Component
+}
+
+func NewInboxDAO(db *egorm.Component) InboxDAO {
+	return &inboxDAO{db: db}
+}
+
+// createInboxMessages 在事务 tx 中批量创建站内信，站内信由发送器在标记通知成功时一起写入
+func createInboxMessages(tx *gorm.DB, messages []InboxMessage) error {
+	if len(messages) == 0 {
+		return nil
+	}
+	now := time.Now().UnixMilli()
+	for i := range messages {
+		messages[i].Status = domain.InboxMessageStatusUnread.String()
+		messages[i].Ctime, messages[i].Utime = now, now
+	}
+	// 发送重试时可能重复写入，忽略已经存在的站内信
+	return tx.Clauses(clause.Insert{Modifier: "IGNORE"}).Create(&messages).Error
+}
+
+func (d *inboxDAO) List(ctx context.Context, bizID int64, receiver string, onlyUnread bool, cursor int64, limit int) ([]InboxMessage, error) {
+	var messages []InboxMessage
+	db := d.query(ctx, bizID, receiver, onlyUnread)
+	if cursor > 0 {
+		db = db.Where("id < ?", cursor)
+	}
+	err := db.Order("id DESC").
+		Limit(limit).
+		Find(&messages).Error
+	return messages, err
+}
+
+func (d *inboxDAO) Count(ctx context.Context, bizID int64, receiver string, onlyUnread bool) (int64, error) {
+	var cnt int64
+	err := d.query(ctx, bizID, receiver, onlyUnread).Count(&cnt).Error
+	return cnt, err
+}
+
+func (d *inboxDAO) query(ctx context.Context, bizID int64, receiver string, onlyUnread bool) *gorm.DB {
+	db := d.db.WithContext(ctx).Model(&InboxMessage{}).Where("biz_id = ? AND receiver = ?", bizID, receiver)
+	if onlyUnread {
+		return db.Where("status = ?", domain.InboxMessageStatusUnread.String())
+	}
+	return db.Where("status IN ?", []string{domain.InboxMessageStatusUnread.String(), domain.InboxMessageStatusRead.String()})
+}
+
+func (d *inboxDAO) MarkRead(ctx context.Context, bizID int64, receiver string, ids []int64) (int64, error) {
+	now := time.Now().UnixMilli()
+	db := d.query(ctx, bizID, receiver, true)
+	if len(ids) != 0 {
+		db = db.Where("id IN ?", ids)
+	}
+	res := db.Updates(map[string]any{
+		"status":    domain.InboxMessageStatusRead.String(),
+		"read_time": now,
+		"utime":     now,
+	})
+	return res.RowsAffected, res.Error
+}
+
+func (d *inboxDAO) Delete(ctx context.Context, bizID int64, receiver string, ids []int64) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	res := d.query(ctx, bizID, receiver, false).
+		Where("id IN ?", ids).
+		Updates(map[string]any{
+			"status": domain.InboxMessageStatusDeleted.String(),
+			"utime":  time.Now().UnixMilli(),
+		})
+	return res.RowsAffected, res.Error
+}
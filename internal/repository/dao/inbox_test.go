@@ -0,0 +1,54 @@
+//go:build unit
+
+package dao
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInboxDAO_List(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name       string
+		onlyUnread bool
+		cursor     int64
+		mock       func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name: "第一页",
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT * FROM `inbox_messages` WHERE (biz_id = ? AND receiver = ?) AND status IN (?,?) ORDER BY id DESC LIMIT ?").
+					WithArgs(1, "user1", "UNREAD", "READ", 2).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(9).AddRow(7))
+			},
+		},
+		{
+			name:       "按照游标查询未读站内信",
+			onlyUnread: true,
+			cursor:     9,
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT * FROM `inbox_messages` WHERE (biz_id = ? AND receiver = ?) AND status = ? AND id < ? ORDER BY id DESC LIMIT ?").
+					WithArgs(1, "user1", "UNREAD", 9, 2).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(7).AddRow(5))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			db, mock := newMockDB(t)
+			tc.mock(mock)
+
+			messages, err := NewInboxDAO(db).List(context.Background(), 1, "user1", tc.onlyUnread, tc.cursor, 2)
+			require.NoError(t, err)
+			assert.Len(t, messages, 2)
+		})
+	}
+}
@@ -0,0 +1,1049 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./notification.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./notification.go -destination=./mocks/notification.mock.go -package=daomocks -typed NotificationDAO
+//
+
+// Package daomocks is a generated GoMock package.
+package daomocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dao "github.com/robinlg/notification-platform/internal/repository/dao"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockNotificationDAO is a mock of NotificationDAO interface.
+type MockNotificationDAO struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationDAOMockRecorder
+	isgomock struct{}
+}
+
+// MockNotificationDAOMockRecorder is the mock recorder for MockNotificationDAO.
+type MockNotificationDAOMockRecorder struct {
+	mock *MockNotificationDAO
+}
+
+// NewMockNotificationDAO creates a new mock instance.
+func NewMockNotificationDAO(ctrl *gomock.Controller) *MockNotificationDAO {
+	mock := &MockNotificationDAO{ctrl: ctrl}
+	mock.recorder = &MockNotificationDAOMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotificationDAO) EXPECT() *MockNotificationDAOMockRecorder {
+	return m.recorder
+}
+
+// BackfillHashSlot mocks base method.
+func (m *MockNotificationDAO) BackfillHashSlot(ctx context.Context, startID uint64, limit int) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackfillHashSlot", ctx, startID, limit)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BackfillHashSlot indicates an expected call of BackfillHashSlot.
+func (mr *MockNotificationDAOMockRecorder) BackfillHashSlot(ctx, startID, limit any) *MockNotificationDAOBackfillHashSlotCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackfillHashSlot", reflect.TypeOf((*MockNotificationDAO)(nil).BackfillHashSlot), ctx, startID, limit)
+	return &MockNotificationDAOBackfillHashSlotCall{Call: call}
+}
+
+// MockNotificationDAOBackfillHashSlotCall wrap *gomock.Call
+type MockNotificationDAOBackfillHashSlotCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNotificationDAOBackfillHashSlotCall) Return(arg0 uint64, arg1 error) *MockNotificationDAOBackfillHashSlotCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNotificationDAOBackfillHashSlotCall) Do(f func(context.Context, uint64, int) (uint64, error)) *MockNotificationDAOBackfillHashSlotCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNotificationDAOBackfillHashSlotCall) DoAndReturn(f func(context.Context, uint64, int) (uint64, error)) *MockNotificationDAOBackfillHashSlotCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// BackfillReceivers mocks base method.
+func (m *MockNotificationDAO) BackfillReceivers(ctx context.Context, startID uint64, limit int) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackfillReceivers", ctx, startID, limit)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BackfillReceivers indicates an expected call of BackfillReceivers.
+func (mr *MockNotificationDAOMockRecorder) BackfillReceivers(ctx, startID, limit any) *MockNotificationDAOBackfillReceiversCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackfillReceivers", reflect.TypeOf((*MockNotificationDAO)(nil).BackfillReceivers), ctx, startID, limit)
+	return &MockNotificationDAOBackfillReceiversCall{Call: call}
+}
+
+// MockNotificationDAOBackfillReceiversCall wrap *gomock.Call
+type MockNotificationDAOBackfillReceiversCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNotificationDAOBackfillReceiversCall) Return(arg0 uint64, arg1 error) *MockNotificationDAOBackfillReceiversCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNotificationDAOBackfillReceiversCall) Do(f func(context.Context, uint64, int) (uint64, error)) *MockNotificationDAOBackfillReceiversCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNotificationDAOBackfillReceiversCall) DoAndReturn(f func(context.Context, uint64, int) (uint64, error)) *MockNotificationDAOBackfillReceiversCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// BatchCreate mocks base method.
+func (m *MockNotificationDAO) BatchCreate(ctx context.Context, dataList []dao.Notification) ([]dao.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchCreate", ctx, dataList)
+	ret0, _ := ret[0].([]dao.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchCreate indicates an expected call of BatchCreate.
+func (mr *MockNotificationDAOMockRecorder) BatchCreate(ctx, dataList any) *MockNotificationDAOBatchCreateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchCreate", reflect.TypeOf((*MockNotificationDAO)(nil).BatchCreate), ctx, dataList)
+	return &MockNotificationDAOBatchCreateCall{Call: call}
+}
+
+// MockNotificationDAOBatchCreateCall wrap *gomock.Call
+type MockNotificationDAOBatchCreateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNotificationDAOBatchCreateCall) Return(arg0 []dao.Notification, arg1 error) *MockNotificationDAOBatchCreateCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNotificationDAOBatchCreateCall) Do(f func(context.Context, []dao.Notification) ([]dao.Notification, error)) *MockNotificationDAOBatchCreateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNotificationDAOBatchCreateCall) DoAndReturn(f func(context.Context, []dao.Notification) ([]dao.Notification, error)) *MockNotificationDAOBatchCreateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// BatchCreateWithCallbackLog mocks base method.
+func (m *MockNotificationDAO) BatchCreateWithCallbackLog(ctx context.Context, datas []dao.Notification) ([]dao.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchCreateWithCallbackLog", ctx, datas)
+	ret0, _ := ret[0].([]dao.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchCreateWithCallbackLog indicates an expected call of BatchCreateWithCallbackLog.
+func (mr *MockNotificationDAOMockRecorder) BatchCreateWithCallbackLog(ctx, datas any) *MockNotificationDAOBatchCreateWithCallbackLogCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchCreateWithCallbackLog", reflect.TypeOf((*MockNotificationDAO)(nil).BatchCreateWithCallbackLog), ctx, datas)
+	return &MockNotificationDAOBatchCreateWithCallbackLogCall{Call: call}
+}
+
+// MockNotificationDAOBatchCreateWithCallbackLogCall wrap *gomock.Call
+type MockNotificationDAOBatchCreateWithCallbackLogCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNotificationDAOBatchCreateWithCallbackLogCall) Return(arg0 []dao.Notification, arg1 error) *MockNotificationDAOBatchCreateWithCallbackLogCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNotificationDAOBatchCreateWithCallbackLogCall) Do(f func(context.Context, []dao.Notification) ([]dao.Notification, error)) *MockNotificationDAOBatchCreateWithCallbackLogCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNotificationDAOBatchCreateWithCallbackLogCall) DoAndReturn(f func(context.Context, []dao.Notification) ([]dao.Notification, error)) *MockNotificationDAOBatchCreateWithCallbackLogCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// BatchGetByIDs mocks base method.
+func (m *MockNotificationDAO) BatchGetByIDs(ctx context.Context, ids []uint64) (map[uint64]dao.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchGetByIDs", ctx, ids)
+	ret0, _ := ret[0].(map[uint64]dao.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchGetByIDs indicates an expected call of BatchGetByIDs.
+func (mr *MockNotificationDAOMockRecorder) BatchGetByIDs(ctx, ids any) *MockNotificationDAOBatchGetByIDsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchGetByIDs", reflect.TypeOf((*MockNotificationDAO)(nil).BatchGetByIDs), ctx, ids)
+	return &MockNotificationDAOBatchGetByIDsCall{Call: call}
+}
+
+// MockNotificationDAOBatchGetByIDsCall wrap *gomock.Call
+type MockNotificationDAOBatchGetByIDsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNotificationDAOBatchGetByIDsCall) Return(arg0 map[uint64]dao.Notification, arg1 error) *MockNotificationDAOBatchGetByIDsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNotificationDAOBatchGetByIDsCall) Do(f func(context.Context, []uint64) (map[uint64]dao.Notification, error)) *MockNotificationDAOBatchGetByIDsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNotificationDAOBatchGetByIDsCall) DoAndReturn(f func(context.Context, []uint64) (map[uint64]dao.Notification, error)) *MockNotificationDAOBatchGetByIDsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// BatchGetByKeys mocks base method.
+func (m *MockNotificationDAO) BatchGetByKeys(ctx context.Context, bizID int64, keys []string) ([]dao.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchGetByKeys", ctx, bizID, keys)
+	ret0, _ := ret[0].([]dao.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchGetByKeys indicates an expected call of BatchGetByKeys.
+func (mr *MockNotificationDAOMockRecorder) BatchGetByKeys(ctx, bizID, keys any) *MockNotificationDAOBatchGetByKeysCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchGetByKeys", reflect.TypeOf((*MockNotificationDAO)(nil).BatchGetByKeys), ctx, bizID, keys)
+	return &MockNotificationDAOBatchGetByKeysCall{Call: call}
+}
+
+// MockNotificationDAOBatchGetByKeysCall wrap *gomock.Call
+type MockNotificationDAOBatchGetByKeysCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNotificationDAOBatchGetByKeysCall) Return(arg0 []dao.Notification, arg1 error) *MockNotificationDAOBatchGetByKeysCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNotificationDAOBatchGetByKeysCall) Do(f func(context.Context, int64, []string) ([]dao.Notification, error)) *MockNotificationDAOBatchGetByKeysCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNotificationDAOBatchGetByKeysCall) DoAndReturn(f func(context.Context, int64, []string) ([]dao.Notification, error)) *MockNotificationDAOBatchGetByKeysCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// BatchMarkRetry mocks base method.
+func (m *MockNotificationDAO) BatchMarkRetry(ctx context.Context, entities []dao.Notification) ([]dao.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchMarkRetry", ctx, entities)
+	ret0, _ := ret[0].([]dao.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchMarkRetry indicates an expected call of BatchMarkRetry.
+func (mr *MockNotificationDAOMockRecorder) BatchMarkRetry(ctx, entities any) *MockNotificationDAOBatchMarkRetryCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchMarkRetry", reflect.TypeOf((*MockNotificationDAO)(nil).BatchMarkRetry), ctx, entities)
+	return &MockNotificationDAOBatchMarkRetryCall{Call: call}
+}
+
+// MockNotificationDAOBatchMarkRetryCall wrap *gomock.Call
+type MockNotificationDAOBatchMarkRetryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNotificationDAOBatchMarkRetryCall) Return(arg0 []dao.Notification, arg1 error) *MockNotificationDAOBatchMarkRetryCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNotificationDAOBatchMarkRetryCall) Do(f func(context.Context, []dao.Notification) ([]dao.Notification, error)) *MockNotificationDAOBatchMarkRetryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNotificationDAOBatchMarkRetryCall) DoAndReturn(f func(context.Context, []dao.Notification) ([]dao.Notification, error)) *MockNotificationDAOBatchMarkRetryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// BatchUpdateStatusSucceededOrFailed mocks base method.
+func (m *MockNotificationDAO) BatchUpdateStatusSucceededOrFailed(ctx context.Context, successNotifications, failedNotifications []dao.Notification, inbox []dao.InboxMessage) ([]dao.Notification, []dao.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchUpdateStatusSucceededOrFailed", ctx, successNotifications, failedNotifications, inbox)
+	ret0, _ := ret[0].([]dao.Notification)
+	ret1, _ := ret[1].([]dao.Notification)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// BatchUpdateStatusSucceededOrFailed indicates an expected call of BatchUpdateStatusSucceededOrFailed.
+func (mr *MockNotificationDAOMockRecorder) BatchUpdateStatusSucceededOrFailed(ctx, successNotifications, failedNotifications, inbox any) *MockNotificationDAOBatchUpdateStatusSucceededOrFailedCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchUpdateStatusSucceededOrFailed", reflect.TypeOf((*MockNotificationDAO)(nil).BatchUpdateStatusSucceededOrFailed), ctx, successNotifications, failedNotifications, inbox)
+	return &MockNotificationDAOBatchUpdateStatusSucceededOrFailedCall{Call: call}
+}
+
+// MockNotificationDAOBatchUpdateStatusSucceededOrFailedCall wrap *gomock.Call
+type MockNotificationDAOBatchUpdateStatusSucceededOrFailedCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNotificationDAOBatchUpdateStatusSucceededOrFailedCall) Return(succeeded, failed []dao.Notification, err error) *MockNotificationDAOBatchUpdateStatusSucceededOrFailedCall {
+	c.Call = c.Call.Return(succeeded, failed, err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNotificationDAOBatchUpdateStatusSucceededOrFailedCall) Do(f func(context.Context, []dao.Notification, []dao.Notification, []dao.InboxMessage) ([]dao.Notification, []dao.Notification, error)) *MockNotificationDAOBatchUpdateStatusSucceededOrFailedCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNotificationDAOBatchUpdateStatusSucceededOrFailedCall) DoAndReturn(f func(context.Context, []dao.Notification, []dao.Notification, []dao.InboxMessage) ([]dao.Notification, []dao.Notification, error)) *MockNotificationDAOBatchUpdateStatusSucceededOrFailedCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CASCancel mocks base method.
+func (m *MockNotificationDAO) CASCancel(ctx context.Context, data dao.Notification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CASCancel", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CASCancel indicates an expected call of CASCancel.
+func (mr *MockNotificationDAOMockRecorder) CASCancel(ctx, data any) *MockNotificationDAOCASCancelCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CASCancel", reflect.TypeOf((*MockNotificationDAO)(nil).CASCancel), ctx, data)
+	return &MockNotificationDAOCASCancelCall{Call: call}
+}
+
+// MockNotificationDAOCASCancelCall wrap *gomock.Call
+type MockNotificationDAOCASCancelCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNotificationDAOCASCancelCall) Return(arg0 error) *MockNotificationDAOCASCancelCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNotificationDAOCASCancelCall) Do(f func(context.Context, dao.Notification) error) *MockNotificationDAOCASCancelCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNotificationDAOCASCancelCall) DoAndReturn(f func(context.Context, dao.Notification) error) *MockNotificationDAOCASCancelCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CASMarkExpired mocks base method.
+func (m *MockNotificationDAO) CASMarkExpired(ctx context.Context, notifications []dao.Notification) ([]dao.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CASMarkExpired", ctx, notifications)
+	ret0, _ := ret[0].([]dao.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CASMarkExpired indicates an expected call of CASMarkExpired.
+func (mr *MockNotificationDAOMockRecorder) CASMarkExpired(ctx, notifications any) *MockNotificationDAOCASMarkExpiredCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CASMarkExpired", reflect.TypeOf((*MockNotificationDAO)(nil).CASMarkExpired), ctx, notifications)
+	return &MockNotificationDAOCASMarkExpiredCall{Call: call}
+}
+
+// MockNotificationDAOCASMarkExpiredCall wrap *gomock.Call
+type MockNotificationDAOCASMarkExpiredCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNotificationDAOCASMarkExpiredCall) Return(arg0 []dao.Notification, arg1 error) *MockNotificationDAOCASMarkExpiredCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNotificationDAOCASMarkExpiredCall) Do(f func(context.Context, []dao.Notification) ([]dao.Notification, error)) *MockNotificationDAOCASMarkExpiredCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNotificationDAOCASMarkExpiredCall) DoAndReturn(f func(context.Context, []dao.Notification) ([]dao.Notification, error)) *MockNotificationDAOCASMarkExpiredCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CASMarkSending mocks base method.
+func (m *MockNotificationDAO) CASMarkSending(ctx context.Context, entities []dao.Notification) ([]dao.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CASMarkSending", ctx, entities)
+	ret0, _ := ret[0].([]dao.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CASMarkSending indicates an expected call of CASMarkSending.
+func (mr *MockNotificationDAOMockRecorder) CASMarkSending(ctx, entities any) *MockNotificationDAOCASMarkSendingCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CASMarkSending", reflect.TypeOf((*MockNotificationDAO)(nil).CASMarkSending), ctx, entities)
+	return &MockNotificationDAOCASMarkSendingCall{Call: call}
+}
+
+// MockNotificationDAOCASMarkSendingCall wrap *gomock.Call
+type MockNotificationDAOCASMarkSendingCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNotificationDAOCASMarkSendingCall) Return(arg0 []dao.Notification, arg1 error) *MockNotificationDAOCASMarkSendingCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNotificationDAOCASMarkSendingCall) Do(f func(context.Context, []dao.Notification) ([]dao.Notification, error)) *MockNotificationDAOCASMarkSendingCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNotificationDAOCASMarkSendingCall) DoAndReturn(f func(context.Context, []dao.Notification) ([]dao.Notification, error)) *MockNotificationDAOCASMarkSendingCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CASRecoverSending mocks base method.
+func (m *MockNotificationDAO) CASRecoverSending(ctx context.Context, notification dao.Notification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CASRecoverSending", ctx, notification)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CASRecoverSending indicates an expected call of CASRecoverSending.
+func (mr *MockNotificationDAOMockRecorder) CASRecoverSending(ctx, notification any) *MockNotificationDAOCASRecoverSendingCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CASRecoverSending", reflect.TypeOf((*MockNotificationDAO)(nil).CASRecoverSending), ctx, notification)
+	return &MockNotificationDAOCASRecoverSendingCall{Call: call}
+}
+
+// MockNotificationDAOCASRecoverSendingCall wrap *gomock.Call
+type MockNotificationDAOCASRecoverSendingCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNotificationDAOCASRecoverSendingCall) Return(arg0 error) *MockNotificationDAOCASRecoverSendingCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNotificationDAOCASRecoverSendingCall) Do(f func(context.Context, dao.Notification) error) *MockNotificationDAOCASRecoverSendingCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNotificationDAOCASRecoverSendingCall) DoAndReturn(f func(context.Context, dao.Notification) error) *MockNotificationDAOCASRecoverSendingCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CASStatus mocks base method.
+func (m *MockNotificationDAO) CASStatus(ctx context.Context, notification dao.Notification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CASStatus", ctx, notification)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CASStatus indicates an expected call of CASStatus.
+func (mr *MockNotificationDAOMockRecorder) CASStatus(ctx, notification any) *MockNotificationDAOCASStatusCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CASStatus", reflect.TypeOf((*MockNotificationDAO)(nil).CASStatus), ctx, notification)
+	return &MockNotificationDAOCASStatusCall{Call: call}
+}
+
+// MockNotificationDAOCASStatusCall wrap *gomock.Call
+type MockNotificationDAOCASStatusCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNotificationDAOCASStatusCall) Return(arg0 error) *MockNotificationDAOCASStatusCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNotificationDAOCASStatusCall) Do(f func(context.Context, dao.Notification) error) *MockNotificationDAOCASStatusCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNotificationDAOCASStatusCall) DoAndReturn(f func(context.Context, dao.Notification) error) *MockNotificationDAOCASStatusCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CASUpdatePending mocks base method.
+func (m *MockNotificationDAO) CASUpdatePending(ctx context.Context, data dao.Notification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CASUpdatePending", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CASUpdatePending indicates an expected call of CASUpdatePending.
+func (mr *MockNotificationDAOMockRecorder) CASUpdatePending(ctx, data any) *MockNotificationDAOCASUpdatePendingCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CASUpdatePending", reflect.TypeOf((*MockNotificationDAO)(nil).CASUpdatePending), ctx, data)
+	return &MockNotificationDAOCASUpdatePendingCall{Call: call}
+}
+
+// MockNotificationDAOCASUpdatePendingCall wrap *gomock.Call
+type MockNotificationDAOCASUpdatePendingCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNotificationDAOCASUpdatePendingCall) Return(arg0 error) *MockNotificationDAOCASUpdatePendingCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNotificationDAOCASUpdatePendingCall) Do(f func(context.Context, dao.Notification) error) *MockNotificationDAOCASUpdatePendingCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNotificationDAOCASUpdatePendingCall) DoAndReturn(f func(context.Context, dao.Notification) error) *MockNotificationDAOCASUpdatePendingCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CountUsedQuota mocks base method.
+func (m *MockNotificationDAO) CountUsedQuota(ctx context.Context, bizID int64, channel string, start, end int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountUsedQuota", ctx, bizID, channel, start, end)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountUsedQuota indicates an expected call of CountUsedQuota.
+func (mr *MockNotificationDAOMockRecorder) CountUsedQuota(ctx, bizID, channel, start, end any) *MockNotificationDAOCountUsedQuotaCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUsedQuota", reflect.TypeOf((*MockNotificationDAO)(nil).CountUsedQuota), ctx, bizID, channel, start, end)
+	return &MockNotificationDAOCountUsedQuotaCall{Call: call}
+}
+
+// MockNotificationDAOCountUsedQuotaCall wrap *gomock.Call
+type MockNotificationDAOCountUsedQuotaCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNotificationDAOCountUsedQuotaCall) Return(arg0 int64, arg1 error) *MockNotificationDAOCountUsedQuotaCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNotificationDAOCountUsedQuotaCall) Do(f func(context.Context, int64, string, int64, int64) (int64, error)) *MockNotificationDAOCountUsedQuotaCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNotificationDAOCountUsedQuotaCall) DoAndReturn(f func(context.Context, int64, string, int64, int64) (int64, error)) *MockNotificationDAOCountUsedQuotaCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Create mocks base method.
+func (m *MockNotificationDAO) Create(ctx context.Context, data dao.Notification) (dao.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, data)
+	ret0, _ := ret[0].(dao.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockNotificationDAOMockRecorder) Create(ctx, data any) *MockNotificationDAOCreateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockNotificationDAO)(nil).Create), ctx, data)
+	return &MockNotificationDAOCreateCall{Call: call}
+}
+
+// MockNotificationDAOCreateCall wrap *gomock.Call
+type MockNotificationDAOCreateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNotificationDAOCreateCall) Return(arg0 dao.Notification, arg1 error) *MockNotificationDAOCreateCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNotificationDAOCreateCall) Do(f func(context.Context, dao.Notification) (dao.Notification, error)) *MockNotificationDAOCreateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNotificationDAOCreateCall) DoAndReturn(f func(context.Context, dao.Notification) (dao.Notification, error)) *MockNotificationDAOCreateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CreateWithCallbackLog mocks base method.
+func (m *MockNotificationDAO) CreateWithCallbackLog(ctx context.Context, data dao.Notification) (dao.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWithCallbackLog", ctx, data)
+	ret0, _ := ret[0].(dao.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWithCallbackLog indicates an expected call of CreateWithCallbackLog.
+func (mr *MockNotificationDAOMockRecorder) CreateWithCallbackLog(ctx, data any) *MockNotificationDAOCreateWithCallbackLogCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWithCallbackLog", reflect.TypeOf((*MockNotificationDAO)(nil).CreateWithCallbackLog), ctx, data)
+	return &MockNotificationDAOCreateWithCallbackLogCall{Call: call}
+}
+
+// MockNotificationDAOCreateWithCallbackLogCall wrap *gomock.Call
+type MockNotificationDAOCreateWithCallbackLogCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNotificationDAOCreateWithCallbackLogCall) Return(arg0 dao.Notification, arg1 error) *MockNotificationDAOCreateWithCallbackLogCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNotificationDAOCreateWithCallbackLogCall) Do(f func(context.Context, dao.Notification) (dao.Notification, error)) *MockNotificationDAOCreateWithCallbackLogCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNotificationDAOCreateWithCallbackLogCall) DoAndReturn(f func(context.Context, dao.Notification) (dao.Notification, error)) *MockNotificationDAOCreateWithCallbackLogCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindExpiredPending mocks base method.
+func (m *MockNotificationDAO) FindExpiredPending(ctx context.Context, etime int64, limit int) ([]dao.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindExpiredPending", ctx, etime, limit)
+	ret0, _ := ret[0].([]dao.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindExpiredPending indicates an expected call of FindExpiredPending.
+func (mr *MockNotificationDAOMockRecorder) FindExpiredPending(ctx, etime, limit any) *MockNotificationDAOFindExpiredPendingCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindExpiredPending", reflect.TypeOf((*MockNotificationDAO)(nil).FindExpiredPending), ctx, etime, limit)
+	return &MockNotificationDAOFindExpiredPendingCall{Call: call}
+}
+
+// MockNotificationDAOFindExpiredPendingCall wrap *gomock.Call
+type MockNotificationDAOFindExpiredPendingCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNotificationDAOFindExpiredPendingCall) Return(arg0 []dao.Notification, arg1 error) *MockNotificationDAOFindExpiredPendingCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNotificationDAOFindExpiredPendingCall) Do(f func(context.Context, int64, int) ([]dao.Notification, error)) *MockNotificationDAOFindExpiredPendingCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNotificationDAOFindExpiredPendingCall) DoAndReturn(f func(context.Context, int64, int) ([]dao.Notification, error)) *MockNotificationDAOFindExpiredPendingCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindReadyNotifications mocks base method.
+func (m *MockNotificationDAO) FindReadyNotifications(ctx context.Context, priority int32, slotStart, slotEnd int64, offset, limit int) ([]dao.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindReadyNotifications", ctx, priority, slotStart, slotEnd, offset, limit)
+	ret0, _ := ret[0].([]dao.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindReadyNotifications indicates an expected call of FindReadyNotifications.
+func (mr *MockNotificationDAOMockRecorder) FindReadyNotifications(ctx, priority, slotStart, slotEnd, offset, limit any) *MockNotificationDAOFindReadyNotificationsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindReadyNotifications", reflect.TypeOf((*MockNotificationDAO)(nil).FindReadyNotifications), ctx, priority, slotStart, slotEnd, offset, limit)
+	return &MockNotificationDAOFindReadyNotificationsCall{Call: call}
+}
+
+// MockNotificationDAOFindReadyNotificationsCall wrap *gomock.Call
+type MockNotificationDAOFindReadyNotificationsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNotificationDAOFindReadyNotificationsCall) Return(arg0 []dao.Notification, arg1 error) *MockNotificationDAOFindReadyNotificationsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNotificationDAOFindReadyNotificationsCall) Do(f func(context.Context, int32, int64, int64, int, int) ([]dao.Notification, error)) *MockNotificationDAOFindReadyNotificationsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNotificationDAOFindReadyNotificationsCall) DoAndReturn(f func(context.Context, int32, int64, int64, int, int) ([]dao.Notification, error)) *MockNotificationDAOFindReadyNotificationsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindTimeoutSending mocks base method.
+func (m *MockNotificationDAO) FindTimeoutSending(ctx context.Context, utime int64, limit int) ([]dao.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTimeoutSending", ctx, utime, limit)
+	ret0, _ := ret[0].([]dao.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTimeoutSending indicates an expected call of FindTimeoutSending.
+func (mr *MockNotificationDAOMockRecorder) FindTimeoutSending(ctx, utime, limit any) *MockNotificationDAOFindTimeoutSendingCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTimeoutSending", reflect.TypeOf((*MockNotificationDAO)(nil).FindTimeoutSending), ctx, utime, limit)
+	return &MockNotificationDAOFindTimeoutSendingCall{Call: call}
+}
+
+// MockNotificationDAOFindTimeoutSendingCall wrap *gomock.Call
+type MockNotificationDAOFindTimeoutSendingCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNotificationDAOFindTimeoutSendingCall) Return(arg0 []dao.Notification, arg1 error) *MockNotificationDAOFindTimeoutSendingCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNotificationDAOFindTimeoutSendingCall) Do(f func(context.Context, int64, int) ([]dao.Notification, error)) *MockNotificationDAOFindTimeoutSendingCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNotificationDAOFindTimeoutSendingCall) DoAndReturn(f func(context.Context, int64, int) ([]dao.Notification, error)) *MockNotificationDAOFindTimeoutSendingCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByKey mocks base method.
+func (m *MockNotificationDAO) GetByKey(ctx context.Context, bizID int64, key string) (dao.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByKey", ctx, bizID, key)
+	ret0, _ := ret[0].(dao.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByKey indicates an expected call of GetByKey.
+func (mr *MockNotificationDAOMockRecorder) GetByKey(ctx, bizID, key any) *MockNotificationDAOGetByKeyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByKey", reflect.TypeOf((*MockNotificationDAO)(nil).GetByKey), ctx, bizID, key)
+	return &MockNotificationDAOGetByKeyCall{Call: call}
+}
+
+// MockNotificationDAOGetByKeyCall wrap *gomock.Call
+type MockNotificationDAOGetByKeyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNotificationDAOGetByKeyCall) Return(arg0 dao.Notification, arg1 error) *MockNotificationDAOGetByKeyCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNotificationDAOGetByKeyCall) Do(f func(context.Context, int64, string) (dao.Notification, error)) *MockNotificationDAOGetByKeyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNotificationDAOGetByKeyCall) DoAndReturn(f func(context.Context, int64, string) (dao.Notification, error)) *MockNotificationDAOGetByKeyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockNotificationDAO) List(ctx context.Context, filter dao.NotificationFilter, cursor uint64, limit int) ([]dao.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, filter, cursor, limit)
+	ret0, _ := ret[0].([]dao.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockNotificationDAOMockRecorder) List(ctx, filter, cursor, limit any) *MockNotificationDAOListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockNotificationDAO)(nil).List), ctx, filter, cursor, limit)
+	return &MockNotificationDAOListCall{Call: call}
+}
+
+// MockNotificationDAOListCall wrap *gomock.Call
+type MockNotificationDAOListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNotificationDAOListCall) Return(arg0 []dao.Notification, arg1 error) *MockNotificationDAOListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNotificationDAOListCall) Do(f func(context.Context, dao.NotificationFilter, uint64, int) ([]dao.Notification, error)) *MockNotificationDAOListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNotificationDAOListCall) DoAndReturn(f func(context.Context, dao.NotificationFilter, uint64, int) ([]dao.Notification, error)) *MockNotificationDAOListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MarkFailed mocks base method.
+func (m *MockNotificationDAO) MarkFailed(ctx context.Context, entity dao.Notification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkFailed", ctx, entity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkFailed indicates an expected call of MarkFailed.
+func (mr *MockNotificationDAOMockRecorder) MarkFailed(ctx, entity any) *MockNotificationDAOMarkFailedCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkFailed", reflect.TypeOf((*MockNotificationDAO)(nil).MarkFailed), ctx, entity)
+	return &MockNotificationDAOMarkFailedCall{Call: call}
+}
+
+// MockNotificationDAOMarkFailedCall wrap *gomock.Call
+type MockNotificationDAOMarkFailedCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNotificationDAOMarkFailedCall) Return(arg0 error) *MockNotificationDAOMarkFailedCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNotificationDAOMarkFailedCall) Do(f func(context.Context, dao.Notification) error) *MockNotificationDAOMarkFailedCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNotificationDAOMarkFailedCall) DoAndReturn(f func(context.Context, dao.Notification) error) *MockNotificationDAOMarkFailedCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MarkRetry mocks base method.
+func (m *MockNotificationDAO) MarkRetry(ctx context.Context, entity dao.Notification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkRetry", ctx, entity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkRetry indicates an expected call of MarkRetry.
+func (mr *MockNotificationDAOMockRecorder) MarkRetry(ctx, entity any) *MockNotificationDAOMarkRetryCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkRetry", reflect.TypeOf((*MockNotificationDAO)(nil).MarkRetry), ctx, entity)
+	return &MockNotificationDAOMarkRetryCall{Call: call}
+}
+
+// MockNotificationDAOMarkRetryCall wrap *gomock.Call
+type MockNotificationDAOMarkRetryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNotificationDAOMarkRetryCall) Return(arg0 error) *MockNotificationDAOMarkRetryCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNotificationDAOMarkRetryCall) Do(f func(context.Context, dao.Notification) error) *MockNotificationDAOMarkRetryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNotificationDAOMarkRetryCall) DoAndReturn(f func(context.Context, dao.Notification) error) *MockNotificationDAOMarkRetryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MarkSuccess mocks base method.
+func (m *MockNotificationDAO) MarkSuccess(ctx context.Context, entity dao.Notification, inbox []dao.InboxMessage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkSuccess", ctx, entity, inbox)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkSuccess indicates an expected call of MarkSuccess.
+func (mr *MockNotificationDAOMockRecorder) MarkSuccess(ctx, entity, inbox any) *MockNotificationDAOMarkSuccessCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkSuccess", reflect.TypeOf((*MockNotificationDAO)(nil).MarkSuccess), ctx, entity, inbox)
+	return &MockNotificationDAOMarkSuccessCall{Call: call}
+}
+
+// MockNotificationDAOMarkSuccessCall wrap *gomock.Call
+type MockNotificationDAOMarkSuccessCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNotificationDAOMarkSuccessCall) Return(arg0 error) *MockNotificationDAOMarkSuccessCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNotificationDAOMarkSuccessCall) Do(f func(context.Context, dao.Notification, []dao.InboxMessage) error) *MockNotificationDAOMarkSuccessCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNotificationDAOMarkSuccessCall) DoAndReturn(f func(context.Context, dao.Notification, []dao.InboxMessage) error) *MockNotificationDAOMarkSuccessCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Resend mocks base method.
+func (m *MockNotificationDAO) Resend(ctx context.Context, failed, next dao.Notification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resend", ctx, failed, next)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Resend indicates an expected call of Resend.
+func (mr *MockNotificationDAOMockRecorder) Resend(ctx, failed, next any) *MockNotificationDAOResendCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resend", reflect.TypeOf((*MockNotificationDAO)(nil).Resend), ctx, failed, next)
+	return &MockNotificationDAOResendCall{Call: call}
+}
+
+// MockNotificationDAOResendCall wrap *gomock.Call
+type MockNotificationDAOResendCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNotificationDAOResendCall) Return(arg0 error) *MockNotificationDAOResendCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNotificationDAOResendCall) Do(f func(context.Context, dao.Notification, dao.Notification) error) *MockNotificationDAOResendCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNotificationDAOResendCall) DoAndReturn(f func(context.Context, dao.Notification, dao.Notification) error) *MockNotificationDAOResendCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
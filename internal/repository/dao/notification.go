@@ -2,8 +2,10 @@ package dao
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/ecodeclub/ekit/slice"
@@ -11,25 +13,83 @@ import (
 	"github.com/go-sql-driver/mysql"
 	"github.com/robinlg/notification-platform/internal/domain"
 	"github.com/robinlg/notification-platform/internal/errs"
+	idgen "github.com/robinlg/notification-platform/internal/pkg/id_generator"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // Notification 通知记录表
 type Notification struct {
-	ID                uint64 `gorm:"primaryKey;comment:'雪花算法ID'"`
-	BizID             int64  `gorm:"type:BIGINT;NOT NULL;index:idx_biz_id_status,priority:1;uniqueIndex:idx_biz_id_key,priority:1;comment:'业务配表ID，业务方可能有多个业务每个业务配置不同'"`
+	ID                uint64 `gorm:"primaryKey;index:idx_biz_id_status_id,priority:3;index:idx_biz_id_channel_id,priority:3;index:idx_biz_id_template_id_id,priority:3;index:idx_biz_id_id,priority:2;comment:'雪花算法ID'"`
+	BizID             int64  `gorm:"type:BIGINT;NOT NULL;index:idx_biz_id_status_id,priority:1;uniqueIndex:idx_biz_id_key,priority:1;index:idx_biz_id_channel_ctime,priority:1;index:idx_biz_id_channel_id,priority:1;index:idx_biz_id_template_id_id,priority:1;index:idx_biz_id_ctime,priority:1;index:idx_biz_id_quota_time,priority:1;index:idx_biz_id_id,priority:1;comment:'业务配表ID，业务方可能有多个业务每个业务配置不同'"`
 	Key               string `gorm:"type:VARCHAR(256);NOT NULL;uniqueIndex:idx_biz_id_key,priority:2;comment:'业务内唯一标识，区分同一个业务内的不同通知'"`
 	Receivers         string `gorm:"type:TEXT;NOT NULL;comment:'接收者(手机/邮箱/用户ID)，JSON数组'"`
-	Channel           string `gorm:"type:ENUM('SMS','EMAIL','IN_APP');NOT NULL;comment:'发送渠道'"`
-	TemplateID        int64  `gorm:"type:BIGINT;NOT NULL;comment:'模板ID'"`
+	Channel           string `gorm:"type:ENUM('SMS','EMAIL','IN_APP');NOT NULL;index:idx_biz_id_channel_ctime,priority:2;index:idx_biz_id_channel_id,priority:2;comment:'发送渠道'"`
+	TemplateID        int64  `gorm:"type:BIGINT;NOT NULL;index:idx_biz_id_template_id_id,priority:2;comment:'模板ID'"`
 	TemplateVersionID int64  `gorm:"type:BIGINT;NOT NULL;comment:'模板版本ID'"`
 	TemplateParams    string `gorm:"NOT NULL;comment:'模版参数'"`
-	Status            string `gorm:"type:ENUM('PREPARE','CANCELED','PENDING','SENDING','SUCCEEDED','FAILED');DEFAULT:'PENDING';index:idx_biz_id_status,priority:2;index:idx_scheduled,priority:3;comment:'发送状态'"`
-	ScheduledSTime    int64  `gorm:"column:scheduled_stime;index:idx_scheduled,priority:1;comment:'计划发送开始时间'"`
+	Status            string `gorm:"type:ENUM('PREPARE','CANCELED','PENDING','SENDING','SUCCEEDED','FAILED','EXPIRED');DEFAULT:'PENDING';index:idx_biz_id_status_id,priority:2;index:idx_scheduled,priority:3;index:idx_status_utime,priority:1;index:idx_priority_status_slot_stime,priority:2;comment:'发送状态'"`
+	ScheduledSTime    int64  `gorm:"column:scheduled_stime;index:idx_scheduled,priority:1;index:idx_priority_status_slot_stime,priority:4;comment:'计划发送开始时间'"`
 	ScheduledETime    int64  `gorm:"column:scheduled_etime;index:idx_scheduled,priority:2;comment:'计划发送结束时间'"`
 	Version           int    `gorm:"type:INT;NOT NULL;DEFAULT:1;comment:'版本号，用于CAS操作'"`
-	Ctime             int64
-	Utime             int64
+	Fallbacks         string `gorm:"type:TEXT;comment:'降级目标，JSON数组'"`
+	DeliveredChannel  string `gorm:"type:VARCHAR(16);NOT NULL;DEFAULT:'';comment:'实际送达的渠道，发送成功后写入'"`
+	RetryCount        int32  `gorm:"type:INT;NOT NULL;DEFAULT:0;comment:'发送失败后的重试次数'"`
+	NextRetryTime     int64  `gorm:"type:BIGINT;NOT NULL;DEFAULT:0;comment:'下次重试时间，未发生重试时为0'"`
+	StatusReason      string `gorm:"type:VARCHAR(256);NOT NULL;DEFAULT:'';comment:'状态变更原因，由系统任务修正状态时写入'"`
+	Priority          int32  `gorm:"type:TINYINT;NOT NULL;DEFAULT:2;index:idx_priority_status_slot_stime,priority:1;comment:'优先级，1-低，2-中，3-高'"`
+	HashSlot          int64  `gorm:"type:SMALLINT;NOT NULL;DEFAULT:0;index:idx_priority_status_slot_stime,priority:3;comment:'ID 中嵌入的 hash 值，调度器按照它划分分区'"`
+	QuotaTime         int64  `gorm:"type:BIGINT;NOT NULL;DEFAULT:0;index:idx_biz_id_quota_time,priority:2;comment:'占用额度的时间，创建和重新发送时写入，早期数据为0时以创建时间为准'"`
+	Ctime             int64  `gorm:"index:idx_biz_id_channel_ctime,priority:3;index:idx_biz_id_ctime,priority:2"`
+	Utime             int64  `gorm:"index:idx_status_utime,priority:2"`
+}
+
+// NotificationFilter 查询通知列表的过滤条件，除 BizID 之外的零值表示不按照该条件过滤
+type NotificationFilter struct {
+	BizID      int64
+	Status     string
+	Channel    string
+	TemplateID int64
+	Receiver   string
+	// StartTime 和 EndTime 为创建时间范围 [StartTime, EndTime)
+	StartTime int64
+	EndTime   int64
+}
+
+// NotificationReceiver 通知的接收者，通知表中的接收者是 JSON 数组，无法建立索引，按照接收者查询通知时使用这张表
+// idx_biz_id_receiver_notification_id 的顺序与查询通知列表的排序一致，不需要额外排序
+type NotificationReceiver struct {
+	ID             int64  `gorm:"primaryKey;autoIncrement;comment:'自增ID'"`
+	NotificationID uint64 `gorm:"type:BIGINT UNSIGNED;NOT NULL;uniqueIndex:idx_notification_id_receiver,priority:1;index:idx_biz_id_receiver_notification_id,priority:3;comment:'通知ID'"`
+	BizID          int64  `gorm:"type:BIGINT;NOT NULL;index:idx_biz_id_receiver_notification_id,priority:1;comment:'业务配表ID'"`
+	Receiver       string `gorm:"type:VARCHAR(256);NOT NULL;uniqueIndex:idx_notification_id_receiver,priority:2;index:idx_biz_id_receiver_notification_id,priority:2;comment:'接收者(手机/邮箱/用户ID)'"`
+	Ctime          int64  `gorm:"type:BIGINT;NOT NULL;comment:'通知的创建时间'"`
+}
+
+// TableName 重命名表
+func (NotificationReceiver) TableName() string {
+	return "notification_receivers"
+}
+
+// NotificationAttempt 通知的发送记录，重新发送之前保存上一次发送的结果
+type NotificationAttempt struct {
+	ID               int64  `gorm:"primaryKey;autoIncrement;comment:'发送记录ID'"`
+	NotificationID   uint64 `gorm:"type:BIGINT UNSIGNED;NOT NULL;uniqueIndex:idx_notification_id_attempt,priority:1;comment:'通知ID'"`
+	Attempt          int32  `gorm:"type:INT;NOT NULL;uniqueIndex:idx_notification_id_attempt,priority:2;comment:'第几次发送，从1开始'"`
+	BizID            int64  `gorm:"type:BIGINT;NOT NULL;comment:'业务配表ID'"`
+	Status           string `gorm:"type:VARCHAR(16);NOT NULL;comment:'该次发送的最终状态'"`
+	StatusReason     string `gorm:"type:VARCHAR(256);NOT NULL;DEFAULT:'';comment:'该次发送的失败原因'"`
+	RetryCount       int32  `gorm:"type:INT;NOT NULL;DEFAULT:0;comment:'该次发送的重试次数'"`
+	DeliveredChannel string `gorm:"type:VARCHAR(16);NOT NULL;DEFAULT:'';comment:'该次发送实际送达的渠道'"`
+	ScheduledSTime   int64  `gorm:"column:scheduled_stime;comment:'该次发送的计划发送开始时间'"`
+	ScheduledETime   int64  `gorm:"column:scheduled_etime;comment:'该次发送的计划发送结束时间'"`
+	FinishedTime     int64  `gorm:"type:BIGINT;NOT NULL;comment:'该次发送得到最终状态的时间'"`
+	Ctime            int64
+}
+
+// TableName 重命名表
+func (NotificationAttempt) TableName() string {
+	return "notification_attempts"
 }
 
 type notificationDAO struct {
@@ -46,6 +106,10 @@ func NewNotificationDAO(db *egorm.Component) NotificationDAO {
 	}
 }
 
+// NotificationDAO 通知数据访问接口
+// 写入通知状态的方法在同一个事务中记录状态变更事件，只有状态实际写入的通知才会记录
+//
+//go:generate mockgen -source=./notification.go -destination=./mocks/notification.mock.go -package=daomocks -typed NotificationDAO
 type NotificationDAO interface {
 	// Create 创建单条通知记录，但不创建对应的回调记录，它还会扣减额度
 	Create(ctx context.Context, data Notification) (Notification, error)
@@ -55,22 +119,60 @@ type NotificationDAO interface {
 	BatchCreate(ctx context.Context, dataList []Notification) ([]Notification, error)
 	// BatchCreateWithCallbackLog 批量创建通知记录，同时创建对应的回调记录
 	BatchCreateWithCallbackLog(ctx context.Context, datas []Notification) ([]Notification, error)
-	// MarkSuccess 标记通知为成功
-	MarkSuccess(ctx context.Context, entity Notification) error
-	// MarkFailed 标记通知为失败
+	// CASMarkSending 使用乐观锁将 PENDING 状态的通知标记为发送中，返回实际标记成功的通知，版本号为更新之后的版本号
+	CASMarkSending(ctx context.Context, entities []Notification) ([]Notification, error)
+	// MarkSuccess 使用乐观锁将发送中的通知标记为成功，同一个事务中写入站内信渠道生成的站内信 inbox
+	// 通知已经不是发送中或者版本号不一致时返回 errs.ErrNotificationVersionMismatch
+	MarkSuccess(ctx context.Context, entity Notification, inbox []InboxMessage) error
+	// MarkFailed 使用乐观锁将发送中的通知标记为失败，通知已经不是发送中或者版本号不一致时返回 errs.ErrNotificationVersionMismatch
 	MarkFailed(ctx context.Context, entity Notification) error
+	// MarkRetry 发送失败但还可以重试，通知回到待发送状态并记录重试次数和下次重试时间，没有回调记录时同时创建
+	// 通知已经不是发送中或者版本号不一致时返回 errs.ErrNotificationVersionMismatch
+	MarkRetry(ctx context.Context, entity Notification) error
+	// BatchMarkRetry 使用乐观锁批量标记发送中的通知等待重试，返回实际标记成功的通知
+	BatchMarkRetry(ctx context.Context, entities []Notification) ([]Notification, error)
 	// BatchGetByIDs 根据ID列表获取通知列表
 	BatchGetByIDs(ctx context.Context, ids []uint64) (map[uint64]Notification, error)
 	// GetByKey 根据业务ID和业务内唯一标识获取通知列表
 	GetByKey(ctx context.Context, bizID int64, key string) (Notification, error)
+	// BatchGetByKeys 根据业务ID和业务内唯一标识批量获取通知
+	BatchGetByKeys(ctx context.Context, bizID int64, keys []string) ([]Notification, error)
 	// CASStatus 更新通知状态
 	CASStatus(ctx context.Context, notification Notification) error
-	// BatchUpdateStatusSucceededOrFailed 批量更新通知状态为成功或失败，使用乐观锁控制并发
+	// BatchUpdateStatusSucceededOrFailed 批量更新发送中的通知状态为成功或失败，使用乐观锁控制并发
 	// successNotifications: 更新为成功状态的通知列表，包含ID、Version和重试次数
 	// failedNotifications: 更新为失败状态的通知列表，包含ID、Version和重试次数
-	BatchUpdateStatusSucceededOrFailed(ctx context.Context, successNotifications, failedNotifications []Notification) error
-	// FindReadyNotifications 准备好调度发送的通知
-	FindReadyNotifications(ctx context.Context, offset, limit int) ([]Notification, error)
+	// inbox: 发送成功的站内信通知生成的站内信，和通知状态在同一个事务中写入
+	// 返回实际更新成功的通知，已经不是发送中或者版本号不一致的通知会被跳过
+	BatchUpdateStatusSucceededOrFailed(ctx context.Context, successNotifications, failedNotifications []Notification, inbox []InboxMessage) (succeeded, failed []Notification, err error)
+	// FindReadyNotifications 准备好调度发送的指定优先级的通知，只查询 hash 值在 [slotStart, slotEnd) 范围内的通知
+	FindReadyNotifications(ctx context.Context, priority int32, slotStart, slotEnd int64, offset, limit int) ([]Notification, error)
+	// BackfillHashSlot 为 hash_slot 为 0 的通知回填 hash 值，每次处理 ID 大于 startID 的 limit 条，返回下一批的起始ID，处理完时返回 0
+	BackfillHashSlot(ctx context.Context, startID uint64, limit int) (uint64, error)
+	// FindTimeoutSending 查找更新时间早于 utime 仍处于 SENDING 状态的通知，utime 为毫秒，兼容以秒为单位写入的历史数据
+	FindTimeoutSending(ctx context.Context, utime int64, limit int) ([]Notification, error)
+	// CASRecoverSending 使用乐观锁将 SENDING 状态的通知修正为 PENDING 或者 FAILED，并记录原因
+	// 修正为 FAILED 时同时将回调记录标记为待回调
+	CASRecoverSending(ctx context.Context, notification Notification) error
+	// FindExpiredPending 查找计划发送结束时间早于 etime 仍处于 PENDING 状态的通知
+	FindExpiredPending(ctx context.Context, etime int64, limit int) ([]Notification, error)
+	// CASMarkExpired 使用乐观锁将 PENDING 状态的通知标记为已过期，同时将回调记录标记为待回调，返回实际标记成功的通知
+	CASMarkExpired(ctx context.Context, notifications []Notification) ([]Notification, error)
+	// CountUsedQuota 统计占用额度的时间在 [start, end) 内的通知数量，失败、取消和过期的通知已经归还额度，不计入
+	// 占用额度的时间为创建或者最近一次重新发送的时间，降级发送成功的通知计入实际送达的渠道
+	CountUsedQuota(ctx context.Context, bizID int64, channel string, start, end int64) (int64, error)
+	// CASCancel 使用乐观锁将 PENDING 状态的通知标记为已取消，同时将回调记录标记为待回调
+	CASCancel(ctx context.Context, data Notification) error
+	// CASUpdatePending 使用乐观锁修改 PENDING 状态的通知的计划发送时间和模版参数
+	CASUpdatePending(ctx context.Context, data Notification) error
+	// Resend 使用乐观锁将 FAILED 状态的通知重新置为待发送或者发送中，同时保存上一次的发送记录，并将回调记录重置为初始状态
+	Resend(ctx context.Context, failed, next Notification) error
+	// List 按照 ID 倒序查询符合过滤条件的通知，只返回 ID 小于 cursor 的通知，cursor 为 0 时从最新的通知开始
+	// 业务ID加上状态、模版ID、渠道或者接收者，再加上创建时间都有对应的索引，ID 作为索引的最后一列用于键集分页
+	// cursor 对应的通知不存在时返回 errs.ErrInvalidParameter
+	List(ctx context.Context, filter NotificationFilter, cursor uint64, limit int) ([]Notification, error)
+	// BackfillReceivers 为引入接收者表之前创建的通知回填接收者，每次处理 ID 大于 startID 的 limit 条，返回下一批的起始ID，处理完时返回 0
+	BackfillReceivers(ctx context.Context, startID uint64, limit int) (uint64, error)
 }
 
 // Create 创建单条通知记录，但不创建对应的回调记录
@@ -86,6 +188,7 @@ func (d *notificationDAO) CreateWithCallbackLog(ctx context.Context, data Notifi
 func (d *notificationDAO) create(ctx context.Context, db *gorm.DB, data Notification, createCallbackLog bool) (Notification, error) {
 	now := time.Now().UnixMilli()
 	data.Ctime, data.Utime = now, now
+	data.QuotaTime = now
 	data.Version = 1
 
 	err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
@@ -95,6 +198,12 @@ func (d *notificationDAO) create(ctx context.Context, db *gorm.DB, data Notifica
 			}
 			return err
 		}
+		if err := createReceivers(tx, data); err != nil {
+			return err
+		}
+		if err := createEvents(tx, newTransitionEvent(data, data.Status, createdEventReason, now)); err != nil {
+			return err
+		}
 		if createCallbackLog {
 			if err := tx.Create(&CallbackLog{
 				NotificationID: data.ID,
@@ -110,6 +219,41 @@ func (d *notificationDAO) create(ctx context.Context, db *gorm.DB, data Notifica
 	return data, err
 }
 
+// createReceivers 在同一个事务中写入通知的接收者，已经存在的接收者会被忽略
+func createReceivers(tx *gorm.DB, notifications ...Notification) error {
+	const batchSize = 500
+	receivers := newNotificationReceivers(notifications)
+	if len(receivers) == 0 {
+		return nil
+	}
+	return tx.Clauses(clause.OnConflict{DoNothing: true}).CreateInBatches(receivers, batchSize).Error
+}
+
+// newNotificationReceivers 展开通知中的接收者，同一条通知中重复的接收者只保留一个
+func newNotificationReceivers(notifications []Notification) []NotificationReceiver {
+	res := make([]NotificationReceiver, 0, len(notifications))
+	for i := range notifications {
+		var receivers []string
+		if err := json.Unmarshal([]byte(notifications[i].Receivers), &receivers); err != nil {
+			continue
+		}
+		seen := make(map[string]struct{}, len(receivers))
+		for _, receiver := range receivers {
+			if _, ok := seen[receiver]; ok {
+				continue
+			}
+			seen[receiver] = struct{}{}
+			res = append(res, NotificationReceiver{
+				NotificationID: notifications[i].ID,
+				BizID:          notifications[i].BizID,
+				Receiver:       receiver,
+				Ctime:          notifications[i].Ctime,
+			})
+		}
+	}
+	return res
+}
+
 // isUniqueConstraintError 检查是否是唯一索引冲突错误
 func (d *notificationDAO) isUniqueConstraintError(err error) bool {
 	if err == nil {
@@ -143,6 +287,7 @@ func (d *notificationDAO) batchCreate(ctx context.Context, datas []Notification,
 	now := time.Now().UnixMilli()
 	for i := range datas {
 		datas[i].Ctime, datas[i].Utime = now, now
+		datas[i].QuotaTime = now
 		datas[i].Version = 1
 	}
 
@@ -155,6 +300,16 @@ func (d *notificationDAO) batchCreate(ctx context.Context, datas []Notification,
 			}
 			return err
 		}
+		if err := createReceivers(tx, datas...); err != nil {
+			return err
+		}
+		events := make([]NotificationEvent, 0, len(datas))
+		for i := range datas {
+			events = append(events, newTransitionEvent(datas[i], datas[i].Status, createdEventReason, now))
+		}
+		if err := createEvents(tx, events...); err != nil {
+			return err
+		}
 
 		if createCallbackLog {
 			// 创建回调记录
@@ -177,23 +332,83 @@ func (d *notificationDAO) batchCreate(ctx context.Context, datas []Notification,
 	return datas, err
 }
 
-func (d *notificationDAO) MarkSuccess(ctx context.Context, notification Notification) error {
+// CASMarkSending 调度器发送之前先将通知标记为发送中，发送期间通知不能被取消或者修改，也不会被重复调度
+func (d *notificationDAO) CASMarkSending(ctx context.Context, notifications []Notification) ([]Notification, error) {
+	if len(notifications) == 0 {
+		return nil, nil
+	}
+	now := time.Now().UnixMilli()
+	claimed := make([]Notification, 0, len(notifications))
+	err := d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for i := range notifications {
+			// 查询之后通知可能被取消、修改或者被其他调度器发送
+			result := tx.Model(&Notification{}).
+				Where("id = ? AND version = ? AND status = ?", notifications[i].ID, notifications[i].Version, domain.SendStatusPending.String()).
+				Updates(map[string]any{
+					"status":  domain.SendStatusSending.String(),
+					"utime":   now,
+					"version": gorm.Expr("version + 1"),
+				})
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected > 0 {
+				n := notifications[i]
+				n.Status = domain.SendStatusSending.String()
+				n.Utime = now
+				n.Version++
+				claimed = append(claimed, n)
+			}
+		}
+		// 只有实际标记为发送中的通知才记录事件
+		events := make([]NotificationEvent, 0, len(claimed))
+		for i := range claimed {
+			events = append(events, newTransitionEvent(claimed[i], claimed[i].Status, "", now))
+		}
+		return createEvents(tx, events...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return claimed, nil
+}
+
+// updateSending 使用乐观锁更新发送中的通知，返回是否更新成功
+// 发送期间通知可能被 SENDING 状态修正任务修正，不能覆盖修正之后的状态，也不能重复归还额度和回调
+func (d *notificationDAO) updateSending(tx *gorm.DB, notification Notification, updates map[string]any) (bool, error) {
+	result := tx.Model(&Notification{}).
+		Where("id = ? AND version = ? AND status = ?", notification.ID, notification.Version, domain.SendStatusSending.String()).
+		Updates(updates)
+	return result.RowsAffected > 0, result.Error
+}
+
+func (d *notificationDAO) MarkSuccess(ctx context.Context, notification Notification, inbox []InboxMessage) error {
 	now := time.Now().UnixMilli()
 	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		err := tx.Model(&Notification{}).
-			Where("id = ?", notification.ID).
-			Updates(map[string]any{
-				"status":  notification.Status,
-				"utime":   now,
-				"version": gorm.Expr("version + 1"),
-			}).Error
+		// 站内信和通知状态一起提交，避免用户看到了站内信但通知没有成功
+		if err := createInboxMessages(tx, inbox); err != nil {
+			return err
+		}
+		ok, err := d.updateSending(tx, notification, map[string]any{
+			"status":            notification.Status,
+			"delivered_channel": notification.DeliveredChannel,
+			"utime":             now,
+			"version":           gorm.Expr("version + 1"),
+		})
 		if err != nil {
 			return err
 		}
+		if !ok {
+			// 回滚站内信
+			return fmt.Errorf("并发竞争失败 %w, id %d", errs.ErrNotificationVersionMismatch, notification.ID)
+		}
+		if err = createEvents(tx, newTransitionEvent(notification, notification.Status, "", now)); err != nil {
+			return err
+		}
 		// 要把 callback log 标记为可以发送了
 		return tx.Model(&CallbackLog{}).Where("notification_id = ?", notification.ID).Updates(map[string]any{
 			// 标记为可以发送回调了
-			"status": domain.CallbackLogStatusPending,
+			"status": domain.CallbackLogStatusPending.String(),
 			"utime":  now,
 		}).Error
 	})
@@ -201,13 +416,91 @@ func (d *notificationDAO) MarkSuccess(ctx context.Context, notification Notifica
 
 func (d *notificationDAO) MarkFailed(ctx context.Context, notification Notification) error {
 	now := time.Now().UnixMilli()
-	return d.db.WithContext(ctx).Model(&Notification{}).
-		Where("id = ?", notification.ID).
-		Updates(map[string]any{
-			"status":  notification.Status,
-			"utime":   now,
-			"version": gorm.Expr("version + 1"),
+	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		ok, err := d.updateSending(tx, notification, map[string]any{
+			"status":        notification.Status,
+			"status_reason": notification.StatusReason,
+			"utime":         now,
+			"version":       gorm.Expr("version + 1"),
+		})
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return fmt.Errorf("并发竞争失败 %w, id %d", errs.ErrNotificationVersionMismatch, notification.ID)
+		}
+		if err = createEvents(tx, newTransitionEvent(notification, notification.Status, notification.StatusReason, now)); err != nil {
+			return err
+		}
+		// 发送失败同样需要通知业务方
+		return tx.Model(&CallbackLog{}).Where("notification_id = ?", notification.ID).Updates(map[string]any{
+			"status": domain.CallbackLogStatusPending.String(),
+			"utime":  now,
 		}).Error
+	})
+}
+
+func (d *notificationDAO) MarkRetry(ctx context.Context, notification Notification) error {
+	retried, err := d.BatchMarkRetry(ctx, []Notification{notification})
+	if err != nil {
+		return err
+	}
+	if len(retried) == 0 {
+		return fmt.Errorf("并发竞争失败 %w, id %d", errs.ErrNotificationVersionMismatch, notification.ID)
+	}
+	return nil
+}
+
+func (d *notificationDAO) BatchMarkRetry(ctx context.Context, notifications []Notification) ([]Notification, error) {
+	if len(notifications) == 0 {
+		return nil, nil
+	}
+	now := time.Now().UnixMilli()
+	retried := make([]Notification, 0, len(notifications))
+	// 每条通知的重试次数和下次重试时间都不同，只能逐条更新
+	err := d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		callbackLogs := make([]CallbackLog, 0, len(notifications))
+		events := make([]NotificationEvent, 0, len(notifications))
+		for i := range notifications {
+			ok, err := d.updateSending(tx, notifications[i], map[string]any{
+				"status":          domain.SendStatusPending.String(),
+				"retry_count":     notifications[i].RetryCount,
+				"next_retry_time": notifications[i].NextRetryTime,
+				"scheduled_etime": notifications[i].ScheduledETime,
+				"utime":           now,
+				"version":         gorm.Expr("version + 1"),
+			})
+			if err != nil {
+				return err
+			}
+			if !ok {
+				continue
+			}
+			retried = append(retried, notifications[i])
+			// 重试时不保存失败原因，只记录在事件中
+			events = append(events, newTransitionEvent(notifications[i], domain.SendStatusPending.String(), notifications[i].StatusReason, now))
+			callbackLogs = append(callbackLogs, CallbackLog{
+				NotificationID: notifications[i].ID,
+				Status:         domain.CallbackLogStatusInit.String(),
+				NextRetryTime:  now,
+				Ctime:          now,
+				Utime:          now,
+			})
+		}
+		if err := createEvents(tx, events...); err != nil {
+			return err
+		}
+		if len(callbackLogs) == 0 {
+			return nil
+		}
+		// 同步立刻发送的通知没有回调记录，转为异步重试之后需要通过回调告知业务方最终结果
+		// 已经存在的回调记录保持不变
+		return tx.Clauses(clause.Insert{Modifier: "IGNORE"}).Create(&callbackLogs).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return retried, nil
 }
 
 func (d *notificationDAO) BatchGetByIDs(ctx context.Context, ids []uint64) (map[uint64]Notification, error) {
@@ -232,95 +525,489 @@ func (d *notificationDAO) GetByKey(ctx context.Context, bizID int64, key string)
 	return not, nil
 }
 
+func (d *notificationDAO) BatchGetByKeys(ctx context.Context, bizID int64, keys []string) ([]Notification, error) {
+	var notifications []Notification
+	if len(keys) == 0 {
+		return notifications, nil
+	}
+	err := d.db.WithContext(ctx).
+		Where("biz_id = ? AND `key` IN ?", bizID, keys).
+		Find(&notifications).Error
+	return notifications, err
+}
+
 // CASStatus 更新通知状态
 func (d *notificationDAO) CASStatus(ctx context.Context, notification Notification) error {
+	now := time.Now().UnixMilli()
 	updates := map[string]any{
 		"status":  notification.Status,
 		"version": gorm.Expr("version + 1"),
-		"utime":   time.Now().Unix(),
+		"utime":   now,
 	}
 
-	result := d.db.WithContext(ctx).Model(&Notification{}).
-		Where("id = ? AND version = ?", notification.ID, notification.Version).
-		Updates(updates)
+	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&Notification{}).
+			Where("id = ? AND version = ?", notification.ID, notification.Version).
+			Updates(updates)
 
-	if result.Error != nil {
-		return result.Error
-	}
+		if result.Error != nil {
+			return result.Error
+		}
 
-	if result.RowsAffected < 1 {
-		return fmt.Errorf("并发竞争失败 %w, id %d", errs.ErrNotificationVersionMismatch, notification.ID)
-	}
-	return nil
+		if result.RowsAffected < 1 {
+			return fmt.Errorf("并发竞争失败 %w, id %d", errs.ErrNotificationVersionMismatch, notification.ID)
+		}
+		return createEvents(tx, newTransitionEvent(notification, notification.Status, notification.StatusReason, now))
+	})
 }
 
-// BatchUpdateStatusSucceededOrFailed 批量更新通知状态为成功或失败，使用乐观锁控制并发
+// BatchUpdateStatusSucceededOrFailed 批量更新发送中的通知状态为成功或失败，使用乐观锁控制并发
 // successNotifications: 更新为成功状态的通知列表，包含ID、Version和重试次数
 // failedNotifications: 更新为失败状态的通知列表，包含ID、Version和重试次数
-func (d *notificationDAO) BatchUpdateStatusSucceededOrFailed(ctx context.Context, successNotifications, failedNotifications []Notification) error {
+// inbox: 发送成功的站内信通知生成的站内信
+// 只有实际更新成功的通知才会写入站内信并且标记回调记录
+func (d *notificationDAO) BatchUpdateStatusSucceededOrFailed(ctx context.Context, successNotifications, failedNotifications []Notification, inbox []InboxMessage) (succeeded, failed []Notification, err error) {
 	if len(successNotifications) == 0 && len(failedNotifications) == 0 {
-		return nil
+		return nil, nil, nil
 	}
 
-	successIDs := slice.Map(successNotifications, func(_ int, src Notification) uint64 {
-		return src.ID
-	})
+	now := time.Now().UnixMilli()
+	// 开启事务
+	err = d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		succeeded = make([]Notification, 0, len(successNotifications))
+		failed = make([]Notification, 0, len(failedNotifications))
+		for i := range successNotifications {
+			// 发生渠道降级时实际送达的渠道可能不同
+			ok, err1 := d.updateSending(tx, successNotifications[i], map[string]any{
+				"version":           gorm.Expr("version + 1"),
+				"utime":             now,
+				"status":            domain.SendStatusSucceeded.String(),
+				"delivered_channel": successNotifications[i].DeliveredChannel,
+			})
+			if err1 != nil {
+				return err1
+			}
+			if ok {
+				succeeded = append(succeeded, successNotifications[i])
+			}
+		}
+		if err1 := createInboxMessages(tx, succeededInbox(succeeded, inbox)); err1 != nil {
+			return err1
+		}
+
+		for i := range failedNotifications {
+			ok, err1 := d.updateSending(tx, failedNotifications[i], map[string]any{
+				"version":       gorm.Expr("version + 1"),
+				"utime":         now,
+				"status":        domain.SendStatusFailed.String(),
+				"status_reason": failedNotifications[i].StatusReason,
+			})
+			if err1 != nil {
+				return err1
+			}
+			if ok {
+				failed = append(failed, failedNotifications[i])
+			}
+		}
 
-	failedIDs := slice.Map(failedNotifications, func(_ int, src Notification) uint64 {
-		return src.ID
+		updatedIDs := make([]uint64, 0, len(succeeded)+len(failed))
+		events := make([]NotificationEvent, 0, len(succeeded)+len(failed))
+		for i := range succeeded {
+			updatedIDs = append(updatedIDs, succeeded[i].ID)
+			events = append(events, newTransitionEvent(succeeded[i], domain.SendStatusSucceeded.String(), "", now))
+		}
+		for i := range failed {
+			updatedIDs = append(updatedIDs, failed[i].ID)
+			events = append(events, newTransitionEvent(failed[i], domain.SendStatusFailed.String(), failed[i].StatusReason, now))
+		}
+		if len(updatedIDs) == 0 {
+			return nil
+		}
+		if err1 := createEvents(tx, events...); err1 != nil {
+			return err1
+		}
+		// 发送成功和失败都需要通知业务方
+		return tx.Model(&CallbackLog{}).
+			Where("notification_id IN ?", updatedIDs).
+			Updates(map[string]any{
+				"status": domain.CallbackLogStatusPending.String(),
+				"utime":  now,
+			}).Error
 	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return succeeded, failed, nil
+}
 
-	// 开启事务
-	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		if len(successIDs) != 0 {
-			err := d.batchMarkSuccess(tx, successIDs)
-			if err != nil {
-				return err
-			}
+// succeededInbox 过滤出实际标记为成功的通知生成的站内信
+func succeededInbox(succeeded []Notification, inbox []InboxMessage) []InboxMessage {
+	ids := make(map[uint64]struct{}, len(succeeded))
+	for i := range succeeded {
+		ids[succeeded[i].ID] = struct{}{}
+	}
+	res := make([]InboxMessage, 0, len(inbox))
+	for i := range inbox {
+		if _, ok := ids[inbox[i].NotificationID]; ok {
+			res = append(res, inbox[i])
 		}
+	}
+	return res
+}
 
-		if len(failedIDs) != 0 {
-			now := time.Now().Unix()
-			return tx.Model(&Notification{}).
-				Where("id IN ?", failedIDs).
-				Updates(map[string]any{
-					"version": gorm.Expr("version + 1"),
-					"utime":   now,
-					"status":  domain.SendStatusFailed.String(),
-				}).Error
+func (d *notificationDAO) FindReadyNotifications(ctx context.Context, priority int32, slotStart, slotEnd int64, offset, limit int) ([]Notification, error) {
+	var res []Notification
+	now := time.Now().UnixMilli()
+	err := d.db.WithContext(ctx).
+		Where("priority = ? AND status = ? AND hash_slot >= ? AND hash_slot < ?", priority, domain.SendStatusPending.String(), slotStart, slotEnd).
+		Where("scheduled_stime <=? AND scheduled_etime >= ? AND next_retry_time <= ?", now, now, now).
+		Limit(limit).Offset(offset).
+		Find(&res).Error
+	return res, err
+}
+
+func (d *notificationDAO) BackfillHashSlot(ctx context.Context, startID uint64, limit int) (uint64, error) {
+	var ns []Notification
+	err := d.db.WithContext(ctx).Select("id", "biz_id", "key").
+		Where("id > ? AND hash_slot = 0", startID).
+		Order("id ASC").
+		Limit(limit).
+		Find(&ns).Error
+	if err != nil || len(ns) == 0 {
+		return 0, err
+	}
+	// hash 值本来就是 0 的通知不需要更新，其余按照 hash 值分组更新
+	slotToIDs := make(map[int64][]uint64)
+	for i := range ns {
+		if slot := idgen.HashValue(ns[i].BizID, ns[i].Key); slot != 0 {
+			slotToIDs[slot] = append(slotToIDs[slot], ns[i].ID)
+		}
+	}
+	slots := make([]int64, 0, len(slotToIDs))
+	for slot := range slotToIDs {
+		slots = append(slots, slot)
+	}
+	slices.Sort(slots)
+	err = d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, slot := range slots {
+			err1 := tx.Model(&Notification{}).
+				Where("id IN ? AND hash_slot = 0", slotToIDs[slot]).
+				Update("hash_slot", slot).Error
+			if err1 != nil {
+				return err1
+			}
 		}
 		return nil
 	})
+	if err != nil || len(ns) < limit {
+		return 0, err
+	}
+	return ns[len(ns)-1].ID, nil
 }
 
-func (d *notificationDAO) batchMarkSuccess(tx *gorm.DB, successIDs []uint64) error {
-	now := time.Now().Unix()
-	err := tx.Model(&Notification{}).
-		Where("id IN ?", successIDs).
-		Updates(map[string]any{
-			"version": gorm.Expr("version + 1"),
-			"utime":   now,
-			"status":  domain.SendStatusSucceeded.String(),
-		}).Error
-	if err != nil {
-		return err
+// milliUtimeLowerBound 早期版本中 CASStatus 和批量更新状态以秒为单位写入 utime，
+// 以毫秒为单位的 utime 不会小于这个值，据此区分两种单位
+const milliUtimeLowerBound int64 = 1_000_000_000_000
+
+// UtimeMilli 将 utime 统一为毫秒，兼容早期以秒为单位写入的数据
+func UtimeMilli(utime int64) int64 {
+	const second = 1000
+	if utime > 0 && utime < milliUtimeLowerBound {
+		return utime * second
 	}
+	return utime
+}
 
-	// 要更新 callback log 了
-	return tx.Model(&CallbackLog{}).
-		Where("notification_id IN ? ", successIDs).
-		Updates(map[string]any{
+func (d *notificationDAO) FindTimeoutSending(ctx context.Context, utime int64, limit int) ([]Notification, error) {
+	var res []Notification
+	const second = 1000
+	// 分别按照两种单位比较，两个范围都能使用 idx_status_utime 索引
+	err := d.db.WithContext(ctx).
+		Where("status = ?", domain.SendStatusSending.String()).
+		Where("(utime >= ? AND utime <= ?) OR utime <= ?", milliUtimeLowerBound, utime, utime/second).
+		Order("utime ASC").
+		Limit(limit).
+		Find(&res).Error
+	return res, err
+}
+
+func (d *notificationDAO) CASRecoverSending(ctx context.Context, notification Notification) error {
+	now := time.Now().UnixMilli()
+	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&Notification{}).
+			Where("id = ? AND version = ? AND status = ?", notification.ID, notification.Version, domain.SendStatusSending.String()).
+			Updates(map[string]any{
+				"status":          notification.Status,
+				"status_reason":   notification.StatusReason,
+				"next_retry_time": notification.NextRetryTime,
+				"utime":           now,
+				"version":         gorm.Expr("version + 1"),
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected < 1 {
+			return fmt.Errorf("并发竞争失败 %w, id %d", errs.ErrNotificationVersionMismatch, notification.ID)
+		}
+		if err := createEvents(tx, newTransitionEvent(notification, notification.Status, notification.StatusReason, now)); err != nil {
+			return err
+		}
+		if notification.Status != domain.SendStatusFailed.String() {
+			return nil
+		}
+		// 发送失败同样需要通知业务方
+		return tx.Model(&CallbackLog{}).Where("notification_id = ?", notification.ID).Updates(map[string]any{
 			"status": domain.CallbackLogStatusPending.String(),
 			"utime":  now,
 		}).Error
+	})
 }
 
-func (d *notificationDAO) FindReadyNotifications(ctx context.Context, offset, limit int) ([]Notification, error) {
+func (d *notificationDAO) FindExpiredPending(ctx context.Context, etime int64, limit int) ([]Notification, error) {
 	var res []Notification
-	now := time.Now().UnixMilli()
 	err := d.db.WithContext(ctx).
-		Where("scheduled_stime <=? AND scheduled_etime >= ? AND status=?", now, now, domain.SendStatusPending.String()).
-		Limit(limit).Offset(offset).
+		Where("status = ? AND scheduled_etime < ?", domain.SendStatusPending.String(), etime).
+		Limit(limit).
 		Find(&res).Error
 	return res, err
 }
+
+func (d *notificationDAO) CASMarkExpired(ctx context.Context, notifications []Notification) ([]Notification, error) {
+	if len(notifications) == 0 {
+		return nil, nil
+	}
+	now := time.Now().UnixMilli()
+	expired := make([]Notification, 0, len(notifications))
+	err := d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for i := range notifications {
+			// 调度器可能正在发送，使用乐观锁避免覆盖发送结果
+			result := tx.Model(&Notification{}).
+				Where("id = ? AND version = ? AND status = ?", notifications[i].ID, notifications[i].Version, domain.SendStatusPending.String()).
+				Updates(map[string]any{
+					"status":        domain.SendStatusExpired.String(),
+					"status_reason": notifications[i].StatusReason,
+					"utime":         now,
+					"version":       gorm.Expr("version + 1"),
+				})
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected > 0 {
+				expired = append(expired, notifications[i])
+			}
+		}
+		if len(expired) == 0 {
+			return nil
+		}
+		events := make([]NotificationEvent, 0, len(expired))
+		for i := range expired {
+			events = append(events, newTransitionEvent(expired[i], domain.SendStatusExpired.String(), expired[i].StatusReason, now))
+		}
+		if err := createEvents(tx, events...); err != nil {
+			return err
+		}
+		// 过期同样需要通知业务方
+		return tx.Model(&CallbackLog{}).
+			Where("notification_id IN ?", slice.Map(expired, func(_ int, src Notification) uint64 {
+				return src.ID
+			})).
+			Updates(map[string]any{
+				"status": domain.CallbackLogStatusPending.String(),
+				"utime":  now,
+			}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return expired, nil
+}
+
+func (d *notificationDAO) CountUsedQuota(ctx context.Context, bizID int64, channel string, start, end int64) (int64, error) {
+	var cnt int64
+	// 降级发送成功的通知占用的是实际送达渠道的额度，所以不能使用 channel 上的索引，只能按照时间范围扫描
+	// 早期数据没有占用额度的时间，按照创建时间统计，两个范围分别使用 idx_biz_id_quota_time 和 idx_biz_id_ctime 索引
+	err := d.db.WithContext(ctx).Model(&Notification{}).
+		Where("biz_id = ?", bizID).
+		Where("(quota_time >= ? AND quota_time < ?) OR (quota_time = 0 AND ctime >= ? AND ctime < ?)", start, end, start, end).
+		Where("IF(delivered_channel = '', channel, delivered_channel) = ?", channel).
+		Where("status NOT IN ?", []string{
+			domain.SendStatusFailed.String(),
+			domain.SendStatusCanceled.String(),
+			domain.SendStatusExpired.String(),
+		}).
+		Count(&cnt).Error
+	return cnt, err
+}
+
+func (d *notificationDAO) CASCancel(ctx context.Context, data Notification) error {
+	now := time.Now().UnixMilli()
+	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 调度器可能正在发送，使用乐观锁避免覆盖发送结果
+		result := tx.Model(&Notification{}).
+			Where("id = ? AND version = ? AND status = ?", data.ID, data.Version, domain.SendStatusPending.String()).
+			Updates(map[string]any{
+				"status":        domain.SendStatusCanceled.String(),
+				"status_reason": data.StatusReason,
+				"utime":         now,
+				"version":       gorm.Expr("version + 1"),
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("%w", errs.ErrNotificationVersionMismatch)
+		}
+		if err := createEvents(tx, newTransitionEvent(data, domain.SendStatusCanceled.String(), data.StatusReason, now)); err != nil {
+			return err
+		}
+		return tx.Model(&CallbackLog{}).
+			Where("notification_id = ?", data.ID).
+			Updates(map[string]any{
+				"status": domain.CallbackLogStatusPending.String(),
+				"utime":  now,
+			}).Error
+	})
+}
+
+func (d *notificationDAO) CASUpdatePending(ctx context.Context, data Notification) error {
+	now := time.Now().UnixMilli()
+	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&Notification{}).
+			Where("id = ? AND version = ? AND status = ?", data.ID, data.Version, domain.SendStatusPending.String()).
+			Updates(map[string]any{
+				"scheduled_stime": data.ScheduledSTime,
+				"scheduled_etime": data.ScheduledETime,
+				"template_params": data.TemplateParams,
+				// 只修改模版参数时保留重试时间，修改计划发送时间时由调用方清零
+				"next_retry_time": data.NextRetryTime,
+				"version":         gorm.Expr("version + 1"),
+				"utime":           now,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected < 1 {
+			return fmt.Errorf("并发竞争失败 %w, id %d", errs.ErrNotificationVersionMismatch, data.ID)
+		}
+		// 状态没有变化，但是修改之后会重新调度，同样记录下来便于排查
+		return createEvents(tx, newTransitionEvent(data, domain.SendStatusPending.String(), updatedEventReason, now))
+	})
+}
+
+func (d *notificationDAO) Resend(ctx context.Context, failed, next Notification) error {
+	now := time.Now().UnixMilli()
+	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&Notification{}).
+			Where("id = ? AND version = ? AND status = ?", failed.ID, failed.Version, domain.SendStatusFailed.String()).
+			Updates(map[string]any{
+				"status":            next.Status,
+				"status_reason":     "",
+				"delivered_channel": "",
+				"retry_count":       0,
+				"next_retry_time":   0,
+				"scheduled_stime":   next.ScheduledSTime,
+				"scheduled_etime":   next.ScheduledETime,
+				// 重新发送时重新扣减了额度，计入本周期
+				"quota_time": now,
+				"version":    gorm.Expr("version + 1"),
+				"utime":      now,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected < 1 {
+			return fmt.Errorf("并发竞争失败 %w, id %d", errs.ErrNotificationVersionMismatch, failed.ID)
+		}
+		if err := createEvents(tx, newTransitionEvent(failed, next.Status, resentEventReason, now)); err != nil {
+			return err
+		}
+
+		var cnt int64
+		err := tx.Model(&NotificationAttempt{}).
+			Where("notification_id = ?", failed.ID).
+			Count(&cnt).Error
+		if err != nil {
+			return err
+		}
+		err = tx.Create(&NotificationAttempt{
+			NotificationID:   failed.ID,
+			Attempt:          int32(cnt) + 1,
+			BizID:            failed.BizID,
+			Status:           failed.Status,
+			StatusReason:     failed.StatusReason,
+			RetryCount:       failed.RetryCount,
+			DeliveredChannel: failed.DeliveredChannel,
+			ScheduledSTime:   failed.ScheduledSTime,
+			ScheduledETime:   failed.ScheduledETime,
+			FinishedTime:     UtimeMilli(failed.Utime),
+			Ctime:            now,
+		}).Error
+		if err != nil {
+			return err
+		}
+
+		// 上一次的结果已经回调过了，等待本次发送的结果
+		return tx.Model(&CallbackLog{}).
+			Where("notification_id = ?", failed.ID).
+			Updates(map[string]any{
+				"status":          domain.CallbackLogStatusInit.String(),
+				"retry_count":     0,
+				"next_retry_time": now,
+				"utime":           now,
+			}).Error
+	})
+}
+
+func (d *notificationDAO) List(ctx context.Context, filter NotificationFilter, cursor uint64, limit int) ([]Notification, error) {
+	// 按照 ID 倒序翻页，每个过滤条件都有 (biz_id, 条件, id) 索引，不需要额外排序
+	// 雪花算法ID按照生成时间递增，所以 ID 倒序基本上就是创建时间倒序，时间范围只用来过滤
+	ctimeColumn, idColumn := "notifications.ctime", "notifications.id"
+	query := d.db.WithContext(ctx).Model(&Notification{}).Where("notifications.biz_id = ?", filter.BizID)
+	if filter.Receiver != "" {
+		// 按照接收者查询时使用接收者表的 idx_biz_id_receiver_notification_id 索引，再根据通知ID回表
+		query = query.Joins("JOIN notification_receivers ON notification_receivers.notification_id = notifications.id").
+			Where("notification_receivers.biz_id = ? AND notification_receivers.receiver = ?", filter.BizID, filter.Receiver)
+		ctimeColumn, idColumn = "notification_receivers.ctime", "notification_receivers.notification_id"
+	}
+	if filter.Status != "" {
+		query = query.Where("notifications.status = ?", filter.Status)
+	}
+	if filter.Channel != "" {
+		query = query.Where("notifications.channel = ?", filter.Channel)
+	}
+	if filter.TemplateID > 0 {
+		query = query.Where("notifications.template_id = ?", filter.TemplateID)
+	}
+	if filter.StartTime > 0 {
+		query = query.Where(ctimeColumn+" >= ?", filter.StartTime)
+	}
+	if filter.EndTime > 0 {
+		query = query.Where(ctimeColumn+" < ?", filter.EndTime)
+	}
+	if cursor > 0 {
+		query = query.Where(idColumn+" < ?", cursor)
+	}
+	var notifications []Notification
+	err := query.Order(idColumn + " DESC").Limit(limit).Find(&notifications).Error
+	return notifications, err
+}
+
+func (d *notificationDAO) BackfillReceivers(ctx context.Context, startID uint64, limit int) (uint64, error) {
+	var ns []Notification
+	err := d.db.WithContext(ctx).Select("id", "biz_id", "receivers", "ctime").
+		Where("id > ?", startID).
+		Order("id ASC").
+		Limit(limit).
+		Find(&ns).Error
+	if err != nil || len(ns) == 0 {
+		return 0, err
+	}
+	// 已经写入的接收者会被忽略，重复执行没有影响
+	err = d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return createReceivers(tx, ns...)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return ns[len(ns)-1].ID, nil
+}
@@ -0,0 +1,136 @@
+package dao
+
+import (
+	"context"
+
+	"github.com/ego-component/egorm"
+	"github.com/robinlg/notification-platform/internal/domain"
+	"gorm.io/gorm"
+)
+
+// NotificationEvent 通知生命周期事件表，状态变更和供应商调用各记录一条
+type NotificationEvent struct {
+	ID              int64  `gorm:"primaryKey;autoIncrement;comment:'事件ID'"`
+	NotificationID  uint64 `gorm:"type:BIGINT UNSIGNED;NOT NULL;index:idx_notification_id,priority:1;comment:'通知ID'"`
+	BizID           int64  `gorm:"type:BIGINT;NOT NULL;comment:'业务配表ID'"`
+	Type            string `gorm:"type:ENUM('TRANSITION','PROVIDER_ATTEMPT');NOT NULL;comment:'事件类型，状态变更或者调用供应商'"`
+	Status          string `gorm:"type:VARCHAR(16);NOT NULL;DEFAULT:'';comment:'变更之后的状态，调用供应商时为该次调用的结果'"`
+	Reason          string `gorm:"type:VARCHAR(256);NOT NULL;DEFAULT:'';comment:'状态变更或者调用供应商失败的原因'"`
+	Channel         string `gorm:"type:VARCHAR(16);NOT NULL;DEFAULT:'';comment:'状态变更时为通知的原始渠道，调用供应商时为实际使用的渠道'"`
+	Provider        string `gorm:"type:VARCHAR(64);NOT NULL;DEFAULT:'';comment:'供应商名称'"`
+	VendorRequestID string `gorm:"type:VARCHAR(128);NOT NULL;DEFAULT:'';comment:'供应商返回的请求ID'"`
+	VendorCode      string `gorm:"type:VARCHAR(64);NOT NULL;DEFAULT:'';comment:'供应商返回的状态码'"`
+	VendorMessage   string `gorm:"type:VARCHAR(256);NOT NULL;DEFAULT:'';comment:'供应商返回的描述信息'"`
+	Latency         int64  `gorm:"type:BIGINT;NOT NULL;DEFAULT:0;comment:'调用供应商的耗时，毫秒'"`
+	// Published 状态变更事件由投递任务按照写入顺序投递到状态变更事件流，调用供应商的事件不需要投递
+	Published bool `gorm:"type:BOOLEAN;NOT NULL;DEFAULT:false;index:idx_published_id,priority:1;comment:'是否已经投递到状态变更事件流'"`
+	Ctime     int64
+}
+
+// TableName 重命名表
+func (NotificationEvent) TableName() string {
+	return "notification_events"
+}
+
+// 状态变更事件的原因，发送失败、修正状态和取消的原因使用通知上记录的原因
+const (
+	createdEventReason            = "创建通知"
+	updatedEventReason            = "业务方修改计划发送时间或者模版参数"
+	resentEventReason             = "重新发送"
+	txPreparedEventReason         = "事务准备"
+	txCommittedEventReason        = "事务提交"
+	txCanceledEventReason         = "事务取消"
+	checkBackCommittedEventReason = "事务回查结果为提交"
+	checkBackFailedEventReason    = "事务回查结果为取消或者无法回查"
+)
+
+// newTransitionEvent 创建通知状态变更事件，渠道使用通知的原始渠道
+func newTransitionEvent(n Notification, status, reason string, now int64) NotificationEvent {
+	return NotificationEvent{
+		NotificationID: n.ID,
+		BizID:          n.BizID,
+		Type:           domain.NotificationEventTypeTransition.String(),
+		Status:         status,
+		Reason:         reason,
+		Channel:        n.Channel,
+		Ctime:          now,
+	}
+}
+
+// createEvents 在写入通知状态的事务中记录状态变更事件，只有状态实际写入时事件才会提交
+func createEvents(tx *gorm.DB, events ...NotificationEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+	return tx.Create(&events).Error
+}
+
+// UnpublishedEvent 还没有投递到状态变更事件流的事件，带上通知的业务唯一标识
+type UnpublishedEvent struct {
+	ID             int64
+	NotificationID uint64
+	BizID          int64
+	Key            string
+	Status         string
+	Ctime          int64
+}
+
+type NotificationEventDAO interface {
+	// BatchCreate 批量创建通知事件
+	BatchCreate(ctx context.Context, events []NotificationEvent) error
+	// FindByNotificationID 按照发生顺序查询通知的事件，最多 limit 条
+	FindByNotificationID(ctx context.Context, notificationID uint64, limit int) ([]NotificationEvent, error)
+	// FindUnpublished 按照写入顺序查询还没有投递到事件流的事件，最多 limit 条
+	FindUnpublished(ctx context.Context, limit int) ([]UnpublishedEvent, error)
+	// MarkPublished 将事件标记为已经投递到事件流
+	MarkPublished(ctx context.Context, ids []int64) error
+}
+
+type notificationEventDAO struct {
+	db *egorm.Component
+}
+
+// NewNotificationEventDAO 创建通知事件DAO实例
+func NewNotificationEventDAO(db *egorm.Component) NotificationEventDAO {
+	return &notificationEventDAO{db: db}
+}
+
+func (d *notificationEventDAO) BatchCreate(ctx context.Context, events []NotificationEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+	return d.db.WithContext(ctx).Create(&events).Error
+}
+
+func (d *notificationEventDAO) FindByNotificationID(ctx context.Context, notificationID uint64, limit int) ([]NotificationEvent, error) {
+	var events []NotificationEvent
+	err := d.db.WithContext(ctx).
+		Where("notification_id = ?", notificationID).
+		Order("id").
+		Limit(limit).
+		Find(&events).Error
+	return events, err
+}
+
+func (d *notificationEventDAO) FindUnpublished(ctx context.Context, limit int) ([]UnpublishedEvent, error) {
+	var events []UnpublishedEvent
+	err := d.db.WithContext(ctx).
+		Table("`notification_events` AS e").
+		Select("e.`id`, e.`notification_id`, e.`biz_id`, n.`key`, e.`status`, e.`ctime`").
+		Joins("JOIN `notifications` AS n ON n.`id` = e.`notification_id`").
+		Where("e.`published` = ?", false).
+		Order("e.`id`").
+		Limit(limit).
+		Scan(&events).Error
+	return events, err
+}
+
+func (d *notificationEventDAO) MarkPublished(ctx context.Context, ids []int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return d.db.WithContext(ctx).
+		Model(&NotificationEvent{}).
+		Where("id IN ?", ids).
+		Update("published", true).Error
+}
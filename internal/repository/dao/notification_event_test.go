@@ -0,0 +1,46 @@
+//go:build unit
+
+package dao
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotificationEventDAO_FindUnpublished(t *testing.T) {
+	t.Parallel()
+
+	db, mock := newMockDB(t)
+	// 事件表中没有通知的业务唯一标识，需要关联通知表
+	mock.ExpectQuery("SELECT e.`id`, e.`notification_id`, e.`biz_id`, n.`key`, e.`status`, e.`ctime` FROM `notification_events` AS e JOIN `notifications` AS n ON n.`id` = e.`notification_id` WHERE e.`published` = ? ORDER BY e.`id` LIMIT ?").
+		WithArgs(false, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "notification_id", "biz_id", "key", "status", "ctime"}).
+			AddRow(1, 10, 1, "key-1", "PENDING", 1700000000000).
+			AddRow(2, 10, 1, "key-1", "SUCCEEDED", 1700000000001))
+
+	events, err := NewNotificationEventDAO(db).FindUnpublished(context.Background(), 2)
+	require.NoError(t, err)
+	assert.Equal(t, []UnpublishedEvent{
+		{ID: 1, NotificationID: 10, BizID: 1, Key: "key-1", Status: "PENDING", Ctime: 1700000000000},
+		{ID: 2, NotificationID: 10, BizID: 1, Key: "key-1", Status: "SUCCEEDED", Ctime: 1700000000001},
+	}, events)
+}
+
+func TestNotificationEventDAO_MarkPublished(t *testing.T) {
+	t.Parallel()
+
+	db, mock := newMockDB(t)
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE `notification_events` SET `published`=? WHERE id IN (?,?)").
+		WithArgs(true, 1, 2).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+	require.NoError(t, NewNotificationEventDAO(db).MarkPublished(context.Background(), []int64{1, 2}))
+
+	// 没有事件时不访问数据库
+	require.NoError(t, NewNotificationEventDAO(db).MarkPublished(context.Background(), nil))
+}
@@ -0,0 +1,532 @@
+//go:build unit
+
+package dao
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/robinlg/notification-platform/internal/errs"
+	idgen "github.com/robinlg/notification-platform/internal/pkg/id_generator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotificationDAO_MarkFailed(t *testing.T) {
+	t.Parallel()
+
+	db, mock := newMockDB(t)
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE `notifications` SET `status`=?,`status_reason`=?,`utime`=?,`version`=version + 1 WHERE id = ? AND version = ? AND status = ?").
+		WithArgs("FAILED", "mock reason", sqlmock.AnyArg(), 1, 2, "SENDING").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	expectTransitionEvents(mock, NotificationEvent{NotificationID: 1, BizID: 3, Status: "FAILED", Reason: "mock reason", Channel: "SMS"})
+	// 回调记录必须标记为待回调，回调任务才能扫描到
+	mock.ExpectExec("UPDATE `callback_logs` SET `status`=?,`utime`=? WHERE notification_id = ?").
+		WithArgs("PENDING", sqlmock.AnyArg(), 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	failed := Notification{ID: 1, BizID: 3, Channel: "SMS", Status: "FAILED", StatusReason: "mock reason", Version: 2}
+	err := NewNotificationDAO(db).MarkFailed(context.Background(), failed)
+	assert.NoError(t, err)
+
+	// 发送期间通知已经被修正，不能覆盖修正之后的状态，也不能再次回调和记录事件
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE `notifications` SET `status`=?,`status_reason`=?,`utime`=?,`version`=version + 1 WHERE id = ? AND version = ? AND status = ?").
+		WithArgs("FAILED", "mock reason", sqlmock.AnyArg(), 1, 2, "SENDING").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+	err = NewNotificationDAO(db).MarkFailed(context.Background(), failed)
+	assert.ErrorIs(t, err, errs.ErrNotificationVersionMismatch)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestNotificationDAO_MarkSuccess(t *testing.T) {
+	t.Parallel()
+
+	inbox := []InboxMessage{{NotificationID: 1, BizID: 2, Receiver: "user1", Title: "标题", Content: "内容"}}
+
+	testCases := []struct {
+		name    string
+		mock    func(mock sqlmock.Sqlmock)
+		wantErr error
+	}{
+		{
+			name: "站内信和通知状态在同一个事务中提交",
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec("INSERT IGNORE INTO `inbox_messages` (`notification_id`,`biz_id`,`receiver`,`title`,`content`,`status`,`read_time`,`ctime`,`utime`) VALUES (?,?,?,?,?,?,?,?,?)").
+					WithArgs(1, 2, "user1", "标题", "内容", "UNREAD", 0, sqlmock.AnyArg(), sqlmock.AnyArg()).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec("UPDATE `notifications` SET `delivered_channel`=?,`status`=?,`utime`=?,`version`=version + 1 WHERE id = ? AND version = ? AND status = ?").
+					WithArgs("IN_APP", "SUCCEEDED", sqlmock.AnyArg(), 1, 2, "SENDING").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				expectTransitionEvents(mock, NotificationEvent{NotificationID: 1, BizID: 2, Status: "SUCCEEDED", Channel: "IN_APP"})
+				mock.ExpectExec("UPDATE `callback_logs` SET `status`=?,`utime`=? WHERE notification_id = ?").
+					WithArgs("PENDING", sqlmock.AnyArg(), 1).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "写入站内信失败时不更新通知状态",
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec("INSERT IGNORE INTO `inbox_messages` (`notification_id`,`biz_id`,`receiver`,`title`,`content`,`status`,`read_time`,`ctime`,`utime`) VALUES (?,?,?,?,?,?,?,?,?)").
+					WillReturnError(assert.AnError)
+				mock.ExpectRollback()
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "通知已经不是发送中时回滚站内信",
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec("INSERT IGNORE INTO `inbox_messages` (`notification_id`,`biz_id`,`receiver`,`title`,`content`,`status`,`read_time`,`ctime`,`utime`) VALUES (?,?,?,?,?,?,?,?,?)").
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec("UPDATE `notifications` SET `delivered_channel`=?,`status`=?,`utime`=?,`version`=version + 1 WHERE id = ? AND version = ? AND status = ?").
+					WithArgs("IN_APP", "SUCCEEDED", sqlmock.AnyArg(), 1, 2, "SENDING").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectRollback()
+			},
+			wantErr: errs.ErrNotificationVersionMismatch,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			db, mock := newMockDB(t)
+			tc.mock(mock)
+
+			err := NewNotificationDAO(db).MarkSuccess(context.Background(),
+				Notification{ID: 1, BizID: 2, Channel: "IN_APP", Status: "SUCCEEDED", DeliveredChannel: "IN_APP", Version: 2},
+				append([]InboxMessage(nil), inbox...))
+			assert.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestNotificationDAO_CountUsedQuota(t *testing.T) {
+	t.Parallel()
+
+	db, mock := newMockDB(t)
+	// 降级发送成功的通知按照实际送达的渠道统计
+	// 按照占用额度的时间统计，重新发送的通知计入重新发送所在的周期，早期数据按照创建时间统计
+	mock.ExpectQuery("SELECT count(*) FROM `notifications` WHERE biz_id = ? AND ((quota_time >= ? AND quota_time < ?) OR (quota_time = 0 AND ctime >= ? AND ctime < ?)) AND IF(delivered_channel = '', channel, delivered_channel) = ? AND status NOT IN (?,?,?)").
+		WithArgs(1, 100, 200, 100, 200, "EMAIL", "FAILED", "CANCELED", "EXPIRED").
+		WillReturnRows(sqlmock.NewRows([]string{"count(*)"}).AddRow(3))
+
+	cnt, err := NewNotificationDAO(db).CountUsedQuota(context.Background(), 1, "EMAIL", 100, 200)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), cnt)
+}
+
+func TestNotificationDAO_BatchMarkRetry(t *testing.T) {
+	t.Parallel()
+
+	db, mock := newMockDB(t)
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE `notifications` SET `next_retry_time`=?,`retry_count`=?,`scheduled_etime`=?,`status`=?,`utime`=?,`version`=version + 1 WHERE id = ? AND version = ? AND status = ?").
+		WithArgs(1000, 1, 2000, "PENDING", sqlmock.AnyArg(), 1, 2, "SENDING").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	// 发送期间已经被修正的通知不会回到待发送状态
+	mock.ExpectExec("UPDATE `notifications` SET `next_retry_time`=?,`retry_count`=?,`scheduled_etime`=?,`status`=?,`utime`=?,`version`=version + 1 WHERE id = ? AND version = ? AND status = ?").
+		WithArgs(1000, 1, 2000, "PENDING", sqlmock.AnyArg(), 2, 2, "SENDING").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	// 重试时失败原因只记录在事件中
+	expectTransitionEvents(mock, NotificationEvent{NotificationID: 1, BizID: 3, Status: "PENDING", Reason: "供应商超时", Channel: "SMS"})
+	// 同步立刻发送的通知没有回调记录，重试之后需要补上
+	mock.ExpectExec("INSERT IGNORE INTO `callback_logs` (`notification_id`,`retry_count`,`next_retry_time`,`status`,`ctime`,`utime`) VALUES (?,?,?,?,?,?)").
+		WithArgs(1, 0, sqlmock.AnyArg(), "INIT", sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	retried, err := NewNotificationDAO(db).BatchMarkRetry(context.Background(), []Notification{
+		{ID: 1, BizID: 3, Channel: "SMS", RetryCount: 1, NextRetryTime: 1000, ScheduledETime: 2000, Version: 2, StatusReason: "供应商超时"},
+		{ID: 2, BizID: 3, Channel: "SMS", RetryCount: 1, NextRetryTime: 1000, ScheduledETime: 2000, Version: 2, StatusReason: "供应商超时"},
+	})
+	assert.NoError(t, err)
+	require.Len(t, retried, 1)
+	assert.Equal(t, uint64(1), retried[0].ID)
+}
+
+func TestNotificationDAO_FindTimeoutSending(t *testing.T) {
+	t.Parallel()
+
+	const utime int64 = 1_700_000_000_000
+	db, mock := newMockDB(t)
+	// 以秒为单位写入的历史数据按照秒比较
+	mock.ExpectQuery("SELECT * FROM `notifications` WHERE status = ? AND ((utime >= ? AND utime <= ?) OR utime <= ?) ORDER BY utime ASC LIMIT ?").
+		WithArgs("SENDING", 1_000_000_000_000, utime, utime/1000, 10).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "utime"}).
+			AddRow(1, 1_600_000_000).
+			AddRow(2, 1_600_000_000_000))
+
+	res, err := NewNotificationDAO(db).FindTimeoutSending(context.Background(), utime, 10)
+	assert.NoError(t, err)
+	assert.Len(t, res, 2)
+}
+
+func TestUtimeMilli(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name  string
+		utime int64
+		want  int64
+	}{
+		{name: "秒", utime: 1_600_000_000, want: 1_600_000_000_000},
+		{name: "毫秒", utime: 1_600_000_000_000, want: 1_600_000_000_000},
+		{name: "零值", utime: 0, want: 0},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.want, UtimeMilli(tc.utime))
+		})
+	}
+}
+
+func TestNotificationDAO_BackfillHashSlot(t *testing.T) {
+	t.Parallel()
+
+	slot := idgen.HashValue(1, "key-1")
+	require.NotZero(t, slot)
+	db, mock := newMockDB(t)
+	mock.ExpectQuery("SELECT `id`,`biz_id`,`key` FROM `notifications` WHERE id > ? AND hash_slot = 0 ORDER BY id ASC LIMIT ?").
+		WithArgs(10, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "biz_id", "key"}).
+			AddRow(11, 1, "key-1").
+			AddRow(12, 1, "key-1"))
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE `notifications` SET `hash_slot`=? WHERE id IN (?,?) AND hash_slot = 0").
+		WithArgs(slot, 11, 12).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+	// 本批是满的，需要继续回填下一批
+	next, err := NewNotificationDAO(db).BackfillHashSlot(context.Background(), 10, 2)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(12), next)
+
+	mock.ExpectQuery("SELECT `id`,`biz_id`,`key` FROM `notifications` WHERE id > ? AND hash_slot = 0 ORDER BY id ASC LIMIT ?").
+		WithArgs(12, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "biz_id", "key"}))
+	next, err = NewNotificationDAO(db).BackfillHashSlot(context.Background(), 12, 2)
+	require.NoError(t, err)
+	assert.Zero(t, next)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestNotificationDAO_CASMarkSending(t *testing.T) {
+	t.Parallel()
+
+	db, mock := newMockDB(t)
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE `notifications` SET `status`=?,`utime`=?,`version`=version + 1 WHERE id = ? AND version = ? AND status = ?").
+		WithArgs("SENDING", sqlmock.AnyArg(), 1, 1, "PENDING").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	// 查询之后被取消的通知不会被标记
+	mock.ExpectExec("UPDATE `notifications` SET `status`=?,`utime`=?,`version`=version + 1 WHERE id = ? AND version = ? AND status = ?").
+		WithArgs("SENDING", sqlmock.AnyArg(), 2, 1, "PENDING").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	// 只有实际标记为发送中的通知才记录事件
+	expectTransitionEvents(mock, NotificationEvent{NotificationID: 1, BizID: 3, Status: "SENDING", Channel: "EMAIL"})
+	mock.ExpectCommit()
+
+	claimed, err := NewNotificationDAO(db).CASMarkSending(context.Background(), []Notification{
+		{ID: 1, BizID: 3, Channel: "EMAIL", Version: 1, Status: "PENDING"},
+		{ID: 2, BizID: 3, Channel: "EMAIL", Version: 1, Status: "PENDING"},
+	})
+	require.NoError(t, err)
+	require.Len(t, claimed, 1)
+	assert.Equal(t, uint64(1), claimed[0].ID)
+	assert.Equal(t, "SENDING", claimed[0].Status)
+	assert.Equal(t, 2, claimed[0].Version)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestNotificationDAO_BatchUpdateStatusSucceededOrFailed(t *testing.T) {
+	t.Parallel()
+
+	db, mock := newMockDB(t)
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE `notifications` SET `delivered_channel`=?,`status`=?,`utime`=?,`version`=version + 1 WHERE id = ? AND version = ? AND status = ?").
+		WithArgs("IN_APP", "SUCCEEDED", sqlmock.AnyArg(), 1, 2, "SENDING").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	// 发送期间已经被修正的通知不会写入站内信
+	mock.ExpectExec("UPDATE `notifications` SET `delivered_channel`=?,`status`=?,`utime`=?,`version`=version + 1 WHERE id = ? AND version = ? AND status = ?").
+		WithArgs("IN_APP", "SUCCEEDED", sqlmock.AnyArg(), 2, 2, "SENDING").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("INSERT IGNORE INTO `inbox_messages` (`notification_id`,`biz_id`,`receiver`,`title`,`content`,`status`,`read_time`,`ctime`,`utime`) VALUES (?,?,?,?,?,?,?,?,?)").
+		WithArgs(1, 1, "user1", "", "", "UNREAD", 0, sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	// 发送期间已经被修正的通知不会再次归还额度和回调
+	mock.ExpectExec("UPDATE `notifications` SET `status`=?,`status_reason`=?,`utime`=?,`version`=version + 1 WHERE id = ? AND version = ? AND status = ?").
+		WithArgs("FAILED", "供应商超时", sqlmock.AnyArg(), 3, 2, "SENDING").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	expectTransitionEvents(mock, NotificationEvent{NotificationID: 1, BizID: 1, Status: "SUCCEEDED", Channel: "IN_APP"})
+	mock.ExpectExec("UPDATE `callback_logs` SET `status`=?,`utime`=? WHERE notification_id IN (?)").
+		WithArgs("PENDING", sqlmock.AnyArg(), 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	succeeded, failed, err := NewNotificationDAO(db).BatchUpdateStatusSucceededOrFailed(context.Background(),
+		[]Notification{
+			{ID: 1, BizID: 1, Channel: "IN_APP", Version: 2, DeliveredChannel: "IN_APP"},
+			{ID: 2, BizID: 1, Channel: "IN_APP", Version: 2, DeliveredChannel: "IN_APP"},
+		},
+		[]Notification{{ID: 3, BizID: 1, Channel: "IN_APP", Version: 2, StatusReason: "供应商超时"}},
+		[]InboxMessage{
+			{NotificationID: 1, BizID: 1, Receiver: "user1"},
+			{NotificationID: 2, BizID: 1, Receiver: "user2"},
+		})
+	require.NoError(t, err)
+	require.Len(t, succeeded, 1)
+	assert.Equal(t, uint64(1), succeeded[0].ID)
+	assert.Empty(t, failed)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestNotificationDAO_CASUpdatePending(t *testing.T) {
+	t.Parallel()
+
+	const query = "UPDATE `notifications` SET `next_retry_time`=?,`scheduled_etime`=?,`scheduled_stime`=?,`template_params`=?,`utime`=?,`version`=version + 1 WHERE id = ? AND version = ? AND status = ?"
+	db, mock := newMockDB(t)
+	// 只修改模版参数时保留重试时间
+	mock.ExpectBegin()
+	mock.ExpectExec(query).
+		WithArgs(int64(3000), int64(2000), int64(1000), `{"code":"1234"}`, sqlmock.AnyArg(), 1, 2, "PENDING").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	expectTransitionEvents(mock, NotificationEvent{NotificationID: 1, BizID: 3, Status: "PENDING", Reason: updatedEventReason, Channel: "SMS"})
+	mock.ExpectCommit()
+	err := NewNotificationDAO(db).CASUpdatePending(context.Background(), Notification{
+		ID: 1, BizID: 3, Channel: "SMS", Version: 2, ScheduledSTime: 1000, ScheduledETime: 2000, NextRetryTime: 3000, TemplateParams: `{"code":"1234"}`,
+	})
+	require.NoError(t, err)
+
+	// 调度器已经标记为发送中
+	mock.ExpectBegin()
+	mock.ExpectExec(query).
+		WithArgs(int64(0), int64(2000), int64(1000), `{"code":"1234"}`, sqlmock.AnyArg(), 1, 2, "PENDING").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+	err = NewNotificationDAO(db).CASUpdatePending(context.Background(), Notification{
+		ID: 1, Version: 2, ScheduledSTime: 1000, ScheduledETime: 2000, TemplateParams: `{"code":"1234"}`,
+	})
+	assert.ErrorIs(t, err, errs.ErrNotificationVersionMismatch)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestNotificationDAO_Resend(t *testing.T) {
+	t.Parallel()
+
+	db, mock := newMockDB(t)
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE `notifications` SET `delivered_channel`=?,`next_retry_time`=?,`quota_time`=?,`retry_count`=?,`scheduled_etime`=?,`scheduled_stime`=?,`status`=?,`status_reason`=?,`utime`=?,`version`=version + 1 WHERE id = ? AND version = ? AND status = ?").
+		WithArgs("", 0, sqlmock.AnyArg(), 0, int64(4000), int64(3000), "PENDING", "", sqlmock.AnyArg(), 1, 3, "FAILED").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	expectTransitionEvents(mock, NotificationEvent{NotificationID: 1, BizID: 2, Status: "PENDING", Reason: resentEventReason, Channel: "SMS"})
+	// 已经重新发送过两次，本次是第三次发送记录
+	mock.ExpectQuery("SELECT count(*) FROM `notification_attempts` WHERE notification_id = ?").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"count(*)"}).AddRow(2))
+	mock.ExpectExec("INSERT INTO `notification_attempts` (`notification_id`,`attempt`,`biz_id`,`status`,`status_reason`,`retry_count`,`delivered_channel`,`scheduled_stime`,`scheduled_etime`,`finished_time`,`ctime`) VALUES (?,?,?,?,?,?,?,?,?,?,?)").
+		WithArgs(1, 3, 2, "FAILED", "供应商超时", 1, "", int64(1000), int64(2000), int64(1700000000000), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("UPDATE `callback_logs` SET `next_retry_time`=?,`retry_count`=?,`status`=?,`utime`=? WHERE notification_id = ?").
+		WithArgs(sqlmock.AnyArg(), 0, "INIT", sqlmock.AnyArg(), 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	failed := Notification{
+		ID: 1, BizID: 2, Channel: "SMS", Status: "FAILED", StatusReason: "供应商超时", RetryCount: 1, Version: 3,
+		ScheduledSTime: 1000, ScheduledETime: 2000, Utime: 1700000000000,
+	}
+	next := failed
+	next.Status = "PENDING"
+	next.ScheduledSTime = 3000
+	next.ScheduledETime = 4000
+	err := NewNotificationDAO(db).Resend(context.Background(), failed, next)
+	require.NoError(t, err)
+
+	// 被其他请求抢先重新发送，不会写入发送记录
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE `notifications` SET `delivered_channel`=?,`next_retry_time`=?,`quota_time`=?,`retry_count`=?,`scheduled_etime`=?,`scheduled_stime`=?,`status`=?,`status_reason`=?,`utime`=?,`version`=version + 1 WHERE id = ? AND version = ? AND status = ?").
+		WithArgs("", 0, sqlmock.AnyArg(), 0, int64(4000), int64(3000), "PENDING", "", sqlmock.AnyArg(), 1, 3, "FAILED").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+	err = NewNotificationDAO(db).Resend(context.Background(), failed, next)
+	assert.ErrorIs(t, err, errs.ErrNotificationVersionMismatch)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestNotificationDAO_CASCancel(t *testing.T) {
+	t.Parallel()
+
+	const query = "UPDATE `notifications` SET `status`=?,`status_reason`=?,`utime`=?,`version`=version + 1 WHERE id = ? AND version = ? AND status = ?"
+	n := Notification{ID: 1, BizID: 2, Channel: "SMS", Version: 3, StatusReason: "业务方取消"}
+	db, mock := newMockDB(t)
+	mock.ExpectBegin()
+	mock.ExpectExec(query).
+		WithArgs("CANCELED", "业务方取消", sqlmock.AnyArg(), 1, 3, "PENDING").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	expectTransitionEvents(mock, NotificationEvent{NotificationID: 1, BizID: 2, Status: "CANCELED", Reason: "业务方取消", Channel: "SMS"})
+	mock.ExpectExec("UPDATE `callback_logs` SET `status`=?,`utime`=? WHERE notification_id = ?").
+		WithArgs("PENDING", sqlmock.AnyArg(), 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	require.NoError(t, NewNotificationDAO(db).CASCancel(context.Background(), n))
+
+	// 调度器已经开始发送，不会记录取消事件
+	mock.ExpectBegin()
+	mock.ExpectExec(query).
+		WithArgs("CANCELED", "业务方取消", sqlmock.AnyArg(), 1, 3, "PENDING").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+	assert.ErrorIs(t, NewNotificationDAO(db).CASCancel(context.Background(), n), errs.ErrNotificationVersionMismatch)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestNotificationDAO_CASRecoverSending(t *testing.T) {
+	t.Parallel()
+
+	const query = "UPDATE `notifications` SET `next_retry_time`=?,`status`=?,`status_reason`=?,`utime`=?,`version`=version + 1 WHERE id = ? AND version = ? AND status = ?"
+	db, mock := newMockDB(t)
+	// 修正为 PENDING 时等待重新调度，不需要回调
+	mock.ExpectBegin()
+	mock.ExpectExec(query).
+		WithArgs(1000, "PENDING", "发送超时", sqlmock.AnyArg(), 1, 2, "SENDING").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	expectTransitionEvents(mock, NotificationEvent{NotificationID: 1, BizID: 3, Status: "PENDING", Reason: "发送超时", Channel: "EMAIL"})
+	mock.ExpectCommit()
+	err := NewNotificationDAO(db).CASRecoverSending(context.Background(), Notification{
+		ID: 1, BizID: 3, Channel: "EMAIL", Version: 2, Status: "PENDING", StatusReason: "发送超时", NextRetryTime: 1000,
+	})
+	require.NoError(t, err)
+
+	// 修正为 FAILED 时需要回调
+	mock.ExpectBegin()
+	mock.ExpectExec(query).
+		WithArgs(0, "FAILED", "发送超时", sqlmock.AnyArg(), 1, 2, "SENDING").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	expectTransitionEvents(mock, NotificationEvent{NotificationID: 1, BizID: 3, Status: "FAILED", Reason: "发送超时", Channel: "EMAIL"})
+	mock.ExpectExec("UPDATE `callback_logs` SET `status`=?,`utime`=? WHERE notification_id = ?").
+		WithArgs("PENDING", sqlmock.AnyArg(), 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	err = NewNotificationDAO(db).CASRecoverSending(context.Background(), Notification{
+		ID: 1, BizID: 3, Channel: "EMAIL", Version: 2, Status: "FAILED", StatusReason: "发送超时",
+	})
+	require.NoError(t, err)
+
+	// 发送结果已经写入，不会记录修正事件
+	mock.ExpectBegin()
+	mock.ExpectExec(query).
+		WithArgs(0, "FAILED", "发送超时", sqlmock.AnyArg(), 1, 2, "SENDING").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+	err = NewNotificationDAO(db).CASRecoverSending(context.Background(), Notification{
+		ID: 1, BizID: 3, Channel: "EMAIL", Version: 2, Status: "FAILED", StatusReason: "发送超时",
+	})
+	assert.ErrorIs(t, err, errs.ErrNotificationVersionMismatch)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestNotificationDAO_CASMarkExpired(t *testing.T) {
+	t.Parallel()
+
+	const query = "UPDATE `notifications` SET `status`=?,`status_reason`=?,`utime`=?,`version`=version + 1 WHERE id = ? AND version = ? AND status = ?"
+	db, mock := newMockDB(t)
+	mock.ExpectBegin()
+	mock.ExpectExec(query).
+		WithArgs("EXPIRED", "超过计划发送时间", sqlmock.AnyArg(), 1, 1, "PENDING").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	// 调度器已经开始发送的通知不会被标记为过期
+	mock.ExpectExec(query).
+		WithArgs("EXPIRED", "超过计划发送时间", sqlmock.AnyArg(), 2, 1, "PENDING").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(query).
+		WithArgs("EXPIRED", "超过计划发送时间", sqlmock.AnyArg(), 3, 1, "PENDING").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	expectTransitionEvents(mock,
+		NotificationEvent{NotificationID: 1, BizID: 4, Status: "EXPIRED", Reason: "超过计划发送时间", Channel: "SMS"},
+		NotificationEvent{NotificationID: 3, BizID: 4, Status: "EXPIRED", Reason: "超过计划发送时间", Channel: "IN_APP"})
+	mock.ExpectExec("UPDATE `callback_logs` SET `status`=?,`utime`=? WHERE notification_id IN (?,?)").
+		WithArgs("PENDING", sqlmock.AnyArg(), 1, 3).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	expired, err := NewNotificationDAO(db).CASMarkExpired(context.Background(), []Notification{
+		{ID: 1, BizID: 4, Channel: "SMS", Version: 1, StatusReason: "超过计划发送时间"},
+		{ID: 2, BizID: 4, Channel: "SMS", Version: 1, StatusReason: "超过计划发送时间"},
+		{ID: 3, BizID: 4, Channel: "IN_APP", Version: 1, StatusReason: "超过计划发送时间"},
+	})
+	require.NoError(t, err)
+	require.Len(t, expired, 2)
+	assert.Equal(t, uint64(3), expired[1].ID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestNotificationDAO_List(t *testing.T) {
+	t.Parallel()
+
+	columns := []string{"id", "biz_id", "ctime"}
+	db, mock := newMockDB(t)
+
+	// 第一页，按照 ID 倒序，与 idx_biz_id_status_id 的顺序一致，时间范围只用来过滤
+	mock.ExpectQuery("SELECT * FROM `notifications` WHERE notifications.biz_id = ? AND notifications.status = ? AND notifications.ctime >= ? ORDER BY notifications.id DESC LIMIT ?").
+		WithArgs(1, "FAILED", 1000, 3).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(5, 1, 3000).AddRow(3, 1, 2000).AddRow(2, 1, 2000))
+	ns, err := NewNotificationDAO(db).List(context.Background(), NotificationFilter{BizID: 1, Status: "FAILED", StartTime: 1000}, 0, 3)
+	require.NoError(t, err)
+	require.Len(t, ns, 3)
+
+	// 下一页只返回 ID 小于游标的通知，不需要查询游标对应的通知
+	mock.ExpectQuery("SELECT * FROM `notifications` WHERE notifications.biz_id = ? AND notifications.status = ? AND notifications.ctime >= ? AND notifications.id < ? ORDER BY notifications.id DESC LIMIT ?").
+		WithArgs(1, "FAILED", 1000, 2, 3).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(1, 1, 2000))
+	ns, err = NewNotificationDAO(db).List(context.Background(), NotificationFilter{BizID: 1, Status: "FAILED", StartTime: 1000}, 2, 3)
+	require.NoError(t, err)
+	require.Len(t, ns, 1)
+	assert.Equal(t, uint64(1), ns[0].ID)
+
+	// 按照接收者查询时使用接收者表排序和翻页
+	mock.ExpectQuery("SELECT `notifications`.`id`,`notifications`.`biz_id`,`notifications`.`key`,`notifications`.`receivers`,`notifications`.`channel`,`notifications`.`template_id`,`notifications`.`template_version_id`,`notifications`.`template_params`,`notifications`.`status`,`notifications`.`scheduled_stime`,`notifications`.`scheduled_etime`,`notifications`.`version`,`notifications`.`fallbacks`,`notifications`.`delivered_channel`,`notifications`.`retry_count`,`notifications`.`next_retry_time`,`notifications`.`status_reason`,`notifications`.`priority`,`notifications`.`hash_slot`,`notifications`.`quota_time`,`notifications`.`ctime`,`notifications`.`utime` FROM `notifications` JOIN notification_receivers ON notification_receivers.notification_id = notifications.id WHERE notifications.biz_id = ? AND (notification_receivers.biz_id = ? AND notification_receivers.receiver = ?) AND notification_receivers.ctime < ? AND notification_receivers.notification_id < ? ORDER BY notification_receivers.notification_id DESC LIMIT ?").
+		WithArgs(1, 1, "13800000000", 5000, 9, 3).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(4, 1, 4000))
+	ns, err = NewNotificationDAO(db).List(context.Background(), NotificationFilter{BizID: 1, Receiver: "13800000000", EndTime: 5000}, 9, 3)
+	require.NoError(t, err)
+	require.Len(t, ns, 1)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestNotificationDAO_BackfillReceivers(t *testing.T) {
+	t.Parallel()
+
+	db, mock := newMockDB(t)
+	mock.ExpectQuery("SELECT `id`,`biz_id`,`receivers`,`ctime` FROM `notifications` WHERE id > ? ORDER BY id ASC LIMIT ?").
+		WithArgs(0, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "biz_id", "receivers", "ctime"}).
+			AddRow(1, 2, `["a","b","a"]`, 1000).
+			AddRow(3, 2, `["c"]`, 2000))
+	// 同一条通知中重复的接收者只写入一次，已经存在的接收者被忽略
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `notification_receivers` (`notification_id`,`biz_id`,`receiver`,`ctime`) VALUES (?,?,?,?),(?,?,?,?),(?,?,?,?) ON DUPLICATE KEY UPDATE `id`=`id`").
+		WithArgs(1, 2, "a", 1000, 1, 2, "b", 1000, 3, 2, "c", 2000).
+		WillReturnResult(sqlmock.NewResult(1, 3))
+	mock.ExpectCommit()
+	next, err := NewNotificationDAO(db).BackfillReceivers(context.Background(), 0, 2)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), next)
+
+	mock.ExpectQuery("SELECT `id`,`biz_id`,`receivers`,`ctime` FROM `notifications` WHERE id > ? ORDER BY id ASC LIMIT ?").
+		WithArgs(3, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "biz_id", "receivers", "ctime"}))
+	next, err = NewNotificationDAO(db).BackfillReceivers(context.Background(), 3, 2)
+	require.NoError(t, err)
+	assert.Zero(t, next)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
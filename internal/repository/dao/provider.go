@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/ego-component/egorm"
+	"github.com/robinlg/notification-platform/internal/domain"
 )
 
 const (
@@ -46,6 +47,10 @@ func (Provider) TableName() string {
 type ProviderDAO interface {
 	// Create 创建供应商
 	Create(ctx context.Context, provider Provider) (Provider, error)
+	// FindActiveByChannel 查找指定渠道下所有启用的供应商
+	FindActiveByChannel(ctx context.Context, channel string) ([]Provider, error)
+	// FindByID 根据ID查找供应商
+	FindByID(ctx context.Context, id int64) (Provider, error)
 }
 
 type providerDAO struct {
@@ -137,3 +142,39 @@ func (p *providerDAO) Create(ctx context.Context, provider Provider) (Provider,
 
 	return provider, nil
 }
+
+// FindActiveByChannel 查找指定渠道下所有启用的供应商
+func (p *providerDAO) FindActiveByChannel(ctx context.Context, channel string) ([]Provider, error) {
+	var providers []Provider
+	err := p.db.WithContext(ctx).
+		Where("channel = ? AND status = ?", channel, domain.ProviderStatusActive.String()).
+		Find(&providers).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range providers {
+		secret, err1 := p.decrypt(providers[i].APISecret)
+		if err1 != nil {
+			return nil, err1
+		}
+		providers[i].APISecret = secret
+	}
+	return providers, nil
+}
+
+// FindByID 根据ID查找供应商
+func (p *providerDAO) FindByID(ctx context.Context, id int64) (Provider, error) {
+	var provider Provider
+	err := p.db.WithContext(ctx).Where("id = ?", id).First(&provider).Error
+	if err != nil {
+		return Provider{}, err
+	}
+
+	secret, err := p.decrypt(provider.APISecret)
+	if err != nil {
+		return Provider{}, err
+	}
+	provider.APISecret = secret
+	return provider, nil
+}
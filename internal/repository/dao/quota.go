@@ -0,0 +1,120 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ego-component/egorm"
+	"github.com/robinlg/notification-platform/internal/errs"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Quota 业务方在各渠道上当前周期的额度，剩余额度以 Redis 中的计数为准
+type Quota struct {
+	ID       int64  `gorm:"primaryKey;autoIncrement;comment:'额度ID'"`
+	BizID    int64  `gorm:"type:BIGINT;NOT NULL;uniqueIndex:idx_biz_id_channel,priority:1;comment:'业务配表ID'"`
+	Channel  string `gorm:"type:ENUM('SMS','EMAIL','IN_APP');NOT NULL;uniqueIndex:idx_biz_id_channel,priority:2;comment:'渠道'"`
+	Period   string `gorm:"type:VARCHAR(7);NOT NULL;comment:'当前额度周期，格式为 2006-01'"`
+	Monthly  int64  `gorm:"type:BIGINT;NOT NULL;DEFAULT:0;comment:'业务配置中的每月额度'"`
+	Adjusted int64  `gorm:"type:BIGINT;NOT NULL;DEFAULT:0;comment:'本周期内手动调整的额度'"`
+	Ctime    int64
+	Utime    int64
+}
+
+// TableName 重命名表
+func (Quota) TableName() string {
+	return "quotas"
+}
+
+// QuotaLedger 额度流水，记录每一次额度变更，Redis 数据丢失时可以据此恢复
+type QuotaLedger struct {
+	ID        int64  `gorm:"primaryKey;autoIncrement;comment:'流水ID'"`
+	BizID     int64  `gorm:"type:BIGINT;NOT NULL;index:idx_biz_id_channel_ctime,priority:1;comment:'业务配表ID'"`
+	Channel   string `gorm:"type:ENUM('SMS','EMAIL','IN_APP');NOT NULL;index:idx_biz_id_channel_ctime,priority:2;comment:'渠道'"`
+	Period    string `gorm:"type:VARCHAR(7);NOT NULL;comment:'额度周期，格式为 2006-01'"`
+	Type      string `gorm:"type:ENUM('INIT','RESET','CONFIG','RESEED','ADJUST','RECONCILE');NOT NULL;comment:'流水类型'"`
+	Delta     int64  `gorm:"type:BIGINT;NOT NULL;comment:'额度总量的变化量'"`
+	Total     int64  `gorm:"type:BIGINT;NOT NULL;comment:'变更后本周期的额度总量'"`
+	Remaining int64  `gorm:"type:BIGINT;NOT NULL;comment:'变更后的剩余额度'"`
+	Reason    string `gorm:"type:VARCHAR(256);NOT NULL;DEFAULT:'';comment:'变更原因'"`
+	Operator  string `gorm:"type:VARCHAR(64);NOT NULL;DEFAULT:'';comment:'操作人，系统任务为空'"`
+	Ctime     int64  `gorm:"index:idx_biz_id_channel_ctime,priority:3"`
+}
+
+// TableName 重命名表
+func (QuotaLedger) TableName() string {
+	return "quota_ledgers"
+}
+
+type QuotaDAO interface {
+	// FindByBizID 获取业务方所有渠道的额度
+	FindByBizID(ctx context.Context, bizID int64) ([]Quota, error)
+	// Save 在同一个事务中保存额度并写入流水
+	Save(ctx context.Context, quota Quota, ledger QuotaLedger) error
+	// Adjust 在同一个事务中累加手动调整的额度并写入流水，流水中的周期和总量以调整后的额度为准，返回调整后的额度
+	Adjust(ctx context.Context, ledger QuotaLedger) (Quota, error)
+}
+
+type quotaDAO struct {
+	db *egorm.Component
+}
+
+func NewQuotaDAO(db *egorm.Component) QuotaDAO {
+	return &quotaDAO{db: db}
+}
+
+func (d *quotaDAO) FindByBizID(ctx context.Context, bizID int64) ([]Quota, error) {
+	var res []Quota
+	err := d.db.WithContext(ctx).Where("biz_id = ?", bizID).Find(&res).Error
+	return res, err
+}
+
+func (d *quotaDAO) Save(ctx context.Context, quota Quota, ledger QuotaLedger) error {
+	now := time.Now().UnixMilli()
+	quota.Ctime, quota.Utime = now, now
+	ledger.Ctime = now
+	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "biz_id"}, {Name: "channel"}},
+			DoUpdates: clause.AssignmentColumns([]string{"period", "monthly", "adjusted", "utime"}),
+		}).Create(&quota).Error
+		if err != nil {
+			return err
+		}
+		return tx.Create(&ledger).Error
+	})
+}
+
+func (d *quotaDAO) Adjust(ctx context.Context, ledger QuotaLedger) (Quota, error) {
+	var quota Quota
+	now := time.Now().UnixMilli()
+	err := d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 锁住额度记录，避免与并发的调整和每月重置互相覆盖
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("biz_id = ? AND channel = ?", ledger.BizID, ledger.Channel).
+			First(&quota).Error
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return fmt.Errorf("%w: bizID = %d, channel = %s", errs.ErrQuotaNotFound, ledger.BizID, ledger.Channel)
+			}
+			return err
+		}
+		quota.Adjusted += ledger.Delta
+		quota.Utime = now
+		err = tx.Model(&Quota{}).Where("id = ?", quota.ID).Updates(map[string]any{
+			"adjusted": quota.Adjusted,
+			"utime":    now,
+		}).Error
+		if err != nil {
+			return err
+		}
+		ledger.Period = quota.Period
+		ledger.Total = quota.Monthly + quota.Adjusted
+		ledger.Ctime = now
+		return tx.Create(&ledger).Error
+	})
+	return quota, err
+}
@@ -91,6 +91,12 @@ func (t *txNotificationDAO) Prepare(ctx context.Context, txn TxNotification, not
 			return nil
 		}
 		txn.NotificationID = notification.ID
+		if err := createReceivers(tx.WithContext(ctx), notification); err != nil {
+			return err
+		}
+		if err := createEvents(tx.WithContext(ctx), newTransitionEvent(notification, notification.Status, txPreparedEventReason, now)); err != nil {
+			return err
+		}
 		return tx.WithContext(ctx).Clauses(clause.OnConflict{
 			DoNothing: true,
 		}).Create(&txn).Error
@@ -110,10 +116,26 @@ func (t *txNotificationDAO) UpdateStatus(ctx context.Context, bizID int64, key s
 		if res.RowsAffected == 0 {
 			return ErrUpdateStatusFailed
 		}
-		return tx.WithContext(ctx).
+		err := tx.WithContext(ctx).
 			Model(&Notification{}).
 			Where("biz_id = ? AND `key` = ? ", bizID, key).
 			Update("status", notificationStatus).Error
+		if err != nil {
+			return err
+		}
+		var notification Notification
+		err = tx.WithContext(ctx).
+			Select("id", "biz_id", "channel").
+			Where("biz_id = ? AND `key` = ?", bizID, key).
+			First(&notification).Error
+		if err != nil {
+			return err
+		}
+		reason := txCommittedEventReason
+		if status == domain.TxNotificationStatusCancel {
+			reason = txCanceledEventReason
+		}
+		return createEvents(tx.WithContext(ctx), newTransitionEvent(notification, notificationStatus.String(), reason, time.Now().UnixMilli()))
 	})
 }
 
@@ -135,11 +157,32 @@ func (t *txNotificationDAO) UpdateCheckStatus(ctx context.Context, txNotificatio
 			if err != nil {
 				return err
 			}
-			if status != domain.SendStatusPrepare {
-				return tx.WithContext(ctx).Model(&Notification{}).Where("id in ?", notificationIDs).
-					Update("status", status).Error
+			// 仍然处于 PREPARE 状态的只是更新了下一次回查时间，不算状态变更
+			if status == domain.SendStatusPrepare {
+				return nil
 			}
-			return nil
+			err = tx.WithContext(ctx).Model(&Notification{}).Where("id in ?", notificationIDs).
+				Update("status", status).Error
+			if err != nil {
+				return err
+			}
+			// 事件的渠道以通知记录为准
+			var notifications []Notification
+			err = tx.WithContext(ctx).Select("id", "biz_id", "channel").
+				Where("id in ?", notificationIDs).
+				Find(&notifications).Error
+			if err != nil {
+				return err
+			}
+			reason := checkBackFailedEventReason
+			if status == domain.SendStatusPending {
+				reason = checkBackCommittedEventReason
+			}
+			events := make([]NotificationEvent, 0, len(notifications))
+			for i := range notifications {
+				events = append(events, newTransitionEvent(notifications[i], status.String(), reason, now))
+			}
+			return createEvents(tx.WithContext(ctx), events...)
 		})
 	}
 	return nil
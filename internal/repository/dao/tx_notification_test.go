@@ -0,0 +1,103 @@
+//go:build unit
+
+package dao
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/robinlg/notification-platform/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxNotificationDAO_UpdateStatus(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name               string
+		status             domain.TxNotificationStatus
+		notificationStatus domain.SendStatus
+		reason             string
+	}{
+		{
+			name:               "提交",
+			status:             domain.TxNotificationStatusCommit,
+			notificationStatus: domain.SendStatusPending,
+			reason:             txCommittedEventReason,
+		},
+		{
+			name:               "取消",
+			status:             domain.TxNotificationStatusCancel,
+			notificationStatus: domain.SendStatusCanceled,
+			reason:             txCanceledEventReason,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			db, mock := newMockDB(t)
+			mock.ExpectBegin()
+			mock.ExpectExec("UPDATE `tx_notifications` SET `status`=? WHERE biz_id = ? AND `key` = ? AND status = 'PREPARE'").
+				WithArgs(tc.status.String(), 1, "key-1").
+				WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectExec("UPDATE `notifications` SET `status`=? WHERE biz_id = ? AND `key` = ?").
+				WithArgs(tc.notificationStatus, 1, "key-1").
+				WillReturnResult(sqlmock.NewResult(0, 1))
+			// 事件的渠道以通知记录为准
+			mock.ExpectQuery("SELECT `id`,`biz_id`,`channel` FROM `notifications` WHERE biz_id = ? AND `key` = ? ORDER BY `notifications`.`id` LIMIT ?").
+				WithArgs(1, "key-1", 1).
+				WillReturnRows(sqlmock.NewRows([]string{"id", "biz_id", "channel"}).AddRow(10, 1, "SMS"))
+			expectTransitionEvents(mock, NotificationEvent{NotificationID: 10, BizID: 1, Status: tc.notificationStatus.String(), Reason: tc.reason, Channel: "SMS"})
+			mock.ExpectCommit()
+
+			err := (&txNotificationDAO{db: db}).UpdateStatus(context.Background(), 1, "key-1", tc.status, tc.notificationStatus)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestTxNotificationDAO_UpdateCheckStatus(t *testing.T) {
+	t.Parallel()
+
+	// 回查状态的 SQL 中直接拼接了更新时间
+	quote := func(sql string) string {
+		return strings.ReplaceAll(regexp.QuoteMeta(sql), "UTIME", `\d+`)
+	}
+	txns := []TxNotification{
+		{NotificationID: 10, BizID: 1, Key: "1", Status: "FAIL", CheckCount: 2},
+		{NotificationID: 11, BizID: 1, Key: "2", Status: "FAIL", CheckCount: 3},
+	}
+	db, mock := newMockDBWithMatcher(t, sqlmock.QueryMatcherRegexp)
+	mock.ExpectBegin()
+	mock.ExpectExec(quote("UPDATE `tx_notifications` set `status` = 'FAIL',`utime` = UTIME ,`next_check_time` = 0,`check_count` = 2 WHERE `key` = 1 AND `biz_id` = 1 AND `status` = 'PREPARE'; UPDATE `tx_notifications` set `status` = 'FAIL',`utime` = UTIME ,`next_check_time` = 0,`check_count` = 3 WHERE `key` = 2 AND `biz_id` = 1 AND `status` = 'PREPARE'")).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(quote("UPDATE `notifications` SET `status`=? WHERE id in (?,?)")).
+		WithArgs(domain.SendStatusFailed, 10, 11).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	// 回查任务只知道通知ID，事件的渠道需要从通知记录中查出
+	mock.ExpectQuery(quote("SELECT `id`,`biz_id`,`channel` FROM `notifications` WHERE id in (?,?)")).
+		WithArgs(10, 11).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "biz_id", "channel"}).AddRow(10, 1, "SMS").AddRow(11, 1, "EMAIL"))
+	mock.ExpectExec(quote("INSERT INTO `notification_events` (`notification_id`,`biz_id`,`type`,`status`,`reason`,`channel`,`provider`,`vendor_request_id`,`vendor_code`,`vendor_message`,`latency`,`published`,`ctime`) VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?),(?,?,?,?,?,?,?,?,?,?,?,?,?)")).
+		WithArgs(10, 1, "TRANSITION", "FAILED", checkBackFailedEventReason, "SMS", "", "", "", "", 0, false, sqlmock.AnyArg(),
+			11, 1, "TRANSITION", "FAILED", checkBackFailedEventReason, "EMAIL", "", "", "", "", 0, false, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 2))
+	mock.ExpectCommit()
+	err := (&txNotificationDAO{db: db}).UpdateCheckStatus(context.Background(), txns, domain.SendStatusFailed)
+	require.NoError(t, err)
+
+	// 仍然处于 PREPARE 状态的只是更新了下一次回查时间，不记录事件
+	mock.ExpectBegin()
+	mock.ExpectExec(quote("UPDATE `tx_notifications` set `status` = 'PREPARE',`utime` = UTIME ,`next_check_time` = 5000,`check_count` = 2 WHERE `key` = 1 AND `biz_id` = 1 AND `status` = 'PREPARE'")).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	err = (&txNotificationDAO{db: db}).UpdateCheckStatus(context.Background(), []TxNotification{
+		{NotificationID: 10, BizID: 1, Key: "1", Status: "PREPARE", CheckCount: 2, NextCheckTime: 5000},
+	}, domain.SendStatusPrepare)
+	require.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
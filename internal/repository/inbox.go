@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ecodeclub/ekit/slice"
+	"github.com/robinlg/notification-platform/internal/domain"
+	"github.com/robinlg/notification-platform/internal/repository/dao"
+)
+
+// InboxRepository 站内信仓储接口
+//
+//go:generate mockgen -source=./inbox.go -destination=./mocks/inbox.mock.go -package=repositorymocks -typed InboxRepository
+type InboxRepository interface {
+	// List 按照 ID 倒序分页查询接收者的站内信，只返回 ID 小于 cursor 的站内信
+	List(ctx context.Context, bizID int64, receiver string, onlyUnread bool, cursor int64, limit int) ([]domain.InboxMessage, error)
+	// CountUnread 统计接收者的未读站内信数量
+	CountUnread(ctx context.Context, bizID int64, receiver string) (int64, error)
+	// MarkRead 将站内信标记为已读，ids 为空时标记全部
+	MarkRead(ctx context.Context, bizID int64, receiver string, ids []int64) (int64, error)
+	// Delete 删除站内信
+	Delete(ctx context.Context, bizID int64, receiver string, ids []int64) (int64, error)
+}
+
+type inboxRepository struct {
+	dao dao.InboxDAO
+}
+
+func NewInboxRepository(d dao.InboxDAO) InboxRepository {
+	return &inboxRepository{dao: d}
+}
+
+func (r *inboxRepository) List(ctx context.Context, bizID int64, receiver string, onlyUnread bool, cursor int64, limit int) ([]domain.InboxMessage, error) {
+	messages, err := r.dao.List(ctx, bizID, receiver, onlyUnread, cursor, limit)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(messages, func(_ int, src dao.InboxMessage) domain.InboxMessage {
+		return r.toDomain(src)
+	}), nil
+}
+
+func (r *inboxRepository) CountUnread(ctx context.Context, bizID int64, receiver string) (int64, error) {
+	return r.dao.Count(ctx, bizID, receiver, true)
+}
+
+func (r *inboxRepository) MarkRead(ctx context.Context, bizID int64, receiver string, ids []int64) (int64, error) {
+	return r.dao.MarkRead(ctx, bizID, receiver, ids)
+}
+
+func (r *inboxRepository) Delete(ctx context.Context, bizID int64, receiver string, ids []int64) (int64, error) {
+	return r.dao.Delete(ctx, bizID, receiver, ids)
+}
+
+// toInboxEntity 将站内信转换为 DAO 实体，站内信由通知仓储在标记通知成功时一起写入
+func toInboxEntity(m domain.InboxMessage) dao.InboxMessage {
+	return dao.InboxMessage{
+		ID:             m.ID,
+		NotificationID: m.NotificationID,
+		BizID:          m.BizID,
+		Receiver:       m.Receiver,
+		Title:          m.Title,
+		Content:        m.Content,
+		Status:         m.Status.String(),
+		ReadTime:       m.ReadTime,
+		Ctime:          m.Ctime,
+		Utime:          m.Utime,
+	}
+}
+
+func (r *inboxRepository) toDomain(m dao.InboxMessage) domain.InboxMessage {
+	return domain.InboxMessage{
+		ID:             m.ID,
+		NotificationID: m.NotificationID,
+		BizID:          m.BizID,
+		Receiver:       m.Receiver,
+		Title:          m.Title,
+		Content:        m.Content,
+		Status:         domain.InboxMessageStatus(m.Status),
+		ReadTime:       m.ReadTime,
+		Ctime:          m.Ctime,
+		Utime:          m.Utime,
+	}
+}
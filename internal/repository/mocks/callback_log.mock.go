@@ -0,0 +1,159 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./callback_log.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./callback_log.go -destination=./mocks/callback_log.mock.go -package=repositorymocks -typed CallbackLogRepository
+//
+
+// Package repositorymocks is a generated GoMock package.
+package repositorymocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/robinlg/notification-platform/internal/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCallbackLogRepository is a mock of CallbackLogRepository interface.
+type MockCallbackLogRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCallbackLogRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockCallbackLogRepositoryMockRecorder is the mock recorder for MockCallbackLogRepository.
+type MockCallbackLogRepositoryMockRecorder struct {
+	mock *MockCallbackLogRepository
+}
+
+// NewMockCallbackLogRepository creates a new mock instance.
+func NewMockCallbackLogRepository(ctrl *gomock.Controller) *MockCallbackLogRepository {
+	mock := &MockCallbackLogRepository{ctrl: ctrl}
+	mock.recorder = &MockCallbackLogRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCallbackLogRepository) EXPECT() *MockCallbackLogRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Find mocks base method.
+func (m *MockCallbackLogRepository) Find(ctx context.Context, startTime, batchSize, startID int64) ([]domain.CallbackLog, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, startTime, batchSize, startID)
+	ret0, _ := ret[0].([]domain.CallbackLog)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockCallbackLogRepositoryMockRecorder) Find(ctx, startTime, batchSize, startID any) *MockCallbackLogRepositoryFindCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockCallbackLogRepository)(nil).Find), ctx, startTime, batchSize, startID)
+	return &MockCallbackLogRepositoryFindCall{Call: call}
+}
+
+// MockCallbackLogRepositoryFindCall wrap *gomock.Call
+type MockCallbackLogRepositoryFindCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockCallbackLogRepositoryFindCall) Return(logs []domain.CallbackLog, nextStartID int64, err error) *MockCallbackLogRepositoryFindCall {
+	c.Call = c.Call.Return(logs, nextStartID, err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockCallbackLogRepositoryFindCall) Do(f func(context.Context, int64, int64, int64) ([]domain.CallbackLog, int64, error)) *MockCallbackLogRepositoryFindCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockCallbackLogRepositoryFindCall) DoAndReturn(f func(context.Context, int64, int64, int64) ([]domain.CallbackLog, int64, error)) *MockCallbackLogRepositoryFindCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindByNotificationIDs mocks base method.
+func (m *MockCallbackLogRepository) FindByNotificationIDs(ctx context.Context, notificationIDs []uint64) ([]domain.CallbackLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByNotificationIDs", ctx, notificationIDs)
+	ret0, _ := ret[0].([]domain.CallbackLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByNotificationIDs indicates an expected call of FindByNotificationIDs.
+func (mr *MockCallbackLogRepositoryMockRecorder) FindByNotificationIDs(ctx, notificationIDs any) *MockCallbackLogRepositoryFindByNotificationIDsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByNotificationIDs", reflect.TypeOf((*MockCallbackLogRepository)(nil).FindByNotificationIDs), ctx, notificationIDs)
+	return &MockCallbackLogRepositoryFindByNotificationIDsCall{Call: call}
+}
+
+// MockCallbackLogRepositoryFindByNotificationIDsCall wrap *gomock.Call
+type MockCallbackLogRepositoryFindByNotificationIDsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockCallbackLogRepositoryFindByNotificationIDsCall) Return(arg0 []domain.CallbackLog, arg1 error) *MockCallbackLogRepositoryFindByNotificationIDsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockCallbackLogRepositoryFindByNotificationIDsCall) Do(f func(context.Context, []uint64) ([]domain.CallbackLog, error)) *MockCallbackLogRepositoryFindByNotificationIDsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockCallbackLogRepositoryFindByNotificationIDsCall) DoAndReturn(f func(context.Context, []uint64) ([]domain.CallbackLog, error)) *MockCallbackLogRepositoryFindByNotificationIDsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m *MockCallbackLogRepository) Update(ctx context.Context, logs []domain.CallbackLog) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, logs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockCallbackLogRepositoryMockRecorder) Update(ctx, logs any) *MockCallbackLogRepositoryUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockCallbackLogRepository)(nil).Update), ctx, logs)
+	return &MockCallbackLogRepositoryUpdateCall{Call: call}
+}
+
+// MockCallbackLogRepositoryUpdateCall wrap *gomock.Call
+type MockCallbackLogRepositoryUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockCallbackLogRepositoryUpdateCall) Return(arg0 error) *MockCallbackLogRepositoryUpdateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockCallbackLogRepositoryUpdateCall) Do(f func(context.Context, []domain.CallbackLog) error) *MockCallbackLogRepositoryUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockCallbackLogRepositoryUpdateCall) DoAndReturn(f func(context.Context, []domain.CallbackLog) error) *MockCallbackLogRepositoryUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
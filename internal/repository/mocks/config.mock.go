@@ -0,0 +1,159 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./config.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./config.go -destination=./mocks/config.mock.go -package=repositorymocks -typed BusinessConfigRepository
+//
+
+// Package repositorymocks is a generated GoMock package.
+package repositorymocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/robinlg/notification-platform/internal/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockBusinessConfigRepository is a mock of BusinessConfigRepository interface.
+type MockBusinessConfigRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockBusinessConfigRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockBusinessConfigRepositoryMockRecorder is the mock recorder for MockBusinessConfigRepository.
+type MockBusinessConfigRepositoryMockRecorder struct {
+	mock *MockBusinessConfigRepository
+}
+
+// NewMockBusinessConfigRepository creates a new mock instance.
+func NewMockBusinessConfigRepository(ctrl *gomock.Controller) *MockBusinessConfigRepository {
+	mock := &MockBusinessConfigRepository{ctrl: ctrl}
+	mock.recorder = &MockBusinessConfigRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBusinessConfigRepository) EXPECT() *MockBusinessConfigRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Find mocks base method.
+func (m *MockBusinessConfigRepository) Find(ctx context.Context, offset, limit int) ([]domain.BusinessConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, offset, limit)
+	ret0, _ := ret[0].([]domain.BusinessConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockBusinessConfigRepositoryMockRecorder) Find(ctx, offset, limit any) *MockBusinessConfigRepositoryFindCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockBusinessConfigRepository)(nil).Find), ctx, offset, limit)
+	return &MockBusinessConfigRepositoryFindCall{Call: call}
+}
+
+// MockBusinessConfigRepositoryFindCall wrap *gomock.Call
+type MockBusinessConfigRepositoryFindCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockBusinessConfigRepositoryFindCall) Return(arg0 []domain.BusinessConfig, arg1 error) *MockBusinessConfigRepositoryFindCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockBusinessConfigRepositoryFindCall) Do(f func(context.Context, int, int) ([]domain.BusinessConfig, error)) *MockBusinessConfigRepositoryFindCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockBusinessConfigRepositoryFindCall) DoAndReturn(f func(context.Context, int, int) ([]domain.BusinessConfig, error)) *MockBusinessConfigRepositoryFindCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByID mocks base method.
+func (m *MockBusinessConfigRepository) GetByID(ctx context.Context, id int64) (domain.BusinessConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(domain.BusinessConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockBusinessConfigRepositoryMockRecorder) GetByID(ctx, id any) *MockBusinessConfigRepositoryGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockBusinessConfigRepository)(nil).GetByID), ctx, id)
+	return &MockBusinessConfigRepositoryGetByIDCall{Call: call}
+}
+
+// MockBusinessConfigRepositoryGetByIDCall wrap *gomock.Call
+type MockBusinessConfigRepositoryGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockBusinessConfigRepositoryGetByIDCall) Return(arg0 domain.BusinessConfig, arg1 error) *MockBusinessConfigRepositoryGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockBusinessConfigRepositoryGetByIDCall) Do(f func(context.Context, int64) (domain.BusinessConfig, error)) *MockBusinessConfigRepositoryGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockBusinessConfigRepositoryGetByIDCall) DoAndReturn(f func(context.Context, int64) (domain.BusinessConfig, error)) *MockBusinessConfigRepositoryGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByIDs mocks base method.
+func (m *MockBusinessConfigRepository) GetByIDs(ctx context.Context, ids []int64) (map[int64]domain.BusinessConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIDs", ctx, ids)
+	ret0, _ := ret[0].(map[int64]domain.BusinessConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIDs indicates an expected call of GetByIDs.
+func (mr *MockBusinessConfigRepositoryMockRecorder) GetByIDs(ctx, ids any) *MockBusinessConfigRepositoryGetByIDsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDs", reflect.TypeOf((*MockBusinessConfigRepository)(nil).GetByIDs), ctx, ids)
+	return &MockBusinessConfigRepositoryGetByIDsCall{Call: call}
+}
+
+// MockBusinessConfigRepositoryGetByIDsCall wrap *gomock.Call
+type MockBusinessConfigRepositoryGetByIDsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockBusinessConfigRepositoryGetByIDsCall) Return(arg0 map[int64]domain.BusinessConfig, arg1 error) *MockBusinessConfigRepositoryGetByIDsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockBusinessConfigRepositoryGetByIDsCall) Do(f func(context.Context, []int64) (map[int64]domain.BusinessConfig, error)) *MockBusinessConfigRepositoryGetByIDsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockBusinessConfigRepositoryGetByIDsCall) DoAndReturn(f func(context.Context, []int64) (map[int64]domain.BusinessConfig, error)) *MockBusinessConfigRepositoryGetByIDsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,198 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./inbox.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./inbox.go -destination=./mocks/inbox.mock.go -package=repositorymocks -typed InboxRepository
+//
+
+// Package repositorymocks is a generated GoMock package.
+package repositorymocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/robinlg/notification-platform/internal/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockInboxRepository is a mock of InboxRepository interface.
+type MockInboxRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockInboxRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockInboxRepositoryMockRecorder is the mock recorder for MockInboxRepository.
+type MockInboxRepositoryMockRecorder struct {
+	mock *MockInboxRepository
+}
+
+// NewMockInboxRepository creates a new mock instance.
+func NewMockInboxRepository(ctrl *gomock.Controller) *MockInboxRepository {
+	mock := &MockInboxRepository{ctrl: ctrl}
+	mock.recorder = &MockInboxRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInboxRepository) EXPECT() *MockInboxRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountUnread mocks base method.
+func (m *MockInboxRepository) CountUnread(ctx context.Context, bizID int64, receiver string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountUnread", ctx, bizID, receiver)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountUnread indicates an expected call of CountUnread.
+func (mr *MockInboxRepositoryMockRecorder) CountUnread(ctx, bizID, receiver any) *MockInboxRepositoryCountUnreadCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUnread", reflect.TypeOf((*MockInboxRepository)(nil).CountUnread), ctx, bizID, receiver)
+	return &MockInboxRepositoryCountUnreadCall{Call: call}
+}
+
+// MockInboxRepositoryCountUnreadCall wrap *gomock.Call
+type MockInboxRepositoryCountUnreadCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInboxRepositoryCountUnreadCall) Return(arg0 int64, arg1 error) *MockInboxRepositoryCountUnreadCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInboxRepositoryCountUnreadCall) Do(f func(context.Context, int64, string) (int64, error)) *MockInboxRepositoryCountUnreadCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInboxRepositoryCountUnreadCall) DoAndReturn(f func(context.Context, int64, string) (int64, error)) *MockInboxRepositoryCountUnreadCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Delete mocks base method.
+func (m *MockInboxRepository) Delete(ctx context.Context, bizID int64, receiver string, ids []int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, bizID, receiver, ids)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockInboxRepositoryMockRecorder) Delete(ctx, bizID, receiver, ids any) *MockInboxRepositoryDeleteCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockInboxRepository)(nil).Delete), ctx, bizID, receiver, ids)
+	return &MockInboxRepositoryDeleteCall{Call: call}
+}
+
+// MockInboxRepositoryDeleteCall wrap *gomock.Call
+type MockInboxRepositoryDeleteCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInboxRepositoryDeleteCall) Return(arg0 int64, arg1 error) *MockInboxRepositoryDeleteCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInboxRepositoryDeleteCall) Do(f func(context.Context, int64, string, []int64) (int64, error)) *MockInboxRepositoryDeleteCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInboxRepositoryDeleteCall) DoAndReturn(f func(context.Context, int64, string, []int64) (int64, error)) *MockInboxRepositoryDeleteCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockInboxRepository) List(ctx context.Context, bizID int64, receiver string, onlyUnread bool, cursor int64, limit int) ([]domain.InboxMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, bizID, receiver, onlyUnread, cursor, limit)
+	ret0, _ := ret[0].([]domain.InboxMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockInboxRepositoryMockRecorder) List(ctx, bizID, receiver, onlyUnread, cursor, limit any) *MockInboxRepositoryListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockInboxRepository)(nil).List), ctx, bizID, receiver, onlyUnread, cursor, limit)
+	return &MockInboxRepositoryListCall{Call: call}
+}
+
+// MockInboxRepositoryListCall wrap *gomock.Call
+type MockInboxRepositoryListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInboxRepositoryListCall) Return(arg0 []domain.InboxMessage, arg1 error) *MockInboxRepositoryListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInboxRepositoryListCall) Do(f func(context.Context, int64, string, bool, int64, int) ([]domain.InboxMessage, error)) *MockInboxRepositoryListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInboxRepositoryListCall) DoAndReturn(f func(context.Context, int64, string, bool, int64, int) ([]domain.InboxMessage, error)) *MockInboxRepositoryListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MarkRead mocks base method.
+func (m *MockInboxRepository) MarkRead(ctx context.Context, bizID int64, receiver string, ids []int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkRead", ctx, bizID, receiver, ids)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkRead indicates an expected call of MarkRead.
+func (mr *MockInboxRepositoryMockRecorder) MarkRead(ctx, bizID, receiver, ids any) *MockInboxRepositoryMarkReadCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkRead", reflect.TypeOf((*MockInboxRepository)(nil).MarkRead), ctx, bizID, receiver, ids)
+	return &MockInboxRepositoryMarkReadCall{Call: call}
+}
+
+// MockInboxRepositoryMarkReadCall wrap *gomock.Call
+type MockInboxRepositoryMarkReadCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInboxRepositoryMarkReadCall) Return(arg0 int64, arg1 error) *MockInboxRepositoryMarkReadCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInboxRepositoryMarkReadCall) Do(f func(context.Context, int64, string, []int64) (int64, error)) *MockInboxRepositoryMarkReadCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInboxRepositoryMarkReadCall) DoAndReturn(f func(context.Context, int64, string, []int64) (int64, error)) *MockInboxRepositoryMarkReadCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
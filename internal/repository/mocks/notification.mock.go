@@ -0,0 +1,1050 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./notification.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./notification.go -destination=./mocks/notification.mock.go -package=repositorymocks -typed NotificationRepository
+//
+
+// Package repositorymocks is a generated GoMock package.
+package repositorymocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	domain "github.com/robinlg/notification-platform/internal/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockNotificationRepository is a mock of NotificationRepository interface.
+type MockNotificationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockNotificationRepositoryMockRecorder is the mock recorder for MockNotificationRepository.
+type MockNotificationRepositoryMockRecorder struct {
+	mock *MockNotificationRepository
+}
+
+// NewMockNotificationRepository creates a new mock instance.
+func NewMockNotificationRepository(ctrl *gomock.Controller) *MockNotificationRepository {
+	mock := &MockNotificationRepository{ctrl: ctrl}
+	mock.recorder = &MockNotificationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotificationRepository) EXPECT() *MockNotificationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// BackfillHashSlot mocks base method.
+func (m *MockNotificationRepository) BackfillHashSlot(ctx context.Context, startID uint64, limit int) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackfillHashSlot", ctx, startID, limit)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BackfillHashSlot indicates an expected call of BackfillHashSlot.
+func (mr *MockNotificationRepositoryMockRecorder) BackfillHashSlot(ctx, startID, limit any) *MockNotificationRepositoryBackfillHashSlotCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackfillHashSlot", reflect.TypeOf((*MockNotificationRepository)(nil).BackfillHashSlot), ctx, startID, limit)
+	return &MockNotificationRepositoryBackfillHashSlotCall{Call: call}
+}
+
+// MockNotificationRepositoryBackfillHashSlotCall wrap *gomock.Call
+type MockNotificationRepositoryBackfillHashSlotCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNotificationRepositoryBackfillHashSlotCall) Return(arg0 uint64, arg1 error) *MockNotificationRepositoryBackfillHashSlotCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNotificationRepositoryBackfillHashSlotCall) Do(f func(context.Context, uint64, int) (uint64, error)) *MockNotificationRepositoryBackfillHashSlotCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNotificationRepositoryBackfillHashSlotCall) DoAndReturn(f func(context.Context, uint64, int) (uint64, error)) *MockNotificationRepositoryBackfillHashSlotCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// BackfillReceivers mocks base method.
+func (m *MockNotificationRepository) BackfillReceivers(ctx context.Context, startID uint64, limit int) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackfillReceivers", ctx, startID, limit)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BackfillReceivers indicates an expected call of BackfillReceivers.
+func (mr *MockNotificationRepositoryMockRecorder) BackfillReceivers(ctx, startID, limit any) *MockNotificationRepositoryBackfillReceiversCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackfillReceivers", reflect.TypeOf((*MockNotificationRepository)(nil).BackfillReceivers), ctx, startID, limit)
+	return &MockNotificationRepositoryBackfillReceiversCall{Call: call}
+}
+
+// MockNotificationRepositoryBackfillReceiversCall wrap *gomock.Call
+type MockNotificationRepositoryBackfillReceiversCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNotificationRepositoryBackfillReceiversCall) Return(arg0 uint64, arg1 error) *MockNotificationRepositoryBackfillReceiversCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNotificationRepositoryBackfillReceiversCall) Do(f func(context.Context, uint64, int) (uint64, error)) *MockNotificationRepositoryBackfillReceiversCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNotificationRepositoryBackfillReceiversCall) DoAndReturn(f func(context.Context, uint64, int) (uint64, error)) *MockNotificationRepositoryBackfillReceiversCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// BatchCreate mocks base method.
+func (m *MockNotificationRepository) BatchCreate(ctx context.Context, notifications []domain.Notification) ([]domain.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchCreate", ctx, notifications)
+	ret0, _ := ret[0].([]domain.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchCreate indicates an expected call of BatchCreate.
+func (mr *MockNotificationRepositoryMockRecorder) BatchCreate(ctx, notifications any) *MockNotificationRepositoryBatchCreateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchCreate", reflect.TypeOf((*MockNotificationRepository)(nil).BatchCreate), ctx, notifications)
+	return &MockNotificationRepositoryBatchCreateCall{Call: call}
+}
+
+// MockNotificationRepositoryBatchCreateCall wrap *gomock.Call
+type MockNotificationRepositoryBatchCreateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNotificationRepositoryBatchCreateCall) Return(arg0 []domain.Notification, arg1 error) *MockNotificationRepositoryBatchCreateCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNotificationRepositoryBatchCreateCall) Do(f func(context.Context, []domain.Notification) ([]domain.Notification, error)) *MockNotificationRepositoryBatchCreateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNotificationRepositoryBatchCreateCall) DoAndReturn(f func(context.Context, []domain.Notification) ([]domain.Notification, error)) *MockNotificationRepositoryBatchCreateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// BatchCreateWithCallbackLog mocks base method.
+func (m *MockNotificationRepository) BatchCreateWithCallbackLog(ctx context.Context, notifications []domain.Notification) ([]domain.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchCreateWithCallbackLog", ctx, notifications)
+	ret0, _ := ret[0].([]domain.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchCreateWithCallbackLog indicates an expected call of BatchCreateWithCallbackLog.
+func (mr *MockNotificationRepositoryMockRecorder) BatchCreateWithCallbackLog(ctx, notifications any) *MockNotificationRepositoryBatchCreateWithCallbackLogCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchCreateWithCallbackLog", reflect.TypeOf((*MockNotificationRepository)(nil).BatchCreateWithCallbackLog), ctx, notifications)
+	return &MockNotificationRepositoryBatchCreateWithCallbackLogCall{Call: call}
+}
+
+// MockNotificationRepositoryBatchCreateWithCallbackLogCall wrap *gomock.Call
+type MockNotificationRepositoryBatchCreateWithCallbackLogCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNotificationRepositoryBatchCreateWithCallbackLogCall) Return(arg0 []domain.Notification, arg1 error) *MockNotificationRepositoryBatchCreateWithCallbackLogCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNotificationRepositoryBatchCreateWithCallbackLogCall) Do(f func(context.Context, []domain.Notification) ([]domain.Notification, error)) *MockNotificationRepositoryBatchCreateWithCallbackLogCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNotificationRepositoryBatchCreateWithCallbackLogCall) DoAndReturn(f func(context.Context, []domain.Notification) ([]domain.Notification, error)) *MockNotificationRepositoryBatchCreateWithCallbackLogCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// BatchGetByIDs mocks base method.
+func (m *MockNotificationRepository) BatchGetByIDs(ctx context.Context, ids []uint64) (map[uint64]domain.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchGetByIDs", ctx, ids)
+	ret0, _ := ret[0].(map[uint64]domain.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchGetByIDs indicates an expected call of BatchGetByIDs.
+func (mr *MockNotificationRepositoryMockRecorder) BatchGetByIDs(ctx, ids any) *MockNotificationRepositoryBatchGetByIDsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchGetByIDs", reflect.TypeOf((*MockNotificationRepository)(nil).BatchGetByIDs), ctx, ids)
+	return &MockNotificationRepositoryBatchGetByIDsCall{Call: call}
+}
+
+// MockNotificationRepositoryBatchGetByIDsCall wrap *gomock.Call
+type MockNotificationRepositoryBatchGetByIDsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNotificationRepositoryBatchGetByIDsCall) Return(arg0 map[uint64]domain.Notification, arg1 error) *MockNotificationRepositoryBatchGetByIDsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNotificationRepositoryBatchGetByIDsCall) Do(f func(context.Context, []uint64) (map[uint64]domain.Notification, error)) *MockNotificationRepositoryBatchGetByIDsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNotificationRepositoryBatchGetByIDsCall) DoAndReturn(f func(context.Context, []uint64) (map[uint64]domain.Notification, error)) *MockNotificationRepositoryBatchGetByIDsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// BatchGetByKeys mocks base method.
+func (m *MockNotificationRepository) BatchGetByKeys(ctx context.Context, bizID int64, keys []string) (map[string]domain.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchGetByKeys", ctx, bizID, keys)
+	ret0, _ := ret[0].(map[string]domain.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchGetByKeys indicates an expected call of BatchGetByKeys.
+func (mr *MockNotificationRepositoryMockRecorder) BatchGetByKeys(ctx, bizID, keys any) *MockNotificationRepositoryBatchGetByKeysCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchGetByKeys", reflect.TypeOf((*MockNotificationRepository)(nil).BatchGetByKeys), ctx, bizID, keys)
+	return &MockNotificationRepositoryBatchGetByKeysCall{Call: call}
+}
+
+// MockNotificationRepositoryBatchGetByKeysCall wrap *gomock.Call
+type MockNotificationRepositoryBatchGetByKeysCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNotificationRepositoryBatchGetByKeysCall) Return(arg0 map[string]domain.Notification, arg1 error) *MockNotificationRepositoryBatchGetByKeysCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNotificationRepositoryBatchGetByKeysCall) Do(f func(context.Context, int64, []string) (map[string]domain.Notification, error)) *MockNotificationRepositoryBatchGetByKeysCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNotificationRepositoryBatchGetByKeysCall) DoAndReturn(f func(context.Context, int64, []string) (map[string]domain.Notification, error)) *MockNotificationRepositoryBatchGetByKeysCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// BatchMarkRetry mocks base method.
+func (m *MockNotificationRepository) BatchMarkRetry(ctx context.Context, notifications []domain.Notification) ([]domain.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchMarkRetry", ctx, notifications)
+	ret0, _ := ret[0].([]domain.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchMarkRetry indicates an expected call of BatchMarkRetry.
+func (mr *MockNotificationRepositoryMockRecorder) BatchMarkRetry(ctx, notifications any) *MockNotificationRepositoryBatchMarkRetryCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchMarkRetry", reflect.TypeOf((*MockNotificationRepository)(nil).BatchMarkRetry), ctx, notifications)
+	return &MockNotificationRepositoryBatchMarkRetryCall{Call: call}
+}
+
+// MockNotificationRepositoryBatchMarkRetryCall wrap *gomock.Call
+type MockNotificationRepositoryBatchMarkRetryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNotificationRepositoryBatchMarkRetryCall) Return(arg0 []domain.Notification, arg1 error) *MockNotificationRepositoryBatchMarkRetryCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNotificationRepositoryBatchMarkRetryCall) Do(f func(context.Context, []domain.Notification) ([]domain.Notification, error)) *MockNotificationRepositoryBatchMarkRetryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNotificationRepositoryBatchMarkRetryCall) DoAndReturn(f func(context.Context, []domain.Notification) ([]domain.Notification, error)) *MockNotificationRepositoryBatchMarkRetryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// BatchUpdateStatusSucceededOrFailed mocks base method.
+func (m *MockNotificationRepository) BatchUpdateStatusSucceededOrFailed(ctx context.Context, succeededNotifications, failedNotifications []domain.Notification, inbox []domain.InboxMessage) ([]domain.Notification, []domain.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchUpdateStatusSucceededOrFailed", ctx, succeededNotifications, failedNotifications, inbox)
+	ret0, _ := ret[0].([]domain.Notification)
+	ret1, _ := ret[1].([]domain.Notification)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// BatchUpdateStatusSucceededOrFailed indicates an expected call of BatchUpdateStatusSucceededOrFailed.
+func (mr *MockNotificationRepositoryMockRecorder) BatchUpdateStatusSucceededOrFailed(ctx, succeededNotifications, failedNotifications, inbox any) *MockNotificationRepositoryBatchUpdateStatusSucceededOrFailedCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchUpdateStatusSucceededOrFailed", reflect.TypeOf((*MockNotificationRepository)(nil).BatchUpdateStatusSucceededOrFailed), ctx, succeededNotifications, failedNotifications, inbox)
+	return &MockNotificationRepositoryBatchUpdateStatusSucceededOrFailedCall{Call: call}
+}
+
+// MockNotificationRepositoryBatchUpdateStatusSucceededOrFailedCall wrap *gomock.Call
+type MockNotificationRepositoryBatchUpdateStatusSucceededOrFailedCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNotificationRepositoryBatchUpdateStatusSucceededOrFailedCall) Return(succeeded, failed []domain.Notification, err error) *MockNotificationRepositoryBatchUpdateStatusSucceededOrFailedCall {
+	c.Call = c.Call.Return(succeeded, failed, err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNotificationRepositoryBatchUpdateStatusSucceededOrFailedCall) Do(f func(context.Context, []domain.Notification, []domain.Notification, []domain.InboxMessage) ([]domain.Notification, []domain.Notification, error)) *MockNotificationRepositoryBatchUpdateStatusSucceededOrFailedCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNotificationRepositoryBatchUpdateStatusSucceededOrFailedCall) DoAndReturn(f func(context.Context, []domain.Notification, []domain.Notification, []domain.InboxMessage) ([]domain.Notification, []domain.Notification, error)) *MockNotificationRepositoryBatchUpdateStatusSucceededOrFailedCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CASCancel mocks base method.
+func (m *MockNotificationRepository) CASCancel(ctx context.Context, notification domain.Notification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CASCancel", ctx, notification)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CASCancel indicates an expected call of CASCancel.
+func (mr *MockNotificationRepositoryMockRecorder) CASCancel(ctx, notification any) *MockNotificationRepositoryCASCancelCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CASCancel", reflect.TypeOf((*MockNotificationRepository)(nil).CASCancel), ctx, notification)
+	return &MockNotificationRepositoryCASCancelCall{Call: call}
+}
+
+// MockNotificationRepositoryCASCancelCall wrap *gomock.Call
+type MockNotificationRepositoryCASCancelCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNotificationRepositoryCASCancelCall) Return(arg0 error) *MockNotificationRepositoryCASCancelCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNotificationRepositoryCASCancelCall) Do(f func(context.Context, domain.Notification) error) *MockNotificationRepositoryCASCancelCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNotificationRepositoryCASCancelCall) DoAndReturn(f func(context.Context, domain.Notification) error) *MockNotificationRepositoryCASCancelCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CASMarkExpired mocks base method.
+func (m *MockNotificationRepository) CASMarkExpired(ctx context.Context, notifications []domain.Notification) ([]domain.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CASMarkExpired", ctx, notifications)
+	ret0, _ := ret[0].([]domain.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CASMarkExpired indicates an expected call of CASMarkExpired.
+func (mr *MockNotificationRepositoryMockRecorder) CASMarkExpired(ctx, notifications any) *MockNotificationRepositoryCASMarkExpiredCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CASMarkExpired", reflect.TypeOf((*MockNotificationRepository)(nil).CASMarkExpired), ctx, notifications)
+	return &MockNotificationRepositoryCASMarkExpiredCall{Call: call}
+}
+
+// MockNotificationRepositoryCASMarkExpiredCall wrap *gomock.Call
+type MockNotificationRepositoryCASMarkExpiredCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNotificationRepositoryCASMarkExpiredCall) Return(arg0 []domain.Notification, arg1 error) *MockNotificationRepositoryCASMarkExpiredCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNotificationRepositoryCASMarkExpiredCall) Do(f func(context.Context, []domain.Notification) ([]domain.Notification, error)) *MockNotificationRepositoryCASMarkExpiredCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNotificationRepositoryCASMarkExpiredCall) DoAndReturn(f func(context.Context, []domain.Notification) ([]domain.Notification, error)) *MockNotificationRepositoryCASMarkExpiredCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CASMarkSending mocks base method.
+func (m *MockNotificationRepository) CASMarkSending(ctx context.Context, notifications []domain.Notification) ([]domain.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CASMarkSending", ctx, notifications)
+	ret0, _ := ret[0].([]domain.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CASMarkSending indicates an expected call of CASMarkSending.
+func (mr *MockNotificationRepositoryMockRecorder) CASMarkSending(ctx, notifications any) *MockNotificationRepositoryCASMarkSendingCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CASMarkSending", reflect.TypeOf((*MockNotificationRepository)(nil).CASMarkSending), ctx, notifications)
+	return &MockNotificationRepositoryCASMarkSendingCall{Call: call}
+}
+
+// MockNotificationRepositoryCASMarkSendingCall wrap *gomock.Call
+type MockNotificationRepositoryCASMarkSendingCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNotificationRepositoryCASMarkSendingCall) Return(arg0 []domain.Notification, arg1 error) *MockNotificationRepositoryCASMarkSendingCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNotificationRepositoryCASMarkSendingCall) Do(f func(context.Context, []domain.Notification) ([]domain.Notification, error)) *MockNotificationRepositoryCASMarkSendingCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNotificationRepositoryCASMarkSendingCall) DoAndReturn(f func(context.Context, []domain.Notification) ([]domain.Notification, error)) *MockNotificationRepositoryCASMarkSendingCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CASRecoverSending mocks base method.
+func (m *MockNotificationRepository) CASRecoverSending(ctx context.Context, notification domain.Notification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CASRecoverSending", ctx, notification)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CASRecoverSending indicates an expected call of CASRecoverSending.
+func (mr *MockNotificationRepositoryMockRecorder) CASRecoverSending(ctx, notification any) *MockNotificationRepositoryCASRecoverSendingCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CASRecoverSending", reflect.TypeOf((*MockNotificationRepository)(nil).CASRecoverSending), ctx, notification)
+	return &MockNotificationRepositoryCASRecoverSendingCall{Call: call}
+}
+
+// MockNotificationRepositoryCASRecoverSendingCall wrap *gomock.Call
+type MockNotificationRepositoryCASRecoverSendingCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNotificationRepositoryCASRecoverSendingCall) Return(arg0 error) *MockNotificationRepositoryCASRecoverSendingCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNotificationRepositoryCASRecoverSendingCall) Do(f func(context.Context, domain.Notification) error) *MockNotificationRepositoryCASRecoverSendingCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNotificationRepositoryCASRecoverSendingCall) DoAndReturn(f func(context.Context, domain.Notification) error) *MockNotificationRepositoryCASRecoverSendingCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CASStatus mocks base method.
+func (m *MockNotificationRepository) CASStatus(ctx context.Context, notification domain.Notification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CASStatus", ctx, notification)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CASStatus indicates an expected call of CASStatus.
+func (mr *MockNotificationRepositoryMockRecorder) CASStatus(ctx, notification any) *MockNotificationRepositoryCASStatusCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CASStatus", reflect.TypeOf((*MockNotificationRepository)(nil).CASStatus), ctx, notification)
+	return &MockNotificationRepositoryCASStatusCall{Call: call}
+}
+
+// MockNotificationRepositoryCASStatusCall wrap *gomock.Call
+type MockNotificationRepositoryCASStatusCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNotificationRepositoryCASStatusCall) Return(arg0 error) *MockNotificationRepositoryCASStatusCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNotificationRepositoryCASStatusCall) Do(f func(context.Context, domain.Notification) error) *MockNotificationRepositoryCASStatusCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNotificationRepositoryCASStatusCall) DoAndReturn(f func(context.Context, domain.Notification) error) *MockNotificationRepositoryCASStatusCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CASUpdatePending mocks base method.
+func (m *MockNotificationRepository) CASUpdatePending(ctx context.Context, notification domain.Notification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CASUpdatePending", ctx, notification)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CASUpdatePending indicates an expected call of CASUpdatePending.
+func (mr *MockNotificationRepositoryMockRecorder) CASUpdatePending(ctx, notification any) *MockNotificationRepositoryCASUpdatePendingCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CASUpdatePending", reflect.TypeOf((*MockNotificationRepository)(nil).CASUpdatePending), ctx, notification)
+	return &MockNotificationRepositoryCASUpdatePendingCall{Call: call}
+}
+
+// MockNotificationRepositoryCASUpdatePendingCall wrap *gomock.Call
+type MockNotificationRepositoryCASUpdatePendingCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNotificationRepositoryCASUpdatePendingCall) Return(arg0 error) *MockNotificationRepositoryCASUpdatePendingCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNotificationRepositoryCASUpdatePendingCall) Do(f func(context.Context, domain.Notification) error) *MockNotificationRepositoryCASUpdatePendingCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNotificationRepositoryCASUpdatePendingCall) DoAndReturn(f func(context.Context, domain.Notification) error) *MockNotificationRepositoryCASUpdatePendingCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CountUsedQuota mocks base method.
+func (m *MockNotificationRepository) CountUsedQuota(ctx context.Context, bizID int64, channel domain.Channel, start, end time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountUsedQuota", ctx, bizID, channel, start, end)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountUsedQuota indicates an expected call of CountUsedQuota.
+func (mr *MockNotificationRepositoryMockRecorder) CountUsedQuota(ctx, bizID, channel, start, end any) *MockNotificationRepositoryCountUsedQuotaCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUsedQuota", reflect.TypeOf((*MockNotificationRepository)(nil).CountUsedQuota), ctx, bizID, channel, start, end)
+	return &MockNotificationRepositoryCountUsedQuotaCall{Call: call}
+}
+
+// MockNotificationRepositoryCountUsedQuotaCall wrap *gomock.Call
+type MockNotificationRepositoryCountUsedQuotaCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNotificationRepositoryCountUsedQuotaCall) Return(arg0 int64, arg1 error) *MockNotificationRepositoryCountUsedQuotaCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNotificationRepositoryCountUsedQuotaCall) Do(f func(context.Context, int64, domain.Channel, time.Time, time.Time) (int64, error)) *MockNotificationRepositoryCountUsedQuotaCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNotificationRepositoryCountUsedQuotaCall) DoAndReturn(f func(context.Context, int64, domain.Channel, time.Time, time.Time) (int64, error)) *MockNotificationRepositoryCountUsedQuotaCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Create mocks base method.
+func (m *MockNotificationRepository) Create(ctx context.Context, notification domain.Notification) (domain.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, notification)
+	ret0, _ := ret[0].(domain.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockNotificationRepositoryMockRecorder) Create(ctx, notification any) *MockNotificationRepositoryCreateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockNotificationRepository)(nil).Create), ctx, notification)
+	return &MockNotificationRepositoryCreateCall{Call: call}
+}
+
+// MockNotificationRepositoryCreateCall wrap *gomock.Call
+type MockNotificationRepositoryCreateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNotificationRepositoryCreateCall) Return(arg0 domain.Notification, arg1 error) *MockNotificationRepositoryCreateCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNotificationRepositoryCreateCall) Do(f func(context.Context, domain.Notification) (domain.Notification, error)) *MockNotificationRepositoryCreateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNotificationRepositoryCreateCall) DoAndReturn(f func(context.Context, domain.Notification) (domain.Notification, error)) *MockNotificationRepositoryCreateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CreateWithCallbackLog mocks base method.
+func (m *MockNotificationRepository) CreateWithCallbackLog(ctx context.Context, notification domain.Notification) (domain.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWithCallbackLog", ctx, notification)
+	ret0, _ := ret[0].(domain.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWithCallbackLog indicates an expected call of CreateWithCallbackLog.
+func (mr *MockNotificationRepositoryMockRecorder) CreateWithCallbackLog(ctx, notification any) *MockNotificationRepositoryCreateWithCallbackLogCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWithCallbackLog", reflect.TypeOf((*MockNotificationRepository)(nil).CreateWithCallbackLog), ctx, notification)
+	return &MockNotificationRepositoryCreateWithCallbackLogCall{Call: call}
+}
+
+// MockNotificationRepositoryCreateWithCallbackLogCall wrap *gomock.Call
+type MockNotificationRepositoryCreateWithCallbackLogCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNotificationRepositoryCreateWithCallbackLogCall) Return(arg0 domain.Notification, arg1 error) *MockNotificationRepositoryCreateWithCallbackLogCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNotificationRepositoryCreateWithCallbackLogCall) Do(f func(context.Context, domain.Notification) (domain.Notification, error)) *MockNotificationRepositoryCreateWithCallbackLogCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNotificationRepositoryCreateWithCallbackLogCall) DoAndReturn(f func(context.Context, domain.Notification) (domain.Notification, error)) *MockNotificationRepositoryCreateWithCallbackLogCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindExpiredPending mocks base method.
+func (m *MockNotificationRepository) FindExpiredPending(ctx context.Context, limit int) ([]domain.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindExpiredPending", ctx, limit)
+	ret0, _ := ret[0].([]domain.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindExpiredPending indicates an expected call of FindExpiredPending.
+func (mr *MockNotificationRepositoryMockRecorder) FindExpiredPending(ctx, limit any) *MockNotificationRepositoryFindExpiredPendingCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindExpiredPending", reflect.TypeOf((*MockNotificationRepository)(nil).FindExpiredPending), ctx, limit)
+	return &MockNotificationRepositoryFindExpiredPendingCall{Call: call}
+}
+
+// MockNotificationRepositoryFindExpiredPendingCall wrap *gomock.Call
+type MockNotificationRepositoryFindExpiredPendingCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNotificationRepositoryFindExpiredPendingCall) Return(arg0 []domain.Notification, arg1 error) *MockNotificationRepositoryFindExpiredPendingCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNotificationRepositoryFindExpiredPendingCall) Do(f func(context.Context, int) ([]domain.Notification, error)) *MockNotificationRepositoryFindExpiredPendingCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNotificationRepositoryFindExpiredPendingCall) DoAndReturn(f func(context.Context, int) ([]domain.Notification, error)) *MockNotificationRepositoryFindExpiredPendingCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindReadyNotifications mocks base method.
+func (m *MockNotificationRepository) FindReadyNotifications(ctx context.Context, partition domain.Partition, priority domain.Priority, offset, limit int) ([]domain.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindReadyNotifications", ctx, partition, priority, offset, limit)
+	ret0, _ := ret[0].([]domain.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindReadyNotifications indicates an expected call of FindReadyNotifications.
+func (mr *MockNotificationRepositoryMockRecorder) FindReadyNotifications(ctx, partition, priority, offset, limit any) *MockNotificationRepositoryFindReadyNotificationsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindReadyNotifications", reflect.TypeOf((*MockNotificationRepository)(nil).FindReadyNotifications), ctx, partition, priority, offset, limit)
+	return &MockNotificationRepositoryFindReadyNotificationsCall{Call: call}
+}
+
+// MockNotificationRepositoryFindReadyNotificationsCall wrap *gomock.Call
+type MockNotificationRepositoryFindReadyNotificationsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNotificationRepositoryFindReadyNotificationsCall) Return(arg0 []domain.Notification, arg1 error) *MockNotificationRepositoryFindReadyNotificationsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNotificationRepositoryFindReadyNotificationsCall) Do(f func(context.Context, domain.Partition, domain.Priority, int, int) ([]domain.Notification, error)) *MockNotificationRepositoryFindReadyNotificationsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNotificationRepositoryFindReadyNotificationsCall) DoAndReturn(f func(context.Context, domain.Partition, domain.Priority, int, int) ([]domain.Notification, error)) *MockNotificationRepositoryFindReadyNotificationsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindTimeoutSending mocks base method.
+func (m *MockNotificationRepository) FindTimeoutSending(ctx context.Context, timeout time.Duration, limit int) ([]domain.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTimeoutSending", ctx, timeout, limit)
+	ret0, _ := ret[0].([]domain.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTimeoutSending indicates an expected call of FindTimeoutSending.
+func (mr *MockNotificationRepositoryMockRecorder) FindTimeoutSending(ctx, timeout, limit any) *MockNotificationRepositoryFindTimeoutSendingCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTimeoutSending", reflect.TypeOf((*MockNotificationRepository)(nil).FindTimeoutSending), ctx, timeout, limit)
+	return &MockNotificationRepositoryFindTimeoutSendingCall{Call: call}
+}
+
+// MockNotificationRepositoryFindTimeoutSendingCall wrap *gomock.Call
+type MockNotificationRepositoryFindTimeoutSendingCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNotificationRepositoryFindTimeoutSendingCall) Return(arg0 []domain.Notification, arg1 error) *MockNotificationRepositoryFindTimeoutSendingCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNotificationRepositoryFindTimeoutSendingCall) Do(f func(context.Context, time.Duration, int) ([]domain.Notification, error)) *MockNotificationRepositoryFindTimeoutSendingCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNotificationRepositoryFindTimeoutSendingCall) DoAndReturn(f func(context.Context, time.Duration, int) ([]domain.Notification, error)) *MockNotificationRepositoryFindTimeoutSendingCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByKey mocks base method.
+func (m *MockNotificationRepository) GetByKey(ctx context.Context, bizID int64, key string) (domain.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByKey", ctx, bizID, key)
+	ret0, _ := ret[0].(domain.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByKey indicates an expected call of GetByKey.
+func (mr *MockNotificationRepositoryMockRecorder) GetByKey(ctx, bizID, key any) *MockNotificationRepositoryGetByKeyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByKey", reflect.TypeOf((*MockNotificationRepository)(nil).GetByKey), ctx, bizID, key)
+	return &MockNotificationRepositoryGetByKeyCall{Call: call}
+}
+
+// MockNotificationRepositoryGetByKeyCall wrap *gomock.Call
+type MockNotificationRepositoryGetByKeyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNotificationRepositoryGetByKeyCall) Return(arg0 domain.Notification, arg1 error) *MockNotificationRepositoryGetByKeyCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNotificationRepositoryGetByKeyCall) Do(f func(context.Context, int64, string) (domain.Notification, error)) *MockNotificationRepositoryGetByKeyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNotificationRepositoryGetByKeyCall) DoAndReturn(f func(context.Context, int64, string) (domain.Notification, error)) *MockNotificationRepositoryGetByKeyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockNotificationRepository) List(ctx context.Context, filter domain.NotificationFilter, cursor uint64, limit int) ([]domain.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, filter, cursor, limit)
+	ret0, _ := ret[0].([]domain.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockNotificationRepositoryMockRecorder) List(ctx, filter, cursor, limit any) *MockNotificationRepositoryListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockNotificationRepository)(nil).List), ctx, filter, cursor, limit)
+	return &MockNotificationRepositoryListCall{Call: call}
+}
+
+// MockNotificationRepositoryListCall wrap *gomock.Call
+type MockNotificationRepositoryListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNotificationRepositoryListCall) Return(arg0 []domain.Notification, arg1 error) *MockNotificationRepositoryListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNotificationRepositoryListCall) Do(f func(context.Context, domain.NotificationFilter, uint64, int) ([]domain.Notification, error)) *MockNotificationRepositoryListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNotificationRepositoryListCall) DoAndReturn(f func(context.Context, domain.NotificationFilter, uint64, int) ([]domain.Notification, error)) *MockNotificationRepositoryListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MarkFailed mocks base method.
+func (m *MockNotificationRepository) MarkFailed(ctx context.Context, notification domain.Notification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkFailed", ctx, notification)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkFailed indicates an expected call of MarkFailed.
+func (mr *MockNotificationRepositoryMockRecorder) MarkFailed(ctx, notification any) *MockNotificationRepositoryMarkFailedCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkFailed", reflect.TypeOf((*MockNotificationRepository)(nil).MarkFailed), ctx, notification)
+	return &MockNotificationRepositoryMarkFailedCall{Call: call}
+}
+
+// MockNotificationRepositoryMarkFailedCall wrap *gomock.Call
+type MockNotificationRepositoryMarkFailedCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNotificationRepositoryMarkFailedCall) Return(arg0 error) *MockNotificationRepositoryMarkFailedCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNotificationRepositoryMarkFailedCall) Do(f func(context.Context, domain.Notification) error) *MockNotificationRepositoryMarkFailedCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNotificationRepositoryMarkFailedCall) DoAndReturn(f func(context.Context, domain.Notification) error) *MockNotificationRepositoryMarkFailedCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MarkRetry mocks base method.
+func (m *MockNotificationRepository) MarkRetry(ctx context.Context, notification domain.Notification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkRetry", ctx, notification)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkRetry indicates an expected call of MarkRetry.
+func (mr *MockNotificationRepositoryMockRecorder) MarkRetry(ctx, notification any) *MockNotificationRepositoryMarkRetryCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkRetry", reflect.TypeOf((*MockNotificationRepository)(nil).MarkRetry), ctx, notification)
+	return &MockNotificationRepositoryMarkRetryCall{Call: call}
+}
+
+// MockNotificationRepositoryMarkRetryCall wrap *gomock.Call
+type MockNotificationRepositoryMarkRetryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNotificationRepositoryMarkRetryCall) Return(arg0 error) *MockNotificationRepositoryMarkRetryCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNotificationRepositoryMarkRetryCall) Do(f func(context.Context, domain.Notification) error) *MockNotificationRepositoryMarkRetryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNotificationRepositoryMarkRetryCall) DoAndReturn(f func(context.Context, domain.Notification) error) *MockNotificationRepositoryMarkRetryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MarkSuccess mocks base method.
+func (m *MockNotificationRepository) MarkSuccess(ctx context.Context, entity domain.Notification, inbox []domain.InboxMessage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkSuccess", ctx, entity, inbox)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkSuccess indicates an expected call of MarkSuccess.
+func (mr *MockNotificationRepositoryMockRecorder) MarkSuccess(ctx, entity, inbox any) *MockNotificationRepositoryMarkSuccessCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkSuccess", reflect.TypeOf((*MockNotificationRepository)(nil).MarkSuccess), ctx, entity, inbox)
+	return &MockNotificationRepositoryMarkSuccessCall{Call: call}
+}
+
+// MockNotificationRepositoryMarkSuccessCall wrap *gomock.Call
+type MockNotificationRepositoryMarkSuccessCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNotificationRepositoryMarkSuccessCall) Return(arg0 error) *MockNotificationRepositoryMarkSuccessCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNotificationRepositoryMarkSuccessCall) Do(f func(context.Context, domain.Notification, []domain.InboxMessage) error) *MockNotificationRepositoryMarkSuccessCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNotificationRepositoryMarkSuccessCall) DoAndReturn(f func(context.Context, domain.Notification, []domain.InboxMessage) error) *MockNotificationRepositoryMarkSuccessCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Resend mocks base method.
+func (m *MockNotificationRepository) Resend(ctx context.Context, failed, next domain.Notification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resend", ctx, failed, next)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Resend indicates an expected call of Resend.
+func (mr *MockNotificationRepositoryMockRecorder) Resend(ctx, failed, next any) *MockNotificationRepositoryResendCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resend", reflect.TypeOf((*MockNotificationRepository)(nil).Resend), ctx, failed, next)
+	return &MockNotificationRepositoryResendCall{Call: call}
+}
+
+// MockNotificationRepositoryResendCall wrap *gomock.Call
+type MockNotificationRepositoryResendCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNotificationRepositoryResendCall) Return(arg0 error) *MockNotificationRepositoryResendCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNotificationRepositoryResendCall) Do(f func(context.Context, domain.Notification, domain.Notification) error) *MockNotificationRepositoryResendCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNotificationRepositoryResendCall) DoAndReturn(f func(context.Context, domain.Notification, domain.Notification) error) *MockNotificationRepositoryResendCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
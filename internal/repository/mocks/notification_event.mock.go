@@ -0,0 +1,196 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./notification_event.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./notification_event.go -destination=./mocks/notification_event.mock.go -package=repositorymocks -typed NotificationEventRepository
+//
+
+// Package repositorymocks is a generated GoMock package.
+package repositorymocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/robinlg/notification-platform/internal/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockNotificationEventRepository is a mock of NotificationEventRepository interface.
+type MockNotificationEventRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationEventRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockNotificationEventRepositoryMockRecorder is the mock recorder for MockNotificationEventRepository.
+type MockNotificationEventRepositoryMockRecorder struct {
+	mock *MockNotificationEventRepository
+}
+
+// NewMockNotificationEventRepository creates a new mock instance.
+func NewMockNotificationEventRepository(ctrl *gomock.Controller) *MockNotificationEventRepository {
+	mock := &MockNotificationEventRepository{ctrl: ctrl}
+	mock.recorder = &MockNotificationEventRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotificationEventRepository) EXPECT() *MockNotificationEventRepositoryMockRecorder {
+	return m.recorder
+}
+
+// BatchCreate mocks base method.
+func (m *MockNotificationEventRepository) BatchCreate(ctx context.Context, events []domain.NotificationEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchCreate", ctx, events)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BatchCreate indicates an expected call of BatchCreate.
+func (mr *MockNotificationEventRepositoryMockRecorder) BatchCreate(ctx, events any) *MockNotificationEventRepositoryBatchCreateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchCreate", reflect.TypeOf((*MockNotificationEventRepository)(nil).BatchCreate), ctx, events)
+	return &MockNotificationEventRepositoryBatchCreateCall{Call: call}
+}
+
+// MockNotificationEventRepositoryBatchCreateCall wrap *gomock.Call
+type MockNotificationEventRepositoryBatchCreateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNotificationEventRepositoryBatchCreateCall) Return(arg0 error) *MockNotificationEventRepositoryBatchCreateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNotificationEventRepositoryBatchCreateCall) Do(f func(context.Context, []domain.NotificationEvent) error) *MockNotificationEventRepositoryBatchCreateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNotificationEventRepositoryBatchCreateCall) DoAndReturn(f func(context.Context, []domain.NotificationEvent) error) *MockNotificationEventRepositoryBatchCreateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindByNotificationID mocks base method.
+func (m *MockNotificationEventRepository) FindByNotificationID(ctx context.Context, notificationID uint64, limit int) ([]domain.NotificationEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByNotificationID", ctx, notificationID, limit)
+	ret0, _ := ret[0].([]domain.NotificationEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByNotificationID indicates an expected call of FindByNotificationID.
+func (mr *MockNotificationEventRepositoryMockRecorder) FindByNotificationID(ctx, notificationID, limit any) *MockNotificationEventRepositoryFindByNotificationIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByNotificationID", reflect.TypeOf((*MockNotificationEventRepository)(nil).FindByNotificationID), ctx, notificationID, limit)
+	return &MockNotificationEventRepositoryFindByNotificationIDCall{Call: call}
+}
+
+// MockNotificationEventRepositoryFindByNotificationIDCall wrap *gomock.Call
+type MockNotificationEventRepositoryFindByNotificationIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNotificationEventRepositoryFindByNotificationIDCall) Return(arg0 []domain.NotificationEvent, arg1 error) *MockNotificationEventRepositoryFindByNotificationIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNotificationEventRepositoryFindByNotificationIDCall) Do(f func(context.Context, uint64, int) ([]domain.NotificationEvent, error)) *MockNotificationEventRepositoryFindByNotificationIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNotificationEventRepositoryFindByNotificationIDCall) DoAndReturn(f func(context.Context, uint64, int) ([]domain.NotificationEvent, error)) *MockNotificationEventRepositoryFindByNotificationIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindUnpublishedStatusEvents mocks base method.
+func (m *MockNotificationEventRepository) FindUnpublishedStatusEvents(ctx context.Context, limit int) ([]domain.NotificationStatusEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindUnpublishedStatusEvents", ctx, limit)
+	ret0, _ := ret[0].([]domain.NotificationStatusEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindUnpublishedStatusEvents indicates an expected call of FindUnpublishedStatusEvents.
+func (mr *MockNotificationEventRepositoryMockRecorder) FindUnpublishedStatusEvents(ctx, limit any) *MockNotificationEventRepositoryFindUnpublishedStatusEventsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindUnpublishedStatusEvents", reflect.TypeOf((*MockNotificationEventRepository)(nil).FindUnpublishedStatusEvents), ctx, limit)
+	return &MockNotificationEventRepositoryFindUnpublishedStatusEventsCall{Call: call}
+}
+
+// MockNotificationEventRepositoryFindUnpublishedStatusEventsCall wrap *gomock.Call
+type MockNotificationEventRepositoryFindUnpublishedStatusEventsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNotificationEventRepositoryFindUnpublishedStatusEventsCall) Return(arg0 []domain.NotificationStatusEvent, arg1 error) *MockNotificationEventRepositoryFindUnpublishedStatusEventsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNotificationEventRepositoryFindUnpublishedStatusEventsCall) Do(f func(context.Context, int) ([]domain.NotificationStatusEvent, error)) *MockNotificationEventRepositoryFindUnpublishedStatusEventsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNotificationEventRepositoryFindUnpublishedStatusEventsCall) DoAndReturn(f func(context.Context, int) ([]domain.NotificationStatusEvent, error)) *MockNotificationEventRepositoryFindUnpublishedStatusEventsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MarkPublished mocks base method.
+func (m *MockNotificationEventRepository) MarkPublished(ctx context.Context, eventIDs []int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkPublished", ctx, eventIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkPublished indicates an expected call of MarkPublished.
+func (mr *MockNotificationEventRepositoryMockRecorder) MarkPublished(ctx, eventIDs any) *MockNotificationEventRepositoryMarkPublishedCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkPublished", reflect.TypeOf((*MockNotificationEventRepository)(nil).MarkPublished), ctx, eventIDs)
+	return &MockNotificationEventRepositoryMarkPublishedCall{Call: call}
+}
+
+// MockNotificationEventRepositoryMarkPublishedCall wrap *gomock.Call
+type MockNotificationEventRepositoryMarkPublishedCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNotificationEventRepositoryMarkPublishedCall) Return(arg0 error) *MockNotificationEventRepositoryMarkPublishedCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNotificationEventRepositoryMarkPublishedCall) Do(f func(context.Context, []int64) error) *MockNotificationEventRepositoryMarkPublishedCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNotificationEventRepositoryMarkPublishedCall) DoAndReturn(f func(context.Context, []int64) error) *MockNotificationEventRepositoryMarkPublishedCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,158 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./quota.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./quota.go -destination=./mocks/quota.mock.go -package=repositorymocks -typed QuotaRepository
+//
+
+// Package repositorymocks is a generated GoMock package.
+package repositorymocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/robinlg/notification-platform/internal/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockQuotaRepository is a mock of QuotaRepository interface.
+type MockQuotaRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockQuotaRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockQuotaRepositoryMockRecorder is the mock recorder for MockQuotaRepository.
+type MockQuotaRepositoryMockRecorder struct {
+	mock *MockQuotaRepository
+}
+
+// NewMockQuotaRepository creates a new mock instance.
+func NewMockQuotaRepository(ctrl *gomock.Controller) *MockQuotaRepository {
+	mock := &MockQuotaRepository{ctrl: ctrl}
+	mock.recorder = &MockQuotaRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockQuotaRepository) EXPECT() *MockQuotaRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Adjust mocks base method.
+func (m *MockQuotaRepository) Adjust(ctx context.Context, ledger domain.QuotaLedger) (domain.QuotaRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Adjust", ctx, ledger)
+	ret0, _ := ret[0].(domain.QuotaRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Adjust indicates an expected call of Adjust.
+func (mr *MockQuotaRepositoryMockRecorder) Adjust(ctx, ledger any) *MockQuotaRepositoryAdjustCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Adjust", reflect.TypeOf((*MockQuotaRepository)(nil).Adjust), ctx, ledger)
+	return &MockQuotaRepositoryAdjustCall{Call: call}
+}
+
+// MockQuotaRepositoryAdjustCall wrap *gomock.Call
+type MockQuotaRepositoryAdjustCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockQuotaRepositoryAdjustCall) Return(arg0 domain.QuotaRecord, arg1 error) *MockQuotaRepositoryAdjustCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockQuotaRepositoryAdjustCall) Do(f func(context.Context, domain.QuotaLedger) (domain.QuotaRecord, error)) *MockQuotaRepositoryAdjustCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockQuotaRepositoryAdjustCall) DoAndReturn(f func(context.Context, domain.QuotaLedger) (domain.QuotaRecord, error)) *MockQuotaRepositoryAdjustCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindByBizID mocks base method.
+func (m *MockQuotaRepository) FindByBizID(ctx context.Context, bizID int64) ([]domain.QuotaRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByBizID", ctx, bizID)
+	ret0, _ := ret[0].([]domain.QuotaRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByBizID indicates an expected call of FindByBizID.
+func (mr *MockQuotaRepositoryMockRecorder) FindByBizID(ctx, bizID any) *MockQuotaRepositoryFindByBizIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByBizID", reflect.TypeOf((*MockQuotaRepository)(nil).FindByBizID), ctx, bizID)
+	return &MockQuotaRepositoryFindByBizIDCall{Call: call}
+}
+
+// MockQuotaRepositoryFindByBizIDCall wrap *gomock.Call
+type MockQuotaRepositoryFindByBizIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockQuotaRepositoryFindByBizIDCall) Return(arg0 []domain.QuotaRecord, arg1 error) *MockQuotaRepositoryFindByBizIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockQuotaRepositoryFindByBizIDCall) Do(f func(context.Context, int64) ([]domain.QuotaRecord, error)) *MockQuotaRepositoryFindByBizIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockQuotaRepositoryFindByBizIDCall) DoAndReturn(f func(context.Context, int64) ([]domain.QuotaRecord, error)) *MockQuotaRepositoryFindByBizIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Save mocks base method.
+func (m *MockQuotaRepository) Save(ctx context.Context, record domain.QuotaRecord, ledger domain.QuotaLedger) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, record, ledger)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockQuotaRepositoryMockRecorder) Save(ctx, record, ledger any) *MockQuotaRepositorySaveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockQuotaRepository)(nil).Save), ctx, record, ledger)
+	return &MockQuotaRepositorySaveCall{Call: call}
+}
+
+// MockQuotaRepositorySaveCall wrap *gomock.Call
+type MockQuotaRepositorySaveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockQuotaRepositorySaveCall) Return(arg0 error) *MockQuotaRepositorySaveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockQuotaRepositorySaveCall) Do(f func(context.Context, domain.QuotaRecord, domain.QuotaLedger) error) *MockQuotaRepositorySaveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockQuotaRepositorySaveCall) DoAndReturn(f func(context.Context, domain.QuotaRecord, domain.QuotaLedger) error) *MockQuotaRepositorySaveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,196 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./tx_notification.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./tx_notification.go -destination=./mocks/tx_notification.mock.go -package=repositorymocks -typed TxNotificationRepository
+//
+
+// Package repositorymocks is a generated GoMock package.
+package repositorymocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/robinlg/notification-platform/internal/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTxNotificationRepository is a mock of TxNotificationRepository interface.
+type MockTxNotificationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTxNotificationRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockTxNotificationRepositoryMockRecorder is the mock recorder for MockTxNotificationRepository.
+type MockTxNotificationRepositoryMockRecorder struct {
+	mock *MockTxNotificationRepository
+}
+
+// NewMockTxNotificationRepository creates a new mock instance.
+func NewMockTxNotificationRepository(ctrl *gomock.Controller) *MockTxNotificationRepository {
+	mock := &MockTxNotificationRepository{ctrl: ctrl}
+	mock.recorder = &MockTxNotificationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTxNotificationRepository) EXPECT() *MockTxNotificationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockTxNotificationRepository) Create(ctx context.Context, notification domain.TxNotification) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, notification)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockTxNotificationRepositoryMockRecorder) Create(ctx, notification any) *MockTxNotificationRepositoryCreateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTxNotificationRepository)(nil).Create), ctx, notification)
+	return &MockTxNotificationRepositoryCreateCall{Call: call}
+}
+
+// MockTxNotificationRepositoryCreateCall wrap *gomock.Call
+type MockTxNotificationRepositoryCreateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTxNotificationRepositoryCreateCall) Return(arg0 uint64, arg1 error) *MockTxNotificationRepositoryCreateCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTxNotificationRepositoryCreateCall) Do(f func(context.Context, domain.TxNotification) (uint64, error)) *MockTxNotificationRepositoryCreateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTxNotificationRepositoryCreateCall) DoAndReturn(f func(context.Context, domain.TxNotification) (uint64, error)) *MockTxNotificationRepositoryCreateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindCheckBack mocks base method.
+func (m *MockTxNotificationRepository) FindCheckBack(ctx context.Context, offset, limit int) ([]domain.TxNotification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindCheckBack", ctx, offset, limit)
+	ret0, _ := ret[0].([]domain.TxNotification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindCheckBack indicates an expected call of FindCheckBack.
+func (mr *MockTxNotificationRepositoryMockRecorder) FindCheckBack(ctx, offset, limit any) *MockTxNotificationRepositoryFindCheckBackCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindCheckBack", reflect.TypeOf((*MockTxNotificationRepository)(nil).FindCheckBack), ctx, offset, limit)
+	return &MockTxNotificationRepositoryFindCheckBackCall{Call: call}
+}
+
+// MockTxNotificationRepositoryFindCheckBackCall wrap *gomock.Call
+type MockTxNotificationRepositoryFindCheckBackCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTxNotificationRepositoryFindCheckBackCall) Return(arg0 []domain.TxNotification, arg1 error) *MockTxNotificationRepositoryFindCheckBackCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTxNotificationRepositoryFindCheckBackCall) Do(f func(context.Context, int, int) ([]domain.TxNotification, error)) *MockTxNotificationRepositoryFindCheckBackCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTxNotificationRepositoryFindCheckBackCall) DoAndReturn(f func(context.Context, int, int) ([]domain.TxNotification, error)) *MockTxNotificationRepositoryFindCheckBackCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateCheckStatus mocks base method.
+func (m *MockTxNotificationRepository) UpdateCheckStatus(ctx context.Context, txNotifications []domain.TxNotification, notificationStatus domain.SendStatus) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCheckStatus", ctx, txNotifications, notificationStatus)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCheckStatus indicates an expected call of UpdateCheckStatus.
+func (mr *MockTxNotificationRepositoryMockRecorder) UpdateCheckStatus(ctx, txNotifications, notificationStatus any) *MockTxNotificationRepositoryUpdateCheckStatusCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCheckStatus", reflect.TypeOf((*MockTxNotificationRepository)(nil).UpdateCheckStatus), ctx, txNotifications, notificationStatus)
+	return &MockTxNotificationRepositoryUpdateCheckStatusCall{Call: call}
+}
+
+// MockTxNotificationRepositoryUpdateCheckStatusCall wrap *gomock.Call
+type MockTxNotificationRepositoryUpdateCheckStatusCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTxNotificationRepositoryUpdateCheckStatusCall) Return(arg0 error) *MockTxNotificationRepositoryUpdateCheckStatusCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTxNotificationRepositoryUpdateCheckStatusCall) Do(f func(context.Context, []domain.TxNotification, domain.SendStatus) error) *MockTxNotificationRepositoryUpdateCheckStatusCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTxNotificationRepositoryUpdateCheckStatusCall) DoAndReturn(f func(context.Context, []domain.TxNotification, domain.SendStatus) error) *MockTxNotificationRepositoryUpdateCheckStatusCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateStatus mocks base method.
+func (m *MockTxNotificationRepository) UpdateStatus(ctx context.Context, bizID int64, key string, status domain.TxNotificationStatus, notificationStatus domain.SendStatus) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatus", ctx, bizID, key, status, notificationStatus)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockTxNotificationRepositoryMockRecorder) UpdateStatus(ctx, bizID, key, status, notificationStatus any) *MockTxNotificationRepositoryUpdateStatusCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockTxNotificationRepository)(nil).UpdateStatus), ctx, bizID, key, status, notificationStatus)
+	return &MockTxNotificationRepositoryUpdateStatusCall{Call: call}
+}
+
+// MockTxNotificationRepositoryUpdateStatusCall wrap *gomock.Call
+type MockTxNotificationRepositoryUpdateStatusCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTxNotificationRepositoryUpdateStatusCall) Return(arg0 error) *MockTxNotificationRepositoryUpdateStatusCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTxNotificationRepositoryUpdateStatusCall) Do(f func(context.Context, int64, string, domain.TxNotificationStatus, domain.SendStatus) error) *MockTxNotificationRepositoryUpdateStatusCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTxNotificationRepositoryUpdateStatusCall) DoAndReturn(f func(context.Context, int64, string, domain.TxNotificationStatus, domain.SendStatus) error) *MockTxNotificationRepositoryUpdateStatusCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
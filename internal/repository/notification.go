@@ -14,6 +14,8 @@ import (
 )
 
 // NotificationRepository 通知仓储接口
+//
+//go:generate mockgen -source=./notification.go -destination=./mocks/notification.mock.go -package=repositorymocks -typed NotificationRepository
 type NotificationRepository interface {
 	// Create 创建单条通知记录，但不创建对应的回调记录
 	Create(ctx context.Context, notification domain.Notification) (domain.Notification, error)
@@ -23,20 +25,52 @@ type NotificationRepository interface {
 	BatchCreate(ctx context.Context, notifications []domain.Notification) ([]domain.Notification, error)
 	// BatchCreateWithCallbackLog 批量创建通知记录，同时创建对应的回调记录
 	BatchCreateWithCallbackLog(ctx context.Context, notifications []domain.Notification) ([]domain.Notification, error)
-	// MarkSuccess 标记通知为成功
-	MarkSuccess(ctx context.Context, entity domain.Notification) error
-	// MarkFailed 标记通知为失败
+	// CASMarkSending 使用乐观锁将等待发送的通知标记为发送中，返回实际标记成功的通知
+	CASMarkSending(ctx context.Context, notifications []domain.Notification) ([]domain.Notification, error)
+	// MarkSuccess 使用乐观锁将发送中的通知标记为成功，inbox 为站内信渠道生成的站内信，和通知状态在同一个事务中写入
+	// 以下三个方法在通知已经不是发送中或者版本号不一致时都返回 errs.ErrNotificationVersionMismatch
+	MarkSuccess(ctx context.Context, entity domain.Notification, inbox []domain.InboxMessage) error
+	// MarkFailed 使用乐观锁将发送中的通知标记为失败，同时归还额度
 	MarkFailed(ctx context.Context, notification domain.Notification) error
+	// MarkRetry 发送失败但还可以重试，通知回到待发送状态等待调度器再次发送，不归还额度
+	MarkRetry(ctx context.Context, notification domain.Notification) error
+	// BatchMarkRetry 使用乐观锁批量标记发送中的通知等待重试，不归还额度，返回实际标记成功的通知
+	BatchMarkRetry(ctx context.Context, notifications []domain.Notification) ([]domain.Notification, error)
 	// BatchGetByIDs 根据ID列表获取通知列表
 	BatchGetByIDs(ctx context.Context, ids []uint64) (map[uint64]domain.Notification, error)
 	// GetByKey 根据业务ID和业务内唯一标识获取通知
 	GetByKey(ctx context.Context, bizID int64, key string) (domain.Notification, error)
+	// BatchGetByKeys 根据业务ID和业务内唯一标识批量获取通知，返回以 key 为键的映射
+	BatchGetByKeys(ctx context.Context, bizID int64, keys []string) (map[string]domain.Notification, error)
 	// CASStatus 更新通知状态
 	CASStatus(ctx context.Context, notification domain.Notification) error
-	// BatchUpdateStatusSucceededOrFailed 批量更新通知状态为成功或失败
-	BatchUpdateStatusSucceededOrFailed(ctx context.Context, succeededNotifications, failedNotifications []domain.Notification) error
-	// FindReadyNotifications 准备好调度发送的通知
-	FindReadyNotifications(ctx context.Context, offset int, limit int) ([]domain.Notification, error)
+	// BatchUpdateStatusSucceededOrFailed 使用乐观锁批量更新发送中的通知状态为成功或失败，inbox 为发送成功的站内信通知生成的站内信
+	// 返回实际更新成功的通知，只有实际标记为失败的通知才会归还额度
+	BatchUpdateStatusSucceededOrFailed(ctx context.Context, succeededNotifications, failedNotifications []domain.Notification, inbox []domain.InboxMessage) (succeeded, failed []domain.Notification, err error)
+	// FindReadyNotifications 准备好调度发送的指定分区、指定优先级的通知
+	FindReadyNotifications(ctx context.Context, partition domain.Partition, priority domain.Priority, offset int, limit int) ([]domain.Notification, error)
+	// BackfillHashSlot 为引入分区之前创建的通知回填 hash_slot，每次处理 ID 大于 startID 的 limit 条，返回下一批的起始ID，处理完时返回 0
+	BackfillHashSlot(ctx context.Context, startID uint64, limit int) (uint64, error)
+	// FindTimeoutSending 查找在 SENDING 状态停留超过 timeout 的通知
+	FindTimeoutSending(ctx context.Context, timeout time.Duration, limit int) ([]domain.Notification, error)
+	// CASRecoverSending 使用乐观锁修正 SENDING 状态的通知，修正为 FAILED 时归还额度
+	CASRecoverSending(ctx context.Context, notification domain.Notification) error
+	// FindExpiredPending 查找已经超出计划发送时间仍处于 PENDING 状态的通知
+	FindExpiredPending(ctx context.Context, limit int) ([]domain.Notification, error)
+	// CASMarkExpired 使用乐观锁将通知标记为已过期并归还额度，返回实际标记成功的通知
+	CASMarkExpired(ctx context.Context, notifications []domain.Notification) ([]domain.Notification, error)
+	// CountUsedQuota 统计占用额度的时间在 [start, end) 内的通知数量，占用额度的时间为创建或者最近一次重新发送的时间
+	CountUsedQuota(ctx context.Context, bizID int64, channel domain.Channel, start, end time.Time) (int64, error)
+	// CASCancel 使用乐观锁将等待发送的通知标记为已取消并归还额度
+	CASCancel(ctx context.Context, notification domain.Notification) error
+	// CASUpdatePending 使用乐观锁修改等待发送的通知的计划发送时间和模版参数
+	CASUpdatePending(ctx context.Context, notification domain.Notification) error
+	// Resend 重新扣减额度，并使用乐观锁将发送失败的通知更新为 next 的状态，同时保存上一次的发送记录
+	Resend(ctx context.Context, failed, next domain.Notification) error
+	// List 按照 ID 倒序查询符合过滤条件的通知，只返回 ID 小于 cursor 的通知，cursor 为 0 时从最新的通知开始
+	List(ctx context.Context, filter domain.NotificationFilter, cursor uint64, limit int) ([]domain.Notification, error)
+	// BackfillReceivers 为引入接收者表之前创建的通知回填接收者，每次处理 ID 大于 startID 的 limit 条，返回下一批的起始ID，处理完时返回 0
+	BackfillReceivers(ctx context.Context, startID uint64, limit int) (uint64, error)
 }
 
 const (
@@ -187,6 +221,7 @@ func (r *notificationRepository) CreateWithCallbackLog(ctx context.Context, noti
 func (r *notificationRepository) toEntity(notification domain.Notification) dao.Notification {
 	templateParams, _ := notification.MarshalTemplateParams()
 	receivers, _ := notification.MarshalReceivers()
+	fallbacks, _ := notification.MarshalFallbacks()
 	return dao.Notification{
 		ID:                notification.ID,
 		BizID:             notification.BizID,
@@ -200,6 +235,13 @@ func (r *notificationRepository) toEntity(notification domain.Notification) dao.
 		ScheduledSTime:    notification.ScheduledSTime.UnixMilli(),
 		ScheduledETime:    notification.ScheduledETime.UnixMilli(),
 		Version:           notification.Version,
+		Fallbacks:         fallbacks,
+		DeliveredChannel:  notification.DeliveredChannel.String(),
+		RetryCount:        notification.RetryCount,
+		NextRetryTime:     notification.NextRetryTime,
+		StatusReason:      notification.StatusReason,
+		Priority:          int32(notification.Priority),
+		HashSlot:          notification.HashSlot(),
 	}
 }
 
@@ -211,6 +253,11 @@ func (r *notificationRepository) toDomain(n dao.Notification) domain.Notificatio
 	var receivers []string
 	_ = json.Unmarshal([]byte(n.Receivers), &receivers)
 
+	var fallbacks []domain.FallbackTarget
+	if n.Fallbacks != "" {
+		_ = json.Unmarshal([]byte(n.Fallbacks), &fallbacks)
+	}
+
 	return domain.Notification{
 		ID:        n.ID,
 		BizID:     n.BizID,
@@ -222,15 +269,37 @@ func (r *notificationRepository) toDomain(n dao.Notification) domain.Notificatio
 			VersionID: n.TemplateVersionID,
 			Params:    templateParams,
 		},
-		Status:         domain.SendStatus(n.Status),
-		ScheduledSTime: time.UnixMilli(n.ScheduledSTime),
-		ScheduledETime: time.UnixMilli(n.ScheduledETime),
-		Version:        n.Version,
+		Status:           domain.SendStatus(n.Status),
+		ScheduledSTime:   time.UnixMilli(n.ScheduledSTime),
+		ScheduledETime:   time.UnixMilli(n.ScheduledETime),
+		Version:          n.Version,
+		Fallbacks:        fallbacks,
+		DeliveredChannel: domain.Channel(n.DeliveredChannel),
+		RetryCount:       n.RetryCount,
+		NextRetryTime:    n.NextRetryTime,
+		StatusReason:     n.StatusReason,
+		Priority:         domain.Priority(n.Priority),
+		Ctime:            time.UnixMilli(n.Ctime),
+		Utime:            time.UnixMilli(dao.UtimeMilli(n.Utime)),
 	}
 }
 
-func (r *notificationRepository) MarkSuccess(ctx context.Context, notification domain.Notification) error {
-	return r.dao.MarkSuccess(ctx, r.toEntity(notification))
+func (r *notificationRepository) CASMarkSending(ctx context.Context, notifications []domain.Notification) ([]domain.Notification, error) {
+	claimed, err := r.dao.CASMarkSending(ctx, slice.Map(notifications, func(_ int, src domain.Notification) dao.Notification {
+		return r.toEntity(src)
+	}))
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(claimed, func(_ int, src dao.Notification) domain.Notification {
+		return r.toDomain(src)
+	}), nil
+}
+
+func (r *notificationRepository) MarkSuccess(ctx context.Context, notification domain.Notification, inbox []domain.InboxMessage) error {
+	return r.dao.MarkSuccess(ctx, r.toEntity(notification), slice.Map(inbox, func(_ int, src domain.InboxMessage) dao.InboxMessage {
+		return toInboxEntity(src)
+	}))
 }
 
 func (r *notificationRepository) MarkFailed(ctx context.Context, notification domain.Notification) error {
@@ -241,6 +310,35 @@ func (r *notificationRepository) MarkFailed(ctx context.Context, notification do
 	return r.quotaCache.Incr(ctx, notification.BizID, notification.Channel, defaultQuotaNumber)
 }
 
+func (r *notificationRepository) MarkRetry(ctx context.Context, notification domain.Notification) error {
+	return r.dao.MarkRetry(ctx, r.toEntity(notification))
+}
+
+func (r *notificationRepository) BatchMarkRetry(ctx context.Context, notifications []domain.Notification) ([]domain.Notification, error) {
+	retried, err := r.dao.BatchMarkRetry(ctx, slice.Map(notifications, func(_ int, src domain.Notification) dao.Notification {
+		return r.toEntity(src)
+	}))
+	if err != nil {
+		return nil, err
+	}
+	return r.filterByIDs(notifications, retried), nil
+}
+
+// filterByIDs 找出 updated 中的通知对应的领域对象，领域对象中有一些字段不会保存到数据库
+func (r *notificationRepository) filterByIDs(notifications []domain.Notification, updated []dao.Notification) []domain.Notification {
+	ids := make(map[uint64]struct{}, len(updated))
+	for i := range updated {
+		ids[updated[i].ID] = struct{}{}
+	}
+	res := make([]domain.Notification, 0, len(updated))
+	for i := range notifications {
+		if _, ok := ids[notifications[i].ID]; ok {
+			res = append(res, notifications[i])
+		}
+	}
+	return res
+}
+
 func (r *notificationRepository) BatchGetByIDs(ctx context.Context, ids []uint64) (map[uint64]domain.Notification, error) {
 	//
 	notificationMap, err := r.dao.BatchGetByIDs(ctx, ids)
@@ -260,13 +358,25 @@ func (r *notificationRepository) GetByKey(ctx context.Context, bizID int64, key
 	return r.toDomain(not), err
 }
 
+func (r *notificationRepository) BatchGetByKeys(ctx context.Context, bizID int64, keys []string) (map[string]domain.Notification, error) {
+	notifications, err := r.dao.BatchGetByKeys(ctx, bizID, keys)
+	if err != nil {
+		return nil, err
+	}
+	domainNotificationMap := make(map[string]domain.Notification, len(notifications))
+	for i := range notifications {
+		domainNotificationMap[notifications[i].Key] = r.toDomain(notifications[i])
+	}
+	return domainNotificationMap, nil
+}
+
 // CASStatus 更新通知状态
 func (r *notificationRepository) CASStatus(ctx context.Context, notification domain.Notification) error {
 	return r.dao.CASStatus(ctx, r.toEntity(notification))
 }
 
 // BatchUpdateStatusSucceededOrFailed 批量更新通知状态为成功或失败
-func (r *notificationRepository) BatchUpdateStatusSucceededOrFailed(ctx context.Context, succeededNotifications, failedNotifications []domain.Notification) error {
+func (r *notificationRepository) BatchUpdateStatusSucceededOrFailed(ctx context.Context, succeededNotifications, failedNotifications []domain.Notification, inbox []domain.InboxMessage) (succeeded, failed []domain.Notification, err error) {
 	// 转换成功的通知为DAO层的实体
 	successItems := make([]dao.Notification, len(succeededNotifications))
 	for i := range succeededNotifications {
@@ -279,22 +389,156 @@ func (r *notificationRepository) BatchUpdateStatusSucceededOrFailed(ctx context.
 		failedItems[i] = r.toEntity(failedNotifications[i])
 	}
 
-	err := r.dao.BatchUpdateStatusSucceededOrFailed(ctx, successItems, failedItems)
+	updatedSuccess, updatedFailed, err := r.dao.BatchUpdateStatusSucceededOrFailed(ctx, successItems, failedItems, slice.Map(inbox, func(_ int, src domain.InboxMessage) dao.InboxMessage {
+		return toInboxEntity(src)
+	}))
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
+	succeeded = withStatus(r.filterByIDs(succeededNotifications, updatedSuccess), domain.SendStatusSucceeded)
+	failed = withStatus(r.filterByIDs(failedNotifications, updatedFailed), domain.SendStatusFailed)
 
-	items := r.getItems(failedNotifications)
-	eerr := r.quotaCache.MutiIncr(ctx, items)
+	if len(failed) == 0 {
+		return succeeded, failed, nil
+	}
+	// 状态已经被其他流程修改的通知由修改的一方归还额度，这里只归还实际标记为失败的
+	eerr := r.quotaCache.MutiIncr(ctx, r.getItems(failed))
 	if eerr != nil {
 		elog.Error("发送失败，归还额度失败", elog.FieldErr(eerr))
 	}
-	return nil
+	return succeeded, failed, nil
+}
+
+func (r *notificationRepository) FindReadyNotifications(ctx context.Context, partition domain.Partition, priority domain.Priority, offset, limit int) ([]domain.Notification, error) {
+	nos, err := r.dao.FindReadyNotifications(ctx, int32(priority), partition.SlotStart, partition.SlotEnd, offset, limit)
+	return slice.Map(nos, func(_ int, src dao.Notification) domain.Notification {
+		return r.toDomain(src)
+	}), err
+}
+
+func (r *notificationRepository) BackfillHashSlot(ctx context.Context, startID uint64, limit int) (uint64, error) {
+	return r.dao.BackfillHashSlot(ctx, startID, limit)
+}
+
+func (r *notificationRepository) BackfillReceivers(ctx context.Context, startID uint64, limit int) (uint64, error) {
+	return r.dao.BackfillReceivers(ctx, startID, limit)
+}
+
+func (r *notificationRepository) FindTimeoutSending(ctx context.Context, timeout time.Duration, limit int) ([]domain.Notification, error) {
+	nos, err := r.dao.FindTimeoutSending(ctx, time.Now().Add(-timeout).UnixMilli(), limit)
+	return slice.Map(nos, func(_ int, src dao.Notification) domain.Notification {
+		return r.toDomain(src)
+	}), err
+}
+
+func (r *notificationRepository) CASRecoverSending(ctx context.Context, notification domain.Notification) error {
+	err := r.dao.CASRecoverSending(ctx, r.toEntity(notification))
+	if err != nil {
+		return err
+	}
+	if notification.Status != domain.SendStatusFailed {
+		return nil
+	}
+	return r.quotaCache.Incr(ctx, notification.BizID, notification.Channel, defaultQuotaNumber)
 }
 
-func (r *notificationRepository) FindReadyNotifications(ctx context.Context, offset, limit int) ([]domain.Notification, error) {
-	nos, err := r.dao.FindReadyNotifications(ctx, offset, limit)
+func (r *notificationRepository) FindExpiredPending(ctx context.Context, limit int) ([]domain.Notification, error) {
+	nos, err := r.dao.FindExpiredPending(ctx, time.Now().UnixMilli(), limit)
 	return slice.Map(nos, func(_ int, src dao.Notification) domain.Notification {
 		return r.toDomain(src)
 	}), err
 }
+
+func (r *notificationRepository) CASMarkExpired(ctx context.Context, notifications []domain.Notification) ([]domain.Notification, error) {
+	expired, err := r.dao.CASMarkExpired(ctx, slice.Map(notifications, func(_ int, src domain.Notification) dao.Notification {
+		return r.toEntity(src)
+	}))
+	if err != nil {
+		return nil, err
+	}
+	res := slice.Map(expired, func(_ int, src dao.Notification) domain.Notification {
+		n := r.toDomain(src)
+		n.Status = domain.SendStatusExpired
+		return n
+	})
+	if len(res) == 0 {
+		return res, nil
+	}
+	eerr := r.quotaCache.MutiIncr(ctx, r.getItems(res))
+	if eerr != nil {
+		r.logger.Error("通知过期，归还额度失败", elog.FieldErr(eerr))
+	}
+	return res, nil
+}
+
+func (r *notificationRepository) CountUsedQuota(ctx context.Context, bizID int64, channel domain.Channel, start, end time.Time) (int64, error) {
+	return r.dao.CountUsedQuota(ctx, bizID, channel.String(), start.UnixMilli(), end.UnixMilli())
+}
+
+func (r *notificationRepository) CASCancel(ctx context.Context, notification domain.Notification) error {
+	err := r.dao.CASCancel(ctx, r.toEntity(notification))
+	if err != nil {
+		return err
+	}
+	err = r.quotaCache.Incr(ctx, notification.BizID, notification.Channel, defaultQuotaNumber)
+	if err != nil {
+		r.logger.Error("通知取消，归还额度失败", elog.FieldErr(err),
+			elog.Int64("biz_id", notification.BizID),
+			elog.String("channel", notification.Channel.String()),
+		)
+	}
+	return nil
+}
+
+func (r *notificationRepository) CASUpdatePending(ctx context.Context, notification domain.Notification) error {
+	return r.dao.CASUpdatePending(ctx, r.toEntity(notification))
+}
+
+func (r *notificationRepository) Resend(ctx context.Context, failed, next domain.Notification) error {
+	// 发送失败时已经归还了额度，重新发送需要重新扣减
+	err := r.quotaCache.Decr(ctx, next.BizID, next.Channel, defaultQuotaNumber)
+	if err != nil {
+		return err
+	}
+	entity := r.toEntity(failed)
+	// 作为上一次发送得到最终状态的时间
+	entity.Utime = failed.Utime.UnixMilli()
+	err = r.dao.Resend(ctx, entity, r.toEntity(next))
+	if err != nil {
+		qerr := r.quotaCache.Incr(ctx, next.BizID, next.Channel, defaultQuotaNumber)
+		if qerr != nil {
+			r.logger.Error("额度归还失败", elog.FieldErr(qerr),
+				elog.Int64("biz_id", next.BizID),
+				elog.String("channel", next.Channel.String()),
+			)
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *notificationRepository) List(ctx context.Context, filter domain.NotificationFilter, cursor uint64, limit int) ([]domain.Notification, error) {
+	entities, err := r.dao.List(ctx, dao.NotificationFilter{
+		BizID:      filter.BizID,
+		Status:     filter.Status.String(),
+		Channel:    filter.Channel.String(),
+		TemplateID: filter.TemplateID,
+		Receiver:   filter.Receiver,
+		StartTime:  filter.StartTime,
+		EndTime:    filter.EndTime,
+	}, cursor, limit)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(entities, func(_ int, src dao.Notification) domain.Notification {
+		return r.toDomain(src)
+	}), nil
+}
+
+// withStatus 复制通知列表并设置状态
+func withStatus(notifications []domain.Notification, status domain.SendStatus) []domain.Notification {
+	return slice.Map(notifications, func(_ int, src domain.Notification) domain.Notification {
+		src.Status = status
+		return src
+	})
+}
@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/ecodeclub/ekit/slice"
+	"github.com/robinlg/notification-platform/internal/domain"
+	"github.com/robinlg/notification-platform/internal/repository/dao"
+)
+
+// NotificationEventRepository 通知事件仓储接口
+//
+//go:generate mockgen -source=./notification_event.go -destination=./mocks/notification_event.mock.go -package=repositorymocks -typed NotificationEventRepository
+type NotificationEventRepository interface {
+	// BatchCreate 批量创建通知事件，超出字段长度的内容会被截断
+	BatchCreate(ctx context.Context, events []domain.NotificationEvent) error
+	// FindByNotificationID 按照发生顺序查询通知的事件，最多 limit 条
+	FindByNotificationID(ctx context.Context, notificationID uint64, limit int) ([]domain.NotificationEvent, error)
+	// FindUnpublishedStatusEvents 按照写入顺序查询还没有投递到事件流的状态变更事件，最多 limit 条
+	FindUnpublishedStatusEvents(ctx context.Context, limit int) ([]domain.NotificationStatusEvent, error)
+	// MarkPublished 将事件标记为已经投递到事件流
+	MarkPublished(ctx context.Context, eventIDs []int64) error
+}
+
+type notificationEventRepository struct {
+	dao dao.NotificationEventDAO
+}
+
+// NewNotificationEventRepository 创建通知事件仓储实例
+func NewNotificationEventRepository(d dao.NotificationEventDAO) NotificationEventRepository {
+	return &notificationEventRepository{dao: d}
+}
+
+func (r *notificationEventRepository) BatchCreate(ctx context.Context, events []domain.NotificationEvent) error {
+	return r.dao.BatchCreate(ctx, slice.Map(events, func(_ int, src domain.NotificationEvent) dao.NotificationEvent {
+		return r.toEntity(src)
+	}))
+}
+
+func (r *notificationEventRepository) FindByNotificationID(ctx context.Context, notificationID uint64, limit int) ([]domain.NotificationEvent, error) {
+	events, err := r.dao.FindByNotificationID(ctx, notificationID, limit)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(events, func(_ int, src dao.NotificationEvent) domain.NotificationEvent {
+		return r.toDomain(src)
+	}), nil
+}
+
+func (r *notificationEventRepository) FindUnpublishedStatusEvents(ctx context.Context, limit int) ([]domain.NotificationStatusEvent, error) {
+	events, err := r.dao.FindUnpublished(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(events, func(_ int, src dao.UnpublishedEvent) domain.NotificationStatusEvent {
+		return domain.NotificationStatusEvent{
+			EventID:        src.ID,
+			NotificationID: src.NotificationID,
+			BizID:          src.BizID,
+			Key:            src.Key,
+			Status:         domain.SendStatus(src.Status),
+			Ctime:          src.Ctime,
+		}
+	}), nil
+}
+
+func (r *notificationEventRepository) MarkPublished(ctx context.Context, eventIDs []int64) error {
+	return r.dao.MarkPublished(ctx, eventIDs)
+}
+
+func (r *notificationEventRepository) toEntity(e domain.NotificationEvent) dao.NotificationEvent {
+	const (
+		maxReasonLength    = 256
+		maxNameLength      = 64
+		maxRequestIDLength = 128
+	)
+	return dao.NotificationEvent{
+		NotificationID:  e.NotificationID,
+		BizID:           e.BizID,
+		Type:            e.Type.String(),
+		Status:          e.Status.String(),
+		Reason:          truncate(e.Reason, maxReasonLength),
+		Channel:         e.Channel.String(),
+		Provider:        truncate(e.Provider.Name, maxNameLength),
+		VendorRequestID: truncate(e.Provider.RequestID, maxRequestIDLength),
+		VendorCode:      truncate(e.Provider.Code, maxNameLength),
+		VendorMessage:   truncate(e.Provider.Message, maxReasonLength),
+		Latency:         e.Latency.Milliseconds(),
+		// 只有状态变更事件需要投递到事件流
+		Published: e.Type != domain.NotificationEventTypeTransition,
+		Ctime:     e.Ctime.UnixMilli(),
+	}
+}
+
+func (r *notificationEventRepository) toDomain(e dao.NotificationEvent) domain.NotificationEvent {
+	return domain.NotificationEvent{
+		ID:             e.ID,
+		NotificationID: e.NotificationID,
+		BizID:          e.BizID,
+		Type:           domain.NotificationEventType(e.Type),
+		Status:         domain.SendStatus(e.Status),
+		Reason:         e.Reason,
+		Channel:        domain.Channel(e.Channel),
+		Provider: domain.ProviderResult{
+			Name:      e.Provider,
+			RequestID: e.VendorRequestID,
+			Code:      e.VendorCode,
+			Message:   e.VendorMessage,
+		},
+		Latency: time.Duration(e.Latency) * time.Millisecond,
+		Ctime:   time.UnixMilli(e.Ctime),
+	}
+}
+
+// truncate 按照字符截断超出字段长度的内容
+func truncate(s string, maxLength int) string {
+	runes := []rune(s)
+	if len(runes) <= maxLength {
+		return s
+	}
+	return string(runes[:maxLength])
+}
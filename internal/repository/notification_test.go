@@ -0,0 +1,88 @@
+//go:build unit
+
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/robinlg/notification-platform/internal/domain"
+	"github.com/robinlg/notification-platform/internal/errs"
+	cachemocks "github.com/robinlg/notification-platform/internal/repository/cache/mocks"
+	"github.com/robinlg/notification-platform/internal/repository/dao"
+	daomocks "github.com/robinlg/notification-platform/internal/repository/dao/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestNotificationRepository_Resend(t *testing.T) {
+	t.Parallel()
+
+	finished := time.UnixMilli(1000)
+	failed := domain.Notification{
+		ID: 1, BizID: 2, Key: "key-1", Channel: domain.ChannelSMS, Status: domain.SendStatusFailed,
+		StatusReason: "供应商超时", Version: 3, Utime: finished,
+	}
+	next := failed
+	next.Status = domain.SendStatusPending
+	next.StatusReason = ""
+
+	testCases := []struct {
+		name    string
+		mock    func(d *daomocks.MockNotificationDAO, quotaCache *cachemocks.MockQuotaCache)
+		wantErr error
+	}{
+		{
+			// 发送失败时已经归还了额度，重新发送需要重新扣减
+			name: "重新扣减额度",
+			mock: func(d *daomocks.MockNotificationDAO, quotaCache *cachemocks.MockQuotaCache) {
+				gomock.InOrder(
+					quotaCache.EXPECT().Decr(gomock.Any(), int64(2), domain.ChannelSMS, int32(1)).Return(nil),
+					d.EXPECT().Resend(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, f, n dao.Notification) error {
+						// 上一次发送的记录使用失败时的更新时间作为结束时间
+						assert.Equal(t, finished.UnixMilli(), f.Utime)
+						assert.Equal(t, 3, f.Version)
+						assert.Equal(t, "供应商超时", f.StatusReason)
+						assert.Equal(t, domain.SendStatusPending.String(), n.Status)
+						return nil
+					}),
+				)
+			},
+		},
+		{
+			name: "额度不足",
+			mock: func(_ *daomocks.MockNotificationDAO, quotaCache *cachemocks.MockQuotaCache) {
+				quotaCache.EXPECT().Decr(gomock.Any(), int64(2), domain.ChannelSMS, int32(1)).Return(errs.ErrNoQuota)
+			},
+			wantErr: errs.ErrNoQuota,
+		},
+		{
+			// 被其他请求抢先重新发送，归还本次扣减的额度
+			name: "并发重新发送",
+			mock: func(d *daomocks.MockNotificationDAO, quotaCache *cachemocks.MockQuotaCache) {
+				gomock.InOrder(
+					quotaCache.EXPECT().Decr(gomock.Any(), int64(2), domain.ChannelSMS, int32(1)).Return(nil),
+					d.EXPECT().Resend(gomock.Any(), gomock.Any(), gomock.Any()).Return(errs.ErrNotificationVersionMismatch),
+					quotaCache.EXPECT().Incr(gomock.Any(), int64(2), domain.ChannelSMS, int32(1)).Return(nil),
+				)
+			},
+			wantErr: errs.ErrNotificationVersionMismatch,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			d := daomocks.NewMockNotificationDAO(ctrl)
+			quotaCache := cachemocks.NewMockQuotaCache(ctrl)
+			tc.mock(d, quotaCache)
+
+			err := NewNotificationRepository(d, quotaCache).Resend(context.Background(), failed, next)
+			assert.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
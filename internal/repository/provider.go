@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 
+	"github.com/ecodeclub/ekit/slice"
 	"github.com/robinlg/notification-platform/internal/domain"
 	"github.com/robinlg/notification-platform/internal/repository/dao"
 )
@@ -11,6 +12,10 @@ import (
 type ProviderRepository interface {
 	// Create 创建供应商
 	Create(ctx context.Context, provider domain.Provider) (domain.Provider, error)
+	// FindActiveByChannel 查找指定渠道下所有启用的供应商
+	FindActiveByChannel(ctx context.Context, channel domain.Channel) ([]domain.Provider, error)
+	// GetByID 根据ID获取供应商
+	GetByID(ctx context.Context, id int64) (domain.Provider, error)
 }
 
 type providerRepository struct {
@@ -29,6 +34,24 @@ func (p *providerRepository) Create(ctx context.Context, provider domain.Provide
 	return p.toDomain(created), nil
 }
 
+func (p *providerRepository) FindActiveByChannel(ctx context.Context, channel domain.Channel) ([]domain.Provider, error) {
+	providers, err := p.dao.FindActiveByChannel(ctx, channel.String())
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(providers, func(_ int, src dao.Provider) domain.Provider {
+		return p.toDomain(src)
+	}), nil
+}
+
+func (p *providerRepository) GetByID(ctx context.Context, id int64) (domain.Provider, error) {
+	provider, err := p.dao.FindByID(ctx, id)
+	if err != nil {
+		return domain.Provider{}, err
+	}
+	return p.toDomain(provider), nil
+}
+
 func (p *providerRepository) toDomain(d dao.Provider) domain.Provider {
 	return domain.Provider{
 		ID:               d.ID,
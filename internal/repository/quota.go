@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ecodeclub/ekit/slice"
+	"github.com/robinlg/notification-platform/internal/domain"
+	"github.com/robinlg/notification-platform/internal/repository/dao"
+)
+
+// QuotaRepository 额度仓储接口，只负责额度和流水的持久化，剩余额度以 Redis 中的计数为准
+//
+//go:generate mockgen -source=./quota.go -destination=./mocks/quota.mock.go -package=repositorymocks -typed QuotaRepository
+type QuotaRepository interface {
+	// FindByBizID 获取业务方所有渠道的额度
+	FindByBizID(ctx context.Context, bizID int64) ([]domain.QuotaRecord, error)
+	// Save 保存额度并写入流水
+	Save(ctx context.Context, record domain.QuotaRecord, ledger domain.QuotaLedger) error
+	// Adjust 累加手动调整的额度并写入流水，返回调整后的额度
+	Adjust(ctx context.Context, ledger domain.QuotaLedger) (domain.QuotaRecord, error)
+}
+
+type quotaRepository struct {
+	dao dao.QuotaDAO
+}
+
+func NewQuotaRepository(d dao.QuotaDAO) QuotaRepository {
+	return &quotaRepository{dao: d}
+}
+
+func (r *quotaRepository) FindByBizID(ctx context.Context, bizID int64) ([]domain.QuotaRecord, error) {
+	quotas, err := r.dao.FindByBizID(ctx, bizID)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(quotas, func(_ int, src dao.Quota) domain.QuotaRecord {
+		return r.toDomain(src)
+	}), nil
+}
+
+func (r *quotaRepository) Save(ctx context.Context, record domain.QuotaRecord, ledger domain.QuotaLedger) error {
+	return r.dao.Save(ctx, dao.Quota{
+		BizID:    record.BizID,
+		Channel:  record.Channel.String(),
+		Period:   record.Period,
+		Monthly:  record.Monthly,
+		Adjusted: record.Adjusted,
+	}, r.toLedgerEntity(ledger))
+}
+
+func (r *quotaRepository) Adjust(ctx context.Context, ledger domain.QuotaLedger) (domain.QuotaRecord, error) {
+	quota, err := r.dao.Adjust(ctx, r.toLedgerEntity(ledger))
+	if err != nil {
+		return domain.QuotaRecord{}, err
+	}
+	return r.toDomain(quota), nil
+}
+
+func (r *quotaRepository) toDomain(src dao.Quota) domain.QuotaRecord {
+	return domain.QuotaRecord{
+		BizID:    src.BizID,
+		Channel:  domain.Channel(src.Channel),
+		Period:   src.Period,
+		Monthly:  src.Monthly,
+		Adjusted: src.Adjusted,
+	}
+}
+
+func (r *quotaRepository) toLedgerEntity(ledger domain.QuotaLedger) dao.QuotaLedger {
+	return dao.QuotaLedger{
+		BizID:     ledger.BizID,
+		Channel:   ledger.Channel.String(),
+		Period:    ledger.Period,
+		Type:      ledger.Type.String(),
+		Delta:     ledger.Delta,
+		Total:     ledger.Total,
+		Remaining: ledger.Remaining,
+		Reason:    ledger.Reason,
+		Operator:  ledger.Operator,
+	}
+}
@@ -7,6 +7,9 @@ import (
 	"github.com/robinlg/notification-platform/internal/repository/dao"
 )
 
+// TxNotificationRepository 事务通知仓储接口
+//
+//go:generate mockgen -source=./tx_notification.go -destination=./mocks/tx_notification.mock.go -package=repositorymocks -typed TxNotificationRepository
 type TxNotificationRepository interface {
 	Create(ctx context.Context, notification domain.TxNotification) (uint64, error)
 	FindCheckBack(ctx context.Context, offset, limit int) ([]domain.TxNotification, error)
@@ -69,6 +72,7 @@ func (t *txNotificationRepo) UpdateCheckStatus(ctx context.Context, txNotificati
 func (t *txNotificationRepo) toEntity(notification domain.Notification) dao.Notification {
 	templateParams, _ := notification.MarshalTemplateParams()
 	receivers, _ := notification.MarshalReceivers()
+	fallbacks, _ := notification.MarshalFallbacks()
 	return dao.Notification{
 		ID:                notification.ID,
 		BizID:             notification.BizID,
@@ -82,6 +86,9 @@ func (t *txNotificationRepo) toEntity(notification domain.Notification) dao.Noti
 		ScheduledSTime:    notification.ScheduledSTime.UnixMilli(),
 		ScheduledETime:    notification.ScheduledETime.UnixMilli(),
 		Version:           notification.Version,
+		Fallbacks:         fallbacks,
+		Priority:          int32(notification.Priority),
+		HashSlot:          notification.HashSlot(),
 	}
 }
 
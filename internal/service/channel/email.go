@@ -0,0 +1,19 @@
+package channel
+
+import (
+	"github.com/robinlg/notification-platform/internal/service/event"
+	"github.com/robinlg/notification-platform/internal/service/provider"
+)
+
+type emailChannel struct {
+	baseChannel
+}
+
+func NewEmailChannel(builder provider.SelectorBuilder, eventSvc event.Service) Channel {
+	return &emailChannel{
+		baseChannel: baseChannel{
+			builder:  builder,
+			eventSvc: eventSvc,
+		},
+	}
+}
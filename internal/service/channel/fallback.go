@@ -0,0 +1,91 @@
+package channel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gotomicro/ego/core/elog"
+	"github.com/robinlg/notification-platform/internal/domain"
+	"github.com/robinlg/notification-platform/internal/repository/cache"
+	configsvc "github.com/robinlg/notification-platform/internal/service/config"
+)
+
+// fallbackQuotaNumber 一条通知占用的额度
+const fallbackQuotaNumber = 1
+
+// fallbackChannel 渠道降级装饰器
+// 原渠道所有供应商都发送失败后，如果业务方开启了渠道降级，那么按照渠道优先级依次使用通知中对应的降级目标重新发送
+// 创建通知时扣减的是原渠道的额度，降级发送之前先扣减降级渠道的额度，额度不足时跳过该渠道；
+// 降级发送成功后归还原渠道的额度，失败则归还降级渠道的额度，保证额度始终记在实际送达的渠道上
+type fallbackChannel struct {
+	channel    Channel
+	configSvc  configsvc.BusinessConfigService
+	quotaCache cache.QuotaCache
+	logger     *elog.Component
+}
+
+// NewFallbackChannel 创建支持渠道降级的渠道，channel 通常是 Dispatcher
+func NewFallbackChannel(channel Channel, configSvc configsvc.BusinessConfigService, quotaCache cache.QuotaCache) Channel {
+	return &fallbackChannel{
+		channel:    channel,
+		configSvc:  configSvc,
+		quotaCache: quotaCache,
+		logger:     elog.DefaultLogger,
+	}
+}
+
+func (f *fallbackChannel) Send(ctx context.Context, notification domain.Notification) (domain.SendResponse, error) {
+	resp, err := f.channel.Send(ctx, notification)
+	if err == nil || len(notification.Fallbacks) == 0 {
+		return resp, err
+	}
+
+	cfg, err1 := f.configSvc.GetByID(ctx, notification.BizID)
+	if err1 != nil || cfg.ChannelConfig == nil {
+		return resp, err
+	}
+
+	targets := make(map[domain.Channel]domain.FallbackTarget, len(notification.Fallbacks))
+	for i := range notification.Fallbacks {
+		targets[notification.Fallbacks[i].Channel] = notification.Fallbacks[i]
+	}
+
+	for _, ch := range cfg.ChannelConfig.FallbackChannels(notification.Channel) {
+		target, ok := targets[ch]
+		if !ok {
+			// 业务方没有提供该渠道的接收者和模版
+			continue
+		}
+		f.logger.Warn("原渠道发送失败，尝试降级渠道",
+			elog.Any("notificationID", notification.ID),
+			elog.String("channel", notification.Channel.String()),
+			elog.String("fallback", ch.String()),
+			elog.FieldErr(err))
+
+		// 检查并扣减降级渠道的额度是原子的，额度不足时不会扣成负数
+		err1 = f.quotaCache.MutiDecr(ctx, []cache.IncrItem{{BizID: notification.BizID, Channel: ch, Val: fallbackQuotaNumber}})
+		if err1 != nil {
+			err = fmt.Errorf("%w; 降级渠道 %s 扣减额度失败: %w", err, ch, err1)
+			continue
+		}
+
+		resp, err1 = f.channel.Send(ctx, notification.Fallback(target))
+		if err1 == nil {
+			f.refund(ctx, notification.BizID, notification.Channel)
+			return resp, nil
+		}
+		f.refund(ctx, notification.BizID, ch)
+		err = fmt.Errorf("%w; 降级渠道 %s 发送失败: %w", err, ch, err1)
+	}
+	return domain.SendResponse{}, err
+}
+
+// refund 归还渠道 ch 的额度，失败时只记录日志，由额度对账任务修正
+func (f *fallbackChannel) refund(ctx context.Context, bizID int64, ch domain.Channel) {
+	err := f.quotaCache.Incr(ctx, bizID, ch, fallbackQuotaNumber)
+	if err != nil {
+		f.logger.Error("渠道降级，归还额度失败", elog.FieldErr(err),
+			elog.Int64("bizID", bizID),
+			elog.String("channel", ch.String()))
+	}
+}
@@ -0,0 +1,187 @@
+//go:build unit
+
+package channel
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/robinlg/notification-platform/internal/domain"
+	"github.com/robinlg/notification-platform/internal/errs"
+	rediscache "github.com/robinlg/notification-platform/internal/repository/cache/redis"
+	channelmocks "github.com/robinlg/notification-platform/internal/service/channel/mocks"
+	configmocks "github.com/robinlg/notification-platform/internal/service/config/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestFallbackChannel_Send(t *testing.T) {
+	t.Parallel()
+
+	notification := domain.Notification{
+		ID:        1,
+		BizID:     2,
+		Channel:   domain.ChannelSMS,
+		Receivers: []string{"13800138000"},
+		Template:  domain.Template{ID: 100, VersionID: 1},
+		Fallbacks: []domain.FallbackTarget{
+			{Channel: domain.ChannelEmail, Receivers: []string{"a@example.com"}, TemplateID: 200},
+			{Channel: domain.ChannelInApp, Receivers: []string{"user-1"}, TemplateID: 300},
+		},
+	}
+	bizConfig := domain.BusinessConfig{
+		ID: 2,
+		ChannelConfig: &domain.ChannelConfig{
+			Fallback: true,
+			Channels: []domain.ChannelItem{
+				{Channel: "SMS", Priority: 1, Enabled: true},
+				{Channel: "IN_APP", Priority: 2, Enabled: true},
+				{Channel: "EMAIL", Priority: 3, Enabled: true},
+			},
+		},
+	}
+
+	// 创建通知时已经扣减了短信的额度
+	initQuota := map[domain.Channel]int64{
+		domain.ChannelSMS:   9,
+		domain.ChannelEmail: 10,
+		domain.ChannelInApp: 10,
+	}
+
+	tests := []struct {
+		name  string
+		mock  func(ctrl *gomock.Controller) (Channel, *configmocks.MockBusinessConfigService)
+		quota map[domain.Channel]int64
+		want  domain.SendResponse
+		// wantQuota 发送之后各渠道的剩余额度
+		wantQuota map[domain.Channel]int64
+		wantErr   error
+	}{
+		{
+			name: "原渠道发送成功",
+			mock: func(ctrl *gomock.Controller) (Channel, *configmocks.MockBusinessConfigService) {
+				ch := channelmocks.NewMockChannel(ctrl)
+				ch.EXPECT().Send(gomock.Any(), notification).
+					Return(domain.SendResponse{NotificationID: 1, Status: domain.SendStatusSucceeded, Channel: domain.ChannelSMS}, nil)
+				return ch, configmocks.NewMockBusinessConfigService(ctrl)
+			},
+			quota:     initQuota,
+			want:      domain.SendResponse{NotificationID: 1, Status: domain.SendStatusSucceeded, Channel: domain.ChannelSMS},
+			wantQuota: initQuota,
+		},
+		{
+			name: "按照优先级降级到站内信",
+			mock: func(ctrl *gomock.Controller) (Channel, *configmocks.MockBusinessConfigService) {
+				ch := channelmocks.NewMockChannel(ctrl)
+				ch.EXPECT().Send(gomock.Any(), notification).Return(domain.SendResponse{}, errs.ErrSendNotificationFailed)
+				ch.EXPECT().Send(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, n domain.Notification) (domain.SendResponse, error) {
+						assert.Equal(t, domain.ChannelInApp, n.Channel)
+						assert.Equal(t, []string{"user-1"}, n.Receivers)
+						assert.Equal(t, int64(300), n.Template.ID)
+						assert.Empty(t, n.Fallbacks)
+						return domain.SendResponse{NotificationID: 1, Status: domain.SendStatusSucceeded, Channel: domain.ChannelInApp}, nil
+					})
+				configSvc := configmocks.NewMockBusinessConfigService(ctrl)
+				configSvc.EXPECT().GetByID(gomock.Any(), int64(2)).Return(bizConfig, nil)
+				return ch, configSvc
+			},
+			quota: initQuota,
+			want:  domain.SendResponse{NotificationID: 1, Status: domain.SendStatusSucceeded, Channel: domain.ChannelInApp},
+			// 额度从短信转移到实际送达的站内信
+			wantQuota: map[domain.Channel]int64{
+				domain.ChannelSMS:   10,
+				domain.ChannelEmail: 10,
+				domain.ChannelInApp: 9,
+			},
+		},
+		{
+			name: "降级渠道额度不足时跳过",
+			mock: func(ctrl *gomock.Controller) (Channel, *configmocks.MockBusinessConfigService) {
+				ch := channelmocks.NewMockChannel(ctrl)
+				ch.EXPECT().Send(gomock.Any(), notification).Return(domain.SendResponse{}, errs.ErrSendNotificationFailed)
+				ch.EXPECT().Send(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, n domain.Notification) (domain.SendResponse, error) {
+						assert.Equal(t, domain.ChannelEmail, n.Channel)
+						return domain.SendResponse{NotificationID: 1, Status: domain.SendStatusSucceeded, Channel: domain.ChannelEmail}, nil
+					})
+				configSvc := configmocks.NewMockBusinessConfigService(ctrl)
+				configSvc.EXPECT().GetByID(gomock.Any(), int64(2)).Return(bizConfig, nil)
+				return ch, configSvc
+			},
+			quota: map[domain.Channel]int64{
+				domain.ChannelSMS:   9,
+				domain.ChannelEmail: 10,
+				domain.ChannelInApp: 0,
+			},
+			want: domain.SendResponse{NotificationID: 1, Status: domain.SendStatusSucceeded, Channel: domain.ChannelEmail},
+			wantQuota: map[domain.Channel]int64{
+				domain.ChannelSMS:   10,
+				domain.ChannelEmail: 9,
+				domain.ChannelInApp: 0,
+			},
+		},
+		{
+			name: "未开启降级",
+			mock: func(ctrl *gomock.Controller) (Channel, *configmocks.MockBusinessConfigService) {
+				ch := channelmocks.NewMockChannel(ctrl)
+				ch.EXPECT().Send(gomock.Any(), notification).Return(domain.SendResponse{}, errs.ErrSendNotificationFailed)
+				cfg := bizConfig
+				cfg.ChannelConfig = &domain.ChannelConfig{Channels: bizConfig.ChannelConfig.Channels}
+				configSvc := configmocks.NewMockBusinessConfigService(ctrl)
+				configSvc.EXPECT().GetByID(gomock.Any(), int64(2)).Return(cfg, nil)
+				return ch, configSvc
+			},
+			quota:     initQuota,
+			wantQuota: initQuota,
+			wantErr:   errs.ErrSendNotificationFailed,
+		},
+		{
+			name: "所有降级渠道均失败",
+			mock: func(ctrl *gomock.Controller) (Channel, *configmocks.MockBusinessConfigService) {
+				ch := channelmocks.NewMockChannel(ctrl)
+				ch.EXPECT().Send(gomock.Any(), gomock.Any()).Return(domain.SendResponse{}, errs.ErrSendNotificationFailed).Times(3)
+				configSvc := configmocks.NewMockBusinessConfigService(ctrl)
+				configSvc.EXPECT().GetByID(gomock.Any(), int64(2)).Return(bizConfig, nil)
+				return ch, configSvc
+			},
+			quota: initQuota,
+			// 降级渠道扣减的额度全部归还，短信的额度由发送器标记失败时归还
+			wantQuota: initQuota,
+			wantErr:   errs.ErrSendNotificationFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			quotaCache := rediscache.NewQuotaCache(redis.NewClient(&redis.Options{Addr: miniredis.RunT(t).Addr()}))
+			for ch, quota := range tt.quota {
+				_, err := quotaCache.SetNX(t.Context(), notification.BizID, ch, quota)
+				require.NoError(t, err)
+			}
+
+			ch, configSvc := tt.mock(ctrl)
+			resp, err := NewFallbackChannel(ch, configSvc, quotaCache).Send(t.Context(), notification)
+			for ch, want := range tt.wantQuota {
+				got, err1 := quotaCache.Get(t.Context(), notification.BizID, ch)
+				require.NoError(t, err1)
+				assert.Equal(t, want, got, ch)
+			}
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, tt.wantErr))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, resp)
+		})
+	}
+}
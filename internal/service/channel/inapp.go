@@ -0,0 +1,19 @@
+package channel
+
+import (
+	"github.com/robinlg/notification-platform/internal/service/event"
+	"github.com/robinlg/notification-platform/internal/service/provider"
+)
+
+type inAppChannel struct {
+	baseChannel
+}
+
+func NewInAppChannel(builder provider.SelectorBuilder, eventSvc event.Service) Channel {
+	return &inAppChannel{
+		baseChannel: baseChannel{
+			builder:  builder,
+			eventSvc: eventSvc,
+		},
+	}
+}
@@ -3,14 +3,17 @@ package channel
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/robinlg/notification-platform/internal/domain"
 	"github.com/robinlg/notification-platform/internal/errs"
+	"github.com/robinlg/notification-platform/internal/service/event"
 	"github.com/robinlg/notification-platform/internal/service/provider"
 )
 
 type baseChannel struct {
-	builder provider.SelectorBuilder
+	builder  provider.SelectorBuilder
+	eventSvc event.Service
 }
 
 func (s *baseChannel) Send(ctx context.Context, notification domain.Notification) (domain.SendResponse, error) {
@@ -27,22 +30,45 @@ func (s *baseChannel) Send(ctx context.Context, notification domain.Notification
 			return domain.SendResponse{}, fmt.Errorf("%w: %w", errs.ErrSendNotificationFailed, err1)
 		}
 
-		// 使用当前供应商发送
+		// 使用当前供应商发送，失败则继续尝试下一个供应商
+		start := time.Now()
 		resp, err2 := p.Send(ctx, notification)
-		if err2 != nil {
+		s.recordAttempt(ctx, notification, resp.Provider, time.Since(start), err2)
+		if err2 == nil {
+			// 记录实际送达的渠道，发生渠道降级时与原始渠道不同
+			resp.Channel = notification.Channel
 			return resp, nil
 		}
 	}
 }
 
+// recordAttempt 记录一次供应商调用
+func (s *baseChannel) recordAttempt(ctx context.Context, notification domain.Notification, result domain.ProviderResult, latency time.Duration, err error) {
+	e := domain.NotificationEvent{
+		NotificationID: notification.ID,
+		BizID:          notification.BizID,
+		Type:           domain.NotificationEventTypeProviderAttempt,
+		Status:         domain.SendStatusSucceeded,
+		Channel:        notification.Channel,
+		Provider:       result,
+		Latency:        latency,
+	}
+	if err != nil {
+		e.Status = domain.SendStatusFailed
+		e.Reason = err.Error()
+	}
+	s.eventSvc.Record(ctx, e)
+}
+
 type smsChannel struct {
 	baseChannel
 }
 
-func NewSMSChannel(builder provider.SelectorBuilder) Channel {
+func NewSMSChannel(builder provider.SelectorBuilder, eventSvc event.Service) Channel {
 	return &smsChannel{
 		baseChannel: baseChannel{
-			builder: builder,
+			builder:  builder,
+			eventSvc: eventSvc,
 		},
 	}
 }
@@ -0,0 +1,111 @@
+//go:build unit
+
+package channel
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/robinlg/notification-platform/internal/domain"
+	"github.com/robinlg/notification-platform/internal/errs"
+	eventmocks "github.com/robinlg/notification-platform/internal/service/event/mocks"
+	"github.com/robinlg/notification-platform/internal/service/provider"
+	providermocks "github.com/robinlg/notification-platform/internal/service/provider/mocks"
+	"github.com/robinlg/notification-platform/internal/service/provider/sequential"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestBaseChannel_Send(t *testing.T) {
+	t.Parallel()
+
+	n := domain.Notification{ID: 1, BizID: 2, Channel: domain.ChannelSMS}
+	testCases := []struct {
+		name       string
+		mock       func(ctrl *gomock.Controller) ([]provider.Provider, *eventmocks.MockService)
+		wantResp   domain.SendResponse
+		wantErr    error
+		wantEvents []domain.NotificationEvent
+	}{
+		{
+			// 每次调用供应商都记录一条事件，失败的调用记录供应商返回的错误
+			name: "第一个供应商失败之后切换供应商",
+			mock: func(ctrl *gomock.Controller) ([]provider.Provider, *eventmocks.MockService) {
+				p1 := providermocks.NewMockProvider(ctrl)
+				p1.EXPECT().Send(gomock.Any(), n).Return(domain.SendResponse{
+					Provider: domain.ProviderResult{Name: "aliyun", RequestID: "req-1", Code: "isv.BUSINESS_LIMIT_CONTROL", Message: "触发流控"},
+				}, errs.ErrSendNotificationFailed)
+				p2 := providermocks.NewMockProvider(ctrl)
+				p2.EXPECT().Send(gomock.Any(), n).Return(domain.SendResponse{
+					NotificationID: 1,
+					Status:         domain.SendStatusSucceeded,
+					Provider:       domain.ProviderResult{Name: "tencent", RequestID: "req-2", Code: "Ok"},
+				}, nil)
+				return []provider.Provider{p1, p2}, eventmocks.NewMockService(ctrl)
+			},
+			wantResp: domain.SendResponse{
+				NotificationID: 1,
+				Status:         domain.SendStatusSucceeded,
+				Channel:        domain.ChannelSMS,
+				Provider:       domain.ProviderResult{Name: "tencent", RequestID: "req-2", Code: "Ok"},
+			},
+			wantEvents: []domain.NotificationEvent{
+				{
+					NotificationID: 1,
+					BizID:          2,
+					Type:           domain.NotificationEventTypeProviderAttempt,
+					Status:         domain.SendStatusFailed,
+					Reason:         errs.ErrSendNotificationFailed.Error(),
+					Channel:        domain.ChannelSMS,
+					Provider:       domain.ProviderResult{Name: "aliyun", RequestID: "req-1", Code: "isv.BUSINESS_LIMIT_CONTROL", Message: "触发流控"},
+				},
+				{
+					NotificationID: 1,
+					BizID:          2,
+					Type:           domain.NotificationEventTypeProviderAttempt,
+					Status:         domain.SendStatusSucceeded,
+					Channel:        domain.ChannelSMS,
+					Provider:       domain.ProviderResult{Name: "tencent", RequestID: "req-2", Code: "Ok"},
+				},
+			},
+		},
+		{
+			// 没有调用供应商时不记录事件
+			name: "没有可用的供应商",
+			mock: func(ctrl *gomock.Controller) ([]provider.Provider, *eventmocks.MockService) {
+				return nil, eventmocks.NewMockService(ctrl)
+			},
+			wantErr: errs.ErrSendNotificationFailed,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			providers, eventSvc := tc.mock(ctrl)
+			var events []domain.NotificationEvent
+			eventSvc.EXPECT().Record(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, es ...domain.NotificationEvent) {
+				events = append(events, es...)
+			}).Times(len(tc.wantEvents))
+
+			ch := NewSMSChannel(sequential.NewSelectorBuilder(providers), eventSvc)
+			resp, err := ch.Send(context.Background(), n)
+			assert.ErrorIs(t, err, tc.wantErr)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, tc.wantResp, resp)
+			require.Len(t, events, len(tc.wantEvents))
+			for i := range events {
+				assert.GreaterOrEqual(t, events[i].Latency, time.Duration(0))
+				events[i].Latency = 0
+				assert.Equal(t, tc.wantEvents[i], events[i])
+			}
+		})
+	}
+}
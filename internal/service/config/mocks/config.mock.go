@@ -79,3 +79,42 @@ func (c *MockBusinessConfigServiceGetByIDCall) DoAndReturn(f func(context.Contex
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// GetByIDs mocks base method.
+func (m *MockBusinessConfigService) GetByIDs(ctx context.Context, ids []int64) (map[int64]domain.BusinessConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIDs", ctx, ids)
+	ret0, _ := ret[0].(map[int64]domain.BusinessConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIDs indicates an expected call of GetByIDs.
+func (mr *MockBusinessConfigServiceMockRecorder) GetByIDs(ctx, ids any) *MockBusinessConfigServiceGetByIDsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDs", reflect.TypeOf((*MockBusinessConfigService)(nil).GetByIDs), ctx, ids)
+	return &MockBusinessConfigServiceGetByIDsCall{Call: call}
+}
+
+// MockBusinessConfigServiceGetByIDsCall wrap *gomock.Call
+type MockBusinessConfigServiceGetByIDsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockBusinessConfigServiceGetByIDsCall) Return(arg0 map[int64]domain.BusinessConfig, arg1 error) *MockBusinessConfigServiceGetByIDsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockBusinessConfigServiceGetByIDsCall) Do(f func(context.Context, []int64) (map[int64]domain.BusinessConfig, error)) *MockBusinessConfigServiceGetByIDsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockBusinessConfigServiceGetByIDsCall) DoAndReturn(f func(context.Context, []int64) (map[int64]domain.BusinessConfig, error)) *MockBusinessConfigServiceGetByIDsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,70 @@
+package event
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gotomicro/ego/core/elog"
+	"github.com/robinlg/notification-platform/internal/domain"
+	"github.com/robinlg/notification-platform/internal/errs"
+	"github.com/robinlg/notification-platform/internal/repository"
+)
+
+// maxEvents 查询单个通知的事件时的最大数量，正常的通知远远达不到这个数量
+const maxEvents = 500
+
+// Service 通知事件服务接口
+//
+//go:generate mockgen -source=./event.go -destination=./mocks/event.mock.go -package=eventmocks -typed Service
+type Service interface {
+	// Record 记录调用供应商的事件，记录失败只打印日志，不影响发送流程
+	// 状态变更事件和状态在同一个事务中写入，不通过这里记录
+	Record(ctx context.Context, events ...domain.NotificationEvent)
+	// ListByNotificationID 按照发生顺序查询通知的事件
+	ListByNotificationID(ctx context.Context, notificationID uint64) ([]domain.NotificationEvent, error)
+}
+
+// eventService 通知事件服务实现
+type eventService struct {
+	repo   repository.NotificationEventRepository
+	logger *elog.Component
+}
+
+// NewEventService 创建通知事件服务
+func NewEventService(repo repository.NotificationEventRepository) Service {
+	return &eventService{
+		repo:   repo,
+		logger: elog.DefaultLogger,
+	}
+}
+
+// Record 记录通知事件
+func (s *eventService) Record(ctx context.Context, events ...domain.NotificationEvent) {
+	if len(events) == 0 {
+		return
+	}
+	now := time.Now()
+	for i := range events {
+		if events[i].Ctime.IsZero() {
+			events[i].Ctime = now
+		}
+	}
+	// 发送流程的 ctx 可能已经超时，事件依旧需要记录下来
+	err := s.repo.BatchCreate(context.WithoutCancel(ctx), events)
+	if err != nil {
+		s.logger.Warn("记录通知事件失败",
+			elog.Int("count", len(events)),
+			elog.Any("notificationID", events[0].NotificationID),
+			elog.FieldErr(err))
+	}
+}
+
+// ListByNotificationID 按照发生顺序查询通知的事件
+func (s *eventService) ListByNotificationID(ctx context.Context, notificationID uint64) ([]domain.NotificationEvent, error) {
+	events, err := s.repo.FindByNotificationID(ctx, notificationID, maxEvents)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrDatabaseError, err)
+	}
+	return events, nil
+}
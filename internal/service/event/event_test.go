@@ -0,0 +1,86 @@
+//go:build unit
+
+package event
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/robinlg/notification-platform/internal/domain"
+	"github.com/robinlg/notification-platform/internal/errs"
+	repositorymocks "github.com/robinlg/notification-platform/internal/repository/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestEventService_Record(t *testing.T) {
+	t.Parallel()
+
+	ctime := time.UnixMilli(1700000000000)
+	testCases := []struct {
+		name   string
+		events []domain.NotificationEvent
+		mock   func(repo *repositorymocks.MockNotificationEventRepository)
+	}{
+		{
+			// 没有指定时间的事件使用记录时的时间
+			name: "记录事件",
+			events: []domain.NotificationEvent{
+				{NotificationID: 1, Type: domain.NotificationEventTypeProviderAttempt, Status: domain.SendStatusFailed},
+				{NotificationID: 1, Type: domain.NotificationEventTypeProviderAttempt, Status: domain.SendStatusSucceeded, Ctime: ctime},
+			},
+			mock: func(repo *repositorymocks.MockNotificationEventRepository) {
+				repo.EXPECT().BatchCreate(gomock.Any(), gomock.Len(2)).DoAndReturn(func(_ context.Context, events []domain.NotificationEvent) error {
+					assert.False(t, events[0].Ctime.IsZero())
+					assert.Equal(t, ctime, events[1].Ctime)
+					return nil
+				})
+			},
+		},
+		{
+			// 记录失败不影响发送流程
+			name:   "记录失败",
+			events: []domain.NotificationEvent{{NotificationID: 1}},
+			mock: func(repo *repositorymocks.MockNotificationEventRepository) {
+				repo.EXPECT().BatchCreate(gomock.Any(), gomock.Len(1)).Return(assert.AnError)
+			},
+		},
+		{
+			name:   "没有事件",
+			events: nil,
+			mock:   func(_ *repositorymocks.MockNotificationEventRepository) {},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := repositorymocks.NewMockNotificationEventRepository(ctrl)
+			tc.mock(repo)
+			NewEventService(repo).Record(context.Background(), tc.events...)
+		})
+	}
+}
+
+func TestEventService_ListByNotificationID(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repositorymocks.NewMockNotificationEventRepository(ctrl)
+	repo.EXPECT().FindByNotificationID(gomock.Any(), uint64(1), maxEvents).
+		Return([]domain.NotificationEvent{{ID: 1, NotificationID: 1}}, nil)
+	events, err := NewEventService(repo).ListByNotificationID(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Len(t, events, 1)
+
+	repo.EXPECT().FindByNotificationID(gomock.Any(), uint64(2), maxEvents).Return(nil, assert.AnError)
+	_, err = NewEventService(repo).ListByNotificationID(context.Background(), 2)
+	assert.ErrorIs(t, err, errs.ErrDatabaseError)
+}
@@ -0,0 +1,122 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./event.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./event.go -destination=./mocks/event.mock.go -package=eventmocks -typed Service
+//
+
+// Package eventmocks is a generated GoMock package.
+package eventmocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/robinlg/notification-platform/internal/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockService is a mock of Service interface.
+type MockService struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockServiceMockRecorder is the mock recorder for MockService.
+type MockServiceMockRecorder struct {
+	mock *MockService
+}
+
+// NewMockService creates a new mock instance.
+func NewMockService(ctrl *gomock.Controller) *MockService {
+	mock := &MockService{ctrl: ctrl}
+	mock.recorder = &MockServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockService) EXPECT() *MockServiceMockRecorder {
+	return m.recorder
+}
+
+// ListByNotificationID mocks base method.
+func (m *MockService) ListByNotificationID(ctx context.Context, notificationID uint64) ([]domain.NotificationEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByNotificationID", ctx, notificationID)
+	ret0, _ := ret[0].([]domain.NotificationEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByNotificationID indicates an expected call of ListByNotificationID.
+func (mr *MockServiceMockRecorder) ListByNotificationID(ctx, notificationID any) *MockServiceListByNotificationIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByNotificationID", reflect.TypeOf((*MockService)(nil).ListByNotificationID), ctx, notificationID)
+	return &MockServiceListByNotificationIDCall{Call: call}
+}
+
+// MockServiceListByNotificationIDCall wrap *gomock.Call
+type MockServiceListByNotificationIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceListByNotificationIDCall) Return(arg0 []domain.NotificationEvent, arg1 error) *MockServiceListByNotificationIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceListByNotificationIDCall) Do(f func(context.Context, uint64) ([]domain.NotificationEvent, error)) *MockServiceListByNotificationIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceListByNotificationIDCall) DoAndReturn(f func(context.Context, uint64) ([]domain.NotificationEvent, error)) *MockServiceListByNotificationIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Record mocks base method.
+func (m *MockService) Record(ctx context.Context, events ...domain.NotificationEvent) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range events {
+		varargs = append(varargs, a)
+	}
+	m.ctrl.Call(m, "Record", varargs...)
+}
+
+// Record indicates an expected call of Record.
+func (mr *MockServiceMockRecorder) Record(ctx any, events ...any) *MockServiceRecordCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, events...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockService)(nil).Record), varargs...)
+	return &MockServiceRecordCall{Call: call}
+}
+
+// MockServiceRecordCall wrap *gomock.Call
+type MockServiceRecordCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceRecordCall) Return() *MockServiceRecordCall {
+	c.Call = c.Call.Return()
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceRecordCall) Do(f func(context.Context, ...domain.NotificationEvent)) *MockServiceRecordCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceRecordCall) DoAndReturn(f func(context.Context, ...domain.NotificationEvent)) *MockServiceRecordCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,72 @@
+package event
+
+import (
+	"context"
+	"time"
+
+	"github.com/meoying/dlock-go"
+	"github.com/robinlg/notification-platform/internal/pkg/loopjob"
+	"github.com/robinlg/notification-platform/internal/repository"
+	"github.com/robinlg/notification-platform/internal/repository/cache"
+)
+
+const (
+	PublishStatusEventsTaskKey = "publish_status_events_job"
+
+	defaultPublishBatchSize = 100
+	defaultPublishTimeout   = 3 * time.Second
+	// defaultPublishIdleInterval 没有待投递的事件时等待的时间，也是事件投递的最大延迟
+	defaultPublishIdleInterval = 200 * time.Millisecond
+)
+
+// PublishStatusEventsTask 将状态变更事件投递到状态变更事件流
+// 状态变更事件和通知状态在同一个事务中写入，投递成功之后才标记为已投递，投递失败或者进程崩溃时由下一轮重新投递，
+// 所以事件流中的事件不会丢失，但是可能重复，按照写入顺序投递保证同一个通知的事件在事件流中是有序的
+type PublishStatusEventsTask struct {
+	repo         repository.NotificationEventRepository
+	statusStream cache.NotificationStatusStream
+	lock         dlock.Client
+	batchSize    int
+	idleInterval time.Duration
+}
+
+func NewPublishStatusEventsTask(repo repository.NotificationEventRepository, statusStream cache.NotificationStatusStream, lock dlock.Client) *PublishStatusEventsTask {
+	return &PublishStatusEventsTask{
+		repo:         repo,
+		statusStream: statusStream,
+		lock:         lock,
+		batchSize:    defaultPublishBatchSize,
+		idleInterval: defaultPublishIdleInterval,
+	}
+}
+
+// Start 只有抢到分布式锁的实例投递，保证事件按照写入顺序进入事件流
+func (task *PublishStatusEventsTask) Start(ctx context.Context) {
+	job := loopjob.NewInfiniteLoop(task.lock, task.oneLoop, PublishStatusEventsTaskKey)
+	job.Run(ctx)
+}
+
+func (task *PublishStatusEventsTask) oneLoop(ctx context.Context) error {
+	loopCtx, cancel := context.WithTimeout(ctx, defaultPublishTimeout)
+	defer cancel()
+
+	events, err := task.repo.FindUnpublishedStatusEvents(loopCtx, task.batchSize)
+	if err != nil {
+		return err
+	}
+	if len(events) == 0 {
+		time.Sleep(task.idleInterval)
+		return nil
+	}
+
+	err = task.statusStream.Publish(loopCtx, events...)
+	if err != nil {
+		return err
+	}
+	ids := make([]int64, 0, len(events))
+	for i := range events {
+		ids = append(ids, events[i].EventID)
+	}
+	// 标记失败时下一轮会重复投递这一批事件
+	return task.repo.MarkPublished(loopCtx, ids)
+}
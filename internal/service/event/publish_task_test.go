@@ -0,0 +1,70 @@
+//go:build unit
+
+package event
+
+import (
+	"testing"
+
+	"github.com/robinlg/notification-platform/internal/domain"
+	cachemocks "github.com/robinlg/notification-platform/internal/repository/cache/mocks"
+	repositorymocks "github.com/robinlg/notification-platform/internal/repository/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPublishStatusEventsTask_oneLoop(t *testing.T) {
+	t.Parallel()
+
+	events := []domain.NotificationStatusEvent{
+		{EventID: 1, NotificationID: 10, BizID: 1, Key: "key-1", Status: domain.SendStatusSending, Ctime: 1700000000000},
+		{EventID: 2, NotificationID: 10, BizID: 1, Key: "key-1", Status: domain.SendStatusSucceeded, Ctime: 1700000000001},
+	}
+	testCases := []struct {
+		name    string
+		mock    func(repo *repositorymocks.MockNotificationEventRepository, stream *cachemocks.MockNotificationStatusStream)
+		wantErr error
+	}{
+		{
+			name: "投递之后标记为已投递",
+			mock: func(repo *repositorymocks.MockNotificationEventRepository, stream *cachemocks.MockNotificationStatusStream) {
+				gomock.InOrder(
+					repo.EXPECT().FindUnpublishedStatusEvents(gomock.Any(), defaultPublishBatchSize).Return(events, nil),
+					stream.EXPECT().Publish(gomock.Any(), events[0], events[1]).Return(nil),
+					repo.EXPECT().MarkPublished(gomock.Any(), []int64{1, 2}).Return(nil),
+				)
+			},
+		},
+		{
+			// 投递失败的事件保持未投递，下一轮重新投递
+			name: "投递失败",
+			mock: func(repo *repositorymocks.MockNotificationEventRepository, stream *cachemocks.MockNotificationStatusStream) {
+				repo.EXPECT().FindUnpublishedStatusEvents(gomock.Any(), defaultPublishBatchSize).Return(events, nil)
+				stream.EXPECT().Publish(gomock.Any(), events[0], events[1]).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "没有待投递的事件",
+			mock: func(repo *repositorymocks.MockNotificationEventRepository, _ *cachemocks.MockNotificationStatusStream) {
+				repo.EXPECT().FindUnpublishedStatusEvents(gomock.Any(), defaultPublishBatchSize).Return(nil, nil)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := repositorymocks.NewMockNotificationEventRepository(ctrl)
+			stream := cachemocks.NewMockNotificationStatusStream(ctrl)
+			tc.mock(repo, stream)
+
+			task := NewPublishStatusEventsTask(repo, stream, nil)
+			task.idleInterval = 0
+			err := task.oneLoop(t.Context())
+			assert.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package inbox
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/robinlg/notification-platform/internal/domain"
+	"github.com/robinlg/notification-platform/internal/errs"
+	"github.com/robinlg/notification-platform/internal/repository"
+)
+
+const (
+	defaultLimit = 20
+	maxLimit     = 100
+)
+
+// Service 站内信服务接口
+//
+//go:generate mockgen -source=./inbox.go -destination=./mocks/inbox.mock.go -package=inboxmocks -typed Service
+type Service interface {
+	// List 按照 ID 倒序分页查询接收者的站内信，未读数量通过 GetUnreadCount 查询
+	// cursor 为上一页返回的 nextCursor，为 0 时查询第一页，返回的 nextCursor 为 0 表示没有下一页
+	List(ctx context.Context, bizID int64, receiver string, onlyUnread bool, cursor int64, limit int) (messages []domain.InboxMessage, nextCursor int64, err error)
+	// GetUnreadCount 查询接收者的未读站内信数量
+	GetUnreadCount(ctx context.Context, bizID int64, receiver string) (int64, error)
+	// MarkRead 将站内信标记为已读，ids 为空时标记全部，返回实际标记的数量
+	MarkRead(ctx context.Context, bizID int64, receiver string, ids []int64) (int64, error)
+	// Delete 删除站内信，返回实际删除的数量
+	Delete(ctx context.Context, bizID int64, receiver string, ids []int64) (int64, error)
+}
+
+// inboxService 站内信服务实现
+type inboxService struct {
+	repo repository.InboxRepository
+}
+
+// NewInboxService 创建站内信服务
+func NewInboxService(repo repository.InboxRepository) Service {
+	return &inboxService{
+		repo: repo,
+	}
+}
+
+// List 按照 ID 倒序分页查询接收者的站内信
+func (s *inboxService) List(ctx context.Context, bizID int64, receiver string, onlyUnread bool, cursor int64, limit int) ([]domain.InboxMessage, int64, error) {
+	if receiver == "" {
+		return nil, 0, fmt.Errorf("%w: 接收者不能为空", errs.ErrInvalidParameter)
+	}
+	if cursor < 0 {
+		return nil, 0, fmt.Errorf("%w: cursor = %d", errs.ErrInvalidParameter, cursor)
+	}
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+	limit = min(limit, maxLimit)
+
+	// 多查一条用于判断是否还有下一页
+	messages, err := s.repo.List(ctx, bizID, receiver, onlyUnread, cursor, limit+1)
+	if err != nil {
+		return nil, 0, fmt.Errorf("%w: %w", errs.ErrDatabaseError, err)
+	}
+	if len(messages) <= limit {
+		return messages, 0, nil
+	}
+	messages = messages[:limit]
+	return messages, messages[limit-1].ID, nil
+}
+
+// GetUnreadCount 查询接收者的未读站内信数量
+func (s *inboxService) GetUnreadCount(ctx context.Context, bizID int64, receiver string) (int64, error) {
+	if receiver == "" {
+		return 0, fmt.Errorf("%w: 接收者不能为空", errs.ErrInvalidParameter)
+	}
+	cnt, err := s.repo.CountUnread(ctx, bizID, receiver)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", errs.ErrDatabaseError, err)
+	}
+	return cnt, nil
+}
+
+// MarkRead 将站内信标记为已读
+func (s *inboxService) MarkRead(ctx context.Context, bizID int64, receiver string, ids []int64) (int64, error) {
+	if receiver == "" {
+		return 0, fmt.Errorf("%w: 接收者不能为空", errs.ErrInvalidParameter)
+	}
+	affected, err := s.repo.MarkRead(ctx, bizID, receiver, ids)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", errs.ErrDatabaseError, err)
+	}
+	return affected, nil
+}
+
+// Delete 删除站内信
+func (s *inboxService) Delete(ctx context.Context, bizID int64, receiver string, ids []int64) (int64, error) {
+	if receiver == "" {
+		return 0, fmt.Errorf("%w: 接收者不能为空", errs.ErrInvalidParameter)
+	}
+	if len(ids) == 0 {
+		return 0, fmt.Errorf("%w: 站内信ID不能为空", errs.ErrInvalidParameter)
+	}
+	affected, err := s.repo.Delete(ctx, bizID, receiver, ids)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", errs.ErrDatabaseError, err)
+	}
+	return affected, nil
+}
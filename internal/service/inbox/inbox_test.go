@@ -0,0 +1,98 @@
+//go:build unit
+
+package inbox
+
+import (
+	"context"
+	"testing"
+
+	"github.com/robinlg/notification-platform/internal/domain"
+	"github.com/robinlg/notification-platform/internal/errs"
+	repositorymocks "github.com/robinlg/notification-platform/internal/repository/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestInboxService_List(t *testing.T) {
+	t.Parallel()
+
+	const (
+		bizID    = int64(1)
+		receiver = "user1"
+	)
+
+	testCases := []struct {
+		name           string
+		cursor         int64
+		limit          int
+		mock           func(ctrl *gomock.Controller) *repositorymocks.MockInboxRepository
+		wantIDs        []int64
+		wantNextCursor int64
+		wantErr        error
+	}{
+		{
+			name:   "还有下一页",
+			cursor: 10,
+			limit:  2,
+			mock: func(ctrl *gomock.Controller) *repositorymocks.MockInboxRepository {
+				repo := repositorymocks.NewMockInboxRepository(ctrl)
+				// 多查一条用于判断是否还有下一页
+				repo.EXPECT().List(gomock.Any(), bizID, receiver, false, int64(10), 3).
+					Return([]domain.InboxMessage{{ID: 9}, {ID: 7}, {ID: 5}}, nil)
+				return repo
+			},
+			wantIDs:        []int64{9, 7},
+			wantNextCursor: 7,
+		},
+		{
+			name:  "最后一页",
+			limit: 2,
+			mock: func(ctrl *gomock.Controller) *repositorymocks.MockInboxRepository {
+				repo := repositorymocks.NewMockInboxRepository(ctrl)
+				repo.EXPECT().List(gomock.Any(), bizID, receiver, false, int64(0), 3).
+					Return([]domain.InboxMessage{{ID: 9}, {ID: 7}}, nil)
+				return repo
+			},
+			wantIDs: []int64{9, 7},
+		},
+		{
+			name:  "使用默认每页数量",
+			limit: 0,
+			mock: func(ctrl *gomock.Controller) *repositorymocks.MockInboxRepository {
+				repo := repositorymocks.NewMockInboxRepository(ctrl)
+				repo.EXPECT().List(gomock.Any(), bizID, receiver, false, int64(0), defaultLimit+1).
+					Return(nil, nil)
+				return repo
+			},
+		},
+		{
+			name:   "非法游标",
+			cursor: -1,
+			mock: func(ctrl *gomock.Controller) *repositorymocks.MockInboxRepository {
+				return repositorymocks.NewMockInboxRepository(ctrl)
+			},
+			wantErr: errs.ErrInvalidParameter,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			svc := NewInboxService(tc.mock(ctrl))
+			messages, nextCursor, err := svc.List(context.Background(), bizID, receiver, false, tc.cursor, tc.limit)
+			assert.ErrorIs(t, err, tc.wantErr)
+			if err != nil {
+				return
+			}
+			ids := make([]int64, 0, len(messages))
+			for i := range messages {
+				ids = append(ids, messages[i].ID)
+			}
+			assert.ElementsMatch(t, tc.wantIDs, ids)
+			assert.Equal(t, tc.wantNextCursor, nextCursor)
+		})
+	}
+}
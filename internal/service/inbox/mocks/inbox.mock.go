@@ -0,0 +1,199 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./inbox.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./inbox.go -destination=./mocks/inbox.mock.go -package=inboxmocks -typed Service
+//
+
+// Package inboxmocks is a generated GoMock package.
+package inboxmocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/robinlg/notification-platform/internal/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockService is a mock of Service interface.
+type MockService struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockServiceMockRecorder is the mock recorder for MockService.
+type MockServiceMockRecorder struct {
+	mock *MockService
+}
+
+// NewMockService creates a new mock instance.
+func NewMockService(ctrl *gomock.Controller) *MockService {
+	mock := &MockService{ctrl: ctrl}
+	mock.recorder = &MockServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockService) EXPECT() *MockServiceMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockService) Delete(ctx context.Context, bizID int64, receiver string, ids []int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, bizID, receiver, ids)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockServiceMockRecorder) Delete(ctx, bizID, receiver, ids any) *MockServiceDeleteCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockService)(nil).Delete), ctx, bizID, receiver, ids)
+	return &MockServiceDeleteCall{Call: call}
+}
+
+// MockServiceDeleteCall wrap *gomock.Call
+type MockServiceDeleteCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceDeleteCall) Return(arg0 int64, arg1 error) *MockServiceDeleteCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceDeleteCall) Do(f func(context.Context, int64, string, []int64) (int64, error)) *MockServiceDeleteCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceDeleteCall) DoAndReturn(f func(context.Context, int64, string, []int64) (int64, error)) *MockServiceDeleteCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetUnreadCount mocks base method.
+func (m *MockService) GetUnreadCount(ctx context.Context, bizID int64, receiver string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnreadCount", ctx, bizID, receiver)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnreadCount indicates an expected call of GetUnreadCount.
+func (mr *MockServiceMockRecorder) GetUnreadCount(ctx, bizID, receiver any) *MockServiceGetUnreadCountCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnreadCount", reflect.TypeOf((*MockService)(nil).GetUnreadCount), ctx, bizID, receiver)
+	return &MockServiceGetUnreadCountCall{Call: call}
+}
+
+// MockServiceGetUnreadCountCall wrap *gomock.Call
+type MockServiceGetUnreadCountCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceGetUnreadCountCall) Return(arg0 int64, arg1 error) *MockServiceGetUnreadCountCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceGetUnreadCountCall) Do(f func(context.Context, int64, string) (int64, error)) *MockServiceGetUnreadCountCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceGetUnreadCountCall) DoAndReturn(f func(context.Context, int64, string) (int64, error)) *MockServiceGetUnreadCountCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockService) List(ctx context.Context, bizID int64, receiver string, onlyUnread bool, cursor int64, limit int) ([]domain.InboxMessage, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, bizID, receiver, onlyUnread, cursor, limit)
+	ret0, _ := ret[0].([]domain.InboxMessage)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockServiceMockRecorder) List(ctx, bizID, receiver, onlyUnread, cursor, limit any) *MockServiceListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockService)(nil).List), ctx, bizID, receiver, onlyUnread, cursor, limit)
+	return &MockServiceListCall{Call: call}
+}
+
+// MockServiceListCall wrap *gomock.Call
+type MockServiceListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceListCall) Return(messages []domain.InboxMessage, nextCursor int64, err error) *MockServiceListCall {
+	c.Call = c.Call.Return(messages, nextCursor, err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceListCall) Do(f func(context.Context, int64, string, bool, int64, int) ([]domain.InboxMessage, int64, error)) *MockServiceListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceListCall) DoAndReturn(f func(context.Context, int64, string, bool, int64, int) ([]domain.InboxMessage, int64, error)) *MockServiceListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MarkRead mocks base method.
+func (m *MockService) MarkRead(ctx context.Context, bizID int64, receiver string, ids []int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkRead", ctx, bizID, receiver, ids)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkRead indicates an expected call of MarkRead.
+func (mr *MockServiceMockRecorder) MarkRead(ctx, bizID, receiver, ids any) *MockServiceMarkReadCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkRead", reflect.TypeOf((*MockService)(nil).MarkRead), ctx, bizID, receiver, ids)
+	return &MockServiceMarkReadCall{Call: call}
+}
+
+// MockServiceMarkReadCall wrap *gomock.Call
+type MockServiceMarkReadCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceMarkReadCall) Return(arg0 int64, arg1 error) *MockServiceMarkReadCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceMarkReadCall) Do(f func(context.Context, int64, string, []int64) (int64, error)) *MockServiceMarkReadCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceMarkReadCall) DoAndReturn(f func(context.Context, int64, string, []int64) (int64, error)) *MockServiceMarkReadCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
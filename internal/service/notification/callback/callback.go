@@ -2,6 +2,7 @@ package callback
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -20,7 +21,12 @@ import (
 
 var _ Service = (*service)(nil)
 
+// Service 回调服务
+//
+//go:generate mockgen -source=./callback.go -destination=./mocks/callback.mock.go -package=callbackmocks -typed Service
 type Service interface {
+	// SendCallback 分批发送所有到了重试时间且处于待回调状态的回调
+	SendCallback(ctx context.Context, startTime, batchSize int64) error
 	SendCallbackByNotification(ctx context.Context, notification domain.Notification) error
 	SendCallbackByNotifications(ctx context.Context, notifications []domain.Notification) error
 }
@@ -45,6 +51,23 @@ func NewService(configSvc config.BusinessConfigService, repo repository.Callback
 	}
 }
 
+func (c *service) SendCallback(ctx context.Context, startTime, batchSize int64) error {
+	var startID int64
+	for {
+		logs, nextStartID, err := c.repo.Find(ctx, startTime, batchSize, startID)
+		if err != nil {
+			return err
+		}
+		if len(logs) == 0 {
+			return nil
+		}
+		if err = c.sendCallbackAndUpdateCallbackLogs(ctx, logs); err != nil {
+			return err
+		}
+		startID = nextStartID
+	}
+}
+
 func (c *service) SendCallbackByNotification(ctx context.Context, notification domain.Notification) error {
 	logs, err := c.repo.FindByNotificationIDs(ctx, []uint64{notification.ID})
 	if err != nil {
@@ -62,7 +85,6 @@ func (c *service) sendCallbackAndUpdateCallbackLogs(ctx context.Context, logs []
 				elog.FieldKey("Callback.ID"),
 				elog.FieldValueAny(logs[i].ID),
 				elog.FieldErr(err))
-			continue
 		}
 		if changed {
 			needUpdate = append(needUpdate, logs[i])
@@ -74,7 +96,13 @@ func (c *service) sendCallbackAndUpdateCallbackLogs(ctx context.Context, logs []
 func (c *service) sendCallbackAndSetChangedFields(ctx context.Context, log *domain.CallbackLog) (changed bool, err error) {
 	resp, err := c.sendCallback(ctx, log.Notification)
 	if err != nil {
-		return false, err
+		if errors.Is(err, errs.ErrConfigNotFound) {
+			// 业务方未提供回调配置，不可能回调成功
+			log.Status = domain.CallbackLogStatusFailed
+			return true, err
+		}
+		// 调用业务方失败，同样按照重试策略稍后重试
+		return c.setNextRetry(ctx, log), err
 	}
 
 	// 拿到业务方对回调的处理结果
@@ -83,9 +111,26 @@ func (c *service) sendCallbackAndSetChangedFields(ctx context.Context, log *doma
 		return true, nil
 	}
 
-	// 业务方对回调的处理失败，需要重试，此时业务方必定有配置
-	cfg, _ := c.getConfig(ctx, log.Notification.BizID)
-	retryStrategy, _ := retry.NewRetry(*cfg.RetryPolicy)
+	// 业务方对回调的处理失败，需要重试
+	return c.setNextRetry(ctx, log), nil
+}
+
+// setNextRetry 根据业务方的重试策略设置下次重试时间，没有重试策略或者达到最大重试次数时标记为失败
+func (c *service) setNextRetry(ctx context.Context, log *domain.CallbackLog) (changed bool) {
+	cfg, err := c.getConfig(ctx, log.Notification.BizID)
+	if err != nil {
+		// 暂时拿不到配置，保持原样等待下次重试
+		return false
+	}
+	if cfg == nil || cfg.RetryPolicy == nil {
+		log.Status = domain.CallbackLogStatusFailed
+		return true
+	}
+	retryStrategy, err := retry.NewRetry(*cfg.RetryPolicy)
+	if err != nil {
+		log.Status = domain.CallbackLogStatusFailed
+		return true
+	}
 	interval, ok := retryStrategy.NextWithRetries(log.RetryCount)
 	if ok {
 		// 未达到最大重试次数，状态不变但要更新下次重试时间和重试次数
@@ -95,7 +140,7 @@ func (c *service) sendCallbackAndSetChangedFields(ctx context.Context, log *doma
 		// 达到最大重试次数限制，不再重试，更新状态为失败
 		log.Status = domain.CallbackLogStatusFailed
 	}
-	return true, nil
+	return true
 }
 
 func (c *service) sendCallback(ctx context.Context, notification domain.Notification) (*clientv1.HandleNotificationResultResponse, error) {
@@ -134,6 +179,15 @@ func (c *service) buildRequest(notification domain.Notification) *clientv1.Handl
 	if notification.Template.Params != nil {
 		templateParams = notification.Template.Params
 	}
+	result := &notificationv1.SendNotificationResponse{
+		NotificationId:   notification.ID,
+		Status:           c.getStatus(notification),
+		DeliveredChannel: c.getDeliveredChannel(notification),
+	}
+	if notification.Status == domain.SendStatusExpired {
+		result.ErrorCode = notificationv1.ErrorCode_NOTIFICATION_EXPIRED
+		result.ErrorMessage = errs.ErrNotificationExpired.Error()
+	}
 	return &clientv1.HandleNotificationResultRequest{
 		NotificationId: notification.ID,
 		OriginalRequest: &notificationv1.SendNotificationRequest{
@@ -145,10 +199,7 @@ func (c *service) buildRequest(notification domain.Notification) *clientv1.Handl
 				TemplateParams: templateParams,
 			},
 		},
-		Result: &notificationv1.SendNotificationResponse{
-			NotificationId: notification.ID,
-			Status:         c.getStatus(notification),
-		},
+		Result: result,
 	}
 }
 
@@ -167,6 +218,16 @@ func (c *service) getChannel(notification domain.Notification) notificationv1.Ch
 	return channel
 }
 
+// getDeliveredChannel 获取实际送达的渠道，降级发送时与原始渠道不同
+func (c *service) getDeliveredChannel(notification domain.Notification) notificationv1.Channel {
+	if notification.DeliveredChannel == "" {
+		return notificationv1.Channel_CHANNEL_UNSPECIFIED
+	}
+	n := notification
+	n.Channel = notification.DeliveredChannel
+	return c.getChannel(n)
+}
+
 func (c *service) getStatus(notification domain.Notification) notificationv1.SendStatus {
 	var status notificationv1.SendStatus
 	switch notification.Status {
@@ -181,7 +242,9 @@ func (c *service) getStatus(notification domain.Notification) notificationv1.Sen
 	case domain.SendStatusPending:
 		status = notificationv1.SendStatus_PENDING
 	case domain.SendStatusSending:
-		status = notificationv1.SendStatus_SEND_STATUS_UNSPECIFIED
+		status = notificationv1.SendStatus_SENDING
+	case domain.SendStatusExpired:
+		status = notificationv1.SendStatus_EXPIRED
 	default:
 		status = notificationv1.SendStatus_SEND_STATUS_UNSPECIFIED
 	}
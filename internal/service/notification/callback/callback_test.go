@@ -0,0 +1,87 @@
+//go:build unit
+
+package callback
+
+import (
+	"context"
+	"testing"
+
+	"github.com/robinlg/notification-platform/internal/domain"
+	repositorymocks "github.com/robinlg/notification-platform/internal/repository/mocks"
+	configmocks "github.com/robinlg/notification-platform/internal/service/config/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestService_SendCallback(t *testing.T) {
+	t.Parallel()
+
+	const (
+		bizID     = int64(1)
+		startTime = int64(100)
+		batchSize = int64(2)
+	)
+
+	testCases := []struct {
+		name    string
+		mock    func(ctrl *gomock.Controller) (*configmocks.MockBusinessConfigService, *repositorymocks.MockCallbackLogRepository)
+		wantErr error
+	}{
+		{
+			name: "分批处理直到没有待回调记录",
+			mock: func(ctrl *gomock.Controller) (*configmocks.MockBusinessConfigService, *repositorymocks.MockCallbackLogRepository) {
+				configSvc := configmocks.NewMockBusinessConfigService(ctrl)
+				repo := repositorymocks.NewMockCallbackLogRepository(ctrl)
+				// 业务方没有回调配置，不可能回调成功，直接标记为失败
+				configSvc.EXPECT().GetByID(gomock.Any(), bizID).Return(domain.BusinessConfig{ID: bizID}, nil).Times(3)
+				first := []domain.CallbackLog{
+					{ID: 1, Notification: domain.Notification{ID: 10, BizID: bizID}, Status: domain.CallbackLogStatusPending},
+					{ID: 2, Notification: domain.Notification{ID: 20, BizID: bizID}, Status: domain.CallbackLogStatusPending},
+				}
+				second := []domain.CallbackLog{
+					{ID: 3, Notification: domain.Notification{ID: 30, BizID: bizID}, Status: domain.CallbackLogStatusPending},
+				}
+				gomock.InOrder(
+					repo.EXPECT().Find(gomock.Any(), startTime, batchSize, int64(0)).Return(first, int64(2), nil),
+					repo.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, logs []domain.CallbackLog) error {
+						assert.Len(t, logs, 2)
+						for i := range logs {
+							assert.Equal(t, domain.CallbackLogStatusFailed, logs[i].Status)
+						}
+						return nil
+					}),
+					repo.EXPECT().Find(gomock.Any(), startTime, batchSize, int64(2)).Return(second, int64(3), nil),
+					repo.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, logs []domain.CallbackLog) error {
+						assert.Len(t, logs, 1)
+						assert.Equal(t, domain.CallbackLogStatusFailed, logs[0].Status)
+						return nil
+					}),
+					repo.EXPECT().Find(gomock.Any(), startTime, batchSize, int64(3)).Return(nil, int64(0), nil),
+				)
+				return configSvc, repo
+			},
+		},
+		{
+			name: "查询失败",
+			mock: func(ctrl *gomock.Controller) (*configmocks.MockBusinessConfigService, *repositorymocks.MockCallbackLogRepository) {
+				configSvc := configmocks.NewMockBusinessConfigService(ctrl)
+				repo := repositorymocks.NewMockCallbackLogRepository(ctrl)
+				repo.EXPECT().Find(gomock.Any(), startTime, batchSize, int64(0)).Return(nil, int64(0), assert.AnError)
+				return configSvc, repo
+			},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			svc := NewService(tc.mock(ctrl))
+			err := svc.SendCallback(context.Background(), startTime, batchSize)
+			assert.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
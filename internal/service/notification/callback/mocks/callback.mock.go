@@ -0,0 +1,156 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./callback.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./callback.go -destination=./mocks/callback.mock.go -package=callbackmocks -typed Service
+//
+
+// Package callbackmocks is a generated GoMock package.
+package callbackmocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/robinlg/notification-platform/internal/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockService is a mock of Service interface.
+type MockService struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockServiceMockRecorder is the mock recorder for MockService.
+type MockServiceMockRecorder struct {
+	mock *MockService
+}
+
+// NewMockService creates a new mock instance.
+func NewMockService(ctrl *gomock.Controller) *MockService {
+	mock := &MockService{ctrl: ctrl}
+	mock.recorder = &MockServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockService) EXPECT() *MockServiceMockRecorder {
+	return m.recorder
+}
+
+// SendCallback mocks base method.
+func (m *MockService) SendCallback(ctx context.Context, startTime, batchSize int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendCallback", ctx, startTime, batchSize)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendCallback indicates an expected call of SendCallback.
+func (mr *MockServiceMockRecorder) SendCallback(ctx, startTime, batchSize any) *MockServiceSendCallbackCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCallback", reflect.TypeOf((*MockService)(nil).SendCallback), ctx, startTime, batchSize)
+	return &MockServiceSendCallbackCall{Call: call}
+}
+
+// MockServiceSendCallbackCall wrap *gomock.Call
+type MockServiceSendCallbackCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceSendCallbackCall) Return(arg0 error) *MockServiceSendCallbackCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceSendCallbackCall) Do(f func(context.Context, int64, int64) error) *MockServiceSendCallbackCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceSendCallbackCall) DoAndReturn(f func(context.Context, int64, int64) error) *MockServiceSendCallbackCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SendCallbackByNotification mocks base method.
+func (m *MockService) SendCallbackByNotification(ctx context.Context, notification domain.Notification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendCallbackByNotification", ctx, notification)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendCallbackByNotification indicates an expected call of SendCallbackByNotification.
+func (mr *MockServiceMockRecorder) SendCallbackByNotification(ctx, notification any) *MockServiceSendCallbackByNotificationCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCallbackByNotification", reflect.TypeOf((*MockService)(nil).SendCallbackByNotification), ctx, notification)
+	return &MockServiceSendCallbackByNotificationCall{Call: call}
+}
+
+// MockServiceSendCallbackByNotificationCall wrap *gomock.Call
+type MockServiceSendCallbackByNotificationCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceSendCallbackByNotificationCall) Return(arg0 error) *MockServiceSendCallbackByNotificationCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceSendCallbackByNotificationCall) Do(f func(context.Context, domain.Notification) error) *MockServiceSendCallbackByNotificationCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceSendCallbackByNotificationCall) DoAndReturn(f func(context.Context, domain.Notification) error) *MockServiceSendCallbackByNotificationCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SendCallbackByNotifications mocks base method.
+func (m *MockService) SendCallbackByNotifications(ctx context.Context, notifications []domain.Notification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendCallbackByNotifications", ctx, notifications)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendCallbackByNotifications indicates an expected call of SendCallbackByNotifications.
+func (mr *MockServiceMockRecorder) SendCallbackByNotifications(ctx, notifications any) *MockServiceSendCallbackByNotificationsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCallbackByNotifications", reflect.TypeOf((*MockService)(nil).SendCallbackByNotifications), ctx, notifications)
+	return &MockServiceSendCallbackByNotificationsCall{Call: call}
+}
+
+// MockServiceSendCallbackByNotificationsCall wrap *gomock.Call
+type MockServiceSendCallbackByNotificationsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceSendCallbackByNotificationsCall) Return(arg0 error) *MockServiceSendCallbackByNotificationsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceSendCallbackByNotificationsCall) Do(f func(context.Context, []domain.Notification) error) *MockServiceSendCallbackByNotificationsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceSendCallbackByNotificationsCall) DoAndReturn(f func(context.Context, []domain.Notification) error) *MockServiceSendCallbackByNotificationsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,46 @@
+package callback
+
+import (
+	"context"
+	"time"
+
+	"github.com/meoying/dlock-go"
+	"github.com/robinlg/notification-platform/internal/pkg/loopjob"
+)
+
+const (
+	CallbackTaskKey         = "callback_job"
+	defaultCallbackTimeout  = 30 * time.Second
+	defaultCallbackInterval = time.Second
+	defaultBatchSize        = 10
+)
+
+// CallbackTask 定时扫描待回调的记录，回调业务方并根据结果更新回调记录
+type CallbackTask struct {
+	svc       Service
+	lock      dlock.Client
+	batchSize int64
+}
+
+func NewCallbackTask(svc Service, lock dlock.Client) *CallbackTask {
+	return &CallbackTask{
+		svc:       svc,
+		lock:      lock,
+		batchSize: defaultBatchSize,
+	}
+}
+
+func (c *CallbackTask) Start(ctx context.Context) {
+	job := loopjob.NewInfiniteLoop(c.lock, c.oneLoop, CallbackTaskKey)
+	job.Run(ctx)
+}
+
+func (c *CallbackTask) oneLoop(ctx context.Context) error {
+	loopCtx, cancel := context.WithTimeout(ctx, defaultCallbackTimeout)
+	defer cancel()
+
+	err := c.svc.SendCallback(loopCtx, time.Now().UnixMilli(), c.batchSize)
+	// 避免立刻又调度
+	time.Sleep(defaultCallbackInterval)
+	return err
+}
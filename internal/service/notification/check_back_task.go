@@ -18,23 +18,34 @@ import (
 	"github.com/robinlg/notification-platform/internal/pkg/grpc"
 	"github.com/robinlg/notification-platform/internal/pkg/loopjob"
 	"github.com/robinlg/notification-platform/internal/repository"
+	"github.com/robinlg/notification-platform/internal/repository/cache"
 	"github.com/robinlg/notification-platform/internal/service/config"
 	"golang.org/x/sync/errgroup"
 )
 
 type TxCheckTask struct {
 	repo      repository.TxNotificationRepository
+	notiRepo  repository.NotificationRepository
 	configSvc config.BusinessConfigService
 	logger    *elog.Component
 	lock      dlock.Client
 	batchSize int
 	clients   *grpc.Clients[clientv1.TransactionCheckServiceClient]
+	// queue 延迟队列，为 nil 时回查提交的通知依赖调度器扫描数据库
+	queue cache.DelayQueue
 }
 
-func NewTxCheckTask(repo repository.TxNotificationRepository, configSvc config.BusinessConfigService, lock dlock.Client) *TxCheckTask {
+func NewTxCheckTask(repo repository.TxNotificationRepository, notiRepo repository.NotificationRepository,
+	configSvc config.BusinessConfigService, lock dlock.Client, queue cache.DelayQueue,
+) *TxCheckTask {
 	return &TxCheckTask{
 		repo:      repo,
+		notiRepo:  notiRepo,
 		configSvc: configSvc,
+		lock:      lock,
+		queue:     queue,
+		batchSize: defaultBatchSize,
+		logger:    elog.DefaultLogger,
 		clients: grpc.NewClients[clientv1.TransactionCheckServiceClient](func(conn *egrpc.Component) clientv1.TransactionCheckServiceClient {
 			return clientv1.NewTransactionCheckServiceClient(conn)
 		}),
@@ -54,14 +65,19 @@ func (task *TxCheckTask) Start(ctx context.Context) {
 	job.Run(ctx)
 }
 
+// NewTask 创建事务回查任务，queue 可以为 nil
 func NewTask(repo repository.TxNotificationRepository,
+	notiRepo repository.NotificationRepository,
 	configSvc config.BusinessConfigService,
 	lock dlock.Client,
+	queue cache.DelayQueue,
 ) *TxCheckTask {
 	return &TxCheckTask{
 		repo:      repo,
+		notiRepo:  notiRepo,
 		configSvc: configSvc,
 		lock:      lock,
+		queue:     queue,
 		batchSize: defaultBatchSize,
 		logger:    elog.DefaultLogger,
 		clients: grpc.NewClients[clientv1.TransactionCheckServiceClient](func(conn *egrpc.Component) clientv1.TransactionCheckServiceClient {
@@ -140,10 +156,39 @@ func (task *TxCheckTask) oneLoop(ctx context.Context) error {
 	err = task.updateStatus(loopCtx, retryTxns, domain.SendStatusPrepare)
 	err = multierror.Append(err, task.updateStatus(loopCtx, failTxns, domain.SendStatusFailed))
 	// 转 PENDING，后续 Scheduler 会调度执行
-	err = multierror.Append(err, task.updateStatus(loopCtx, commitTxns, domain.SendStatusPending))
+	commitErr := task.updateStatus(loopCtx, commitTxns, domain.SendStatusPending)
+	if commitErr == nil {
+		task.enqueue(loopCtx, commitTxns.AsSlice())
+	}
+	err = multierror.Append(err, commitErr)
 	return err
 }
 
+// enqueue 将回查提交的通知加入延迟队列，事务通知只记录了通知ID，需要先查出计划发送时间和优先级
+// 查询或者加入失败时不影响回查结果，调度器兜底扫描数据库时依旧能够发现这些通知
+func (task *TxCheckTask) enqueue(ctx context.Context, txns []domain.TxNotification) {
+	if task.queue == nil || len(txns) == 0 {
+		return
+	}
+	ids := slice.Map(txns, func(_ int, src domain.TxNotification) uint64 {
+		return src.Notification.ID
+	})
+	notificationMap, err := task.notiRepo.BatchGetByIDs(ctx, ids)
+	if err != nil {
+		task.logger.Warn("查询回查提交的通知失败", elog.Any("notificationIDs", ids), elog.FieldErr(err))
+		return
+	}
+	notifications := make([]domain.Notification, 0, len(notificationMap))
+	for _, n := range notificationMap {
+		if n.Status == domain.SendStatusPending {
+			notifications = append(notifications, n)
+		}
+	}
+	if err = task.queue.Add(ctx, notifications...); err != nil {
+		task.logger.Warn("通知加入延迟队列失败", elog.Int("count", len(notifications)), elog.FieldErr(err))
+	}
+}
+
 func (task *TxCheckTask) oneBackCheck(ctx context.Context, configMap map[int64]domain.BusinessConfig, txNotification domain.TxNotification) domain.TxNotification {
 	bizConfig, ok := configMap[txNotification.BizID]
 	if !ok || bizConfig.TxnConfig == nil {
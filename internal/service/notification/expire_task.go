@@ -0,0 +1,79 @@
+package notification
+
+import (
+	"context"
+	"time"
+
+	"github.com/gotomicro/ego/core/elog"
+	"github.com/meoying/dlock-go"
+	"github.com/robinlg/notification-platform/internal/pkg/loopjob"
+	"github.com/robinlg/notification-platform/internal/repository"
+	"github.com/robinlg/notification-platform/internal/service/notification/callback"
+)
+
+const (
+	ExpirePendingTaskKey        = "expire_pending_job"
+	defaultExpirePendingTimeout = 5 * time.Second
+
+	pendingExpiredReason = "超出计划发送时间仍未发送"
+)
+
+// ExpirePendingTask 将超出计划发送时间仍处于 PENDING 状态的通知标记为已过期
+// 调度器停机或者积压时，这些通知再也不会被调度，需要归还额度并回调业务方
+type ExpirePendingTask struct {
+	repo        repository.NotificationRepository
+	callbackSvc callback.Service
+	lock        dlock.Client
+	batchSize   int
+	logger      *elog.Component
+}
+
+func NewExpirePendingTask(repo repository.NotificationRepository, callbackSvc callback.Service, lock dlock.Client) *ExpirePendingTask {
+	return &ExpirePendingTask{
+		repo:        repo,
+		callbackSvc: callbackSvc,
+		lock:        lock,
+		batchSize:   defaultBatchSize,
+		logger:      elog.DefaultLogger,
+	}
+}
+
+func (task *ExpirePendingTask) Start(ctx context.Context) {
+	job := loopjob.NewInfiniteLoop(task.lock, task.oneLoop, ExpirePendingTaskKey)
+	job.Run(ctx)
+}
+
+func (task *ExpirePendingTask) oneLoop(ctx context.Context) error {
+	loopCtx, cancel := context.WithTimeout(ctx, defaultExpirePendingTimeout)
+	defer cancel()
+
+	notifications, err := task.repo.FindExpiredPending(loopCtx, task.batchSize)
+	if err != nil {
+		return err
+	}
+
+	if len(notifications) == 0 {
+		// 避免立刻又调度
+		time.Sleep(time.Second)
+		return nil
+	}
+
+	for i := range notifications {
+		notifications[i].StatusReason = pendingExpiredReason
+	}
+	expired, err := task.repo.CASMarkExpired(loopCtx, notifications)
+	if err != nil {
+		return err
+	}
+	if len(expired) == 0 {
+		return nil
+	}
+	task.logger.Info("通知已过期", elog.Int("count", len(expired)))
+
+	// 过期是确定的发送结果，回调业务方
+	err = task.callbackSvc.SendCallbackByNotifications(loopCtx, expired)
+	if err != nil {
+		task.logger.Warn("通知过期回调业务方失败", elog.FieldErr(err))
+	}
+	return nil
+}
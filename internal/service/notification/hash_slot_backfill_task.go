@@ -0,0 +1,63 @@
+package notification
+
+import (
+	"context"
+	"time"
+
+	"github.com/gotomicro/ego/core/elog"
+	"github.com/meoying/dlock-go"
+	"github.com/robinlg/notification-platform/internal/pkg/loopjob"
+	"github.com/robinlg/notification-platform/internal/repository"
+)
+
+const (
+	HashSlotBackfillTaskKey        = "hash_slot_backfill_job"
+	defaultHashSlotBackfillTimeout = 5 * time.Second
+	defaultHashSlotBackfillBatch   = 500
+)
+
+// HashSlotBackfillTask 为引入分区之前创建的通知回填 hash_slot
+// 这些通知的 hash_slot 默认为 0，全部落在第一个分区中，回填之后才会分散到各个分区，全部回填完成后任务结束
+type HashSlotBackfillTask struct {
+	repo      repository.NotificationRepository
+	lock      dlock.Client
+	batchSize int
+	// startID 下一批的起始ID，切换到其他实例执行时从头开始，已经回填的通知不会再被处理
+	startID uint64
+	cancel  context.CancelFunc
+	logger  *elog.Component
+}
+
+func NewHashSlotBackfillTask(repo repository.NotificationRepository, lock dlock.Client) *HashSlotBackfillTask {
+	return &HashSlotBackfillTask{
+		repo:      repo,
+		lock:      lock,
+		batchSize: defaultHashSlotBackfillBatch,
+		logger:    elog.DefaultLogger,
+	}
+}
+
+// Start 回填完成或者 ctx 被取消时返回
+func (task *HashSlotBackfillTask) Start(ctx context.Context) {
+	ctx, task.cancel = context.WithCancel(ctx)
+	defer task.cancel()
+	job := loopjob.NewInfiniteLoop(task.lock, task.oneLoop, HashSlotBackfillTaskKey)
+	job.Run(ctx)
+}
+
+func (task *HashSlotBackfillTask) oneLoop(ctx context.Context) error {
+	loopCtx, cancel := context.WithTimeout(ctx, defaultHashSlotBackfillTimeout)
+	defer cancel()
+
+	next, err := task.repo.BackfillHashSlot(loopCtx, task.startID, task.batchSize)
+	if err != nil {
+		return err
+	}
+	if next == 0 {
+		task.logger.Info("hash_slot 回填完成")
+		task.cancel()
+		return nil
+	}
+	task.startID = next
+	return nil
+}
@@ -41,19 +41,136 @@ func (m *MockService) EXPECT() *MockServiceMockRecorder {
 	return m.recorder
 }
 
+// BatchGetByKeys mocks base method.
+func (m *MockService) BatchGetByKeys(ctx context.Context, bizID int64, keys []string) (map[string]domain.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchGetByKeys", ctx, bizID, keys)
+	ret0, _ := ret[0].(map[string]domain.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchGetByKeys indicates an expected call of BatchGetByKeys.
+func (mr *MockServiceMockRecorder) BatchGetByKeys(ctx, bizID, keys any) *MockServiceBatchGetByKeysCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchGetByKeys", reflect.TypeOf((*MockService)(nil).BatchGetByKeys), ctx, bizID, keys)
+	return &MockServiceBatchGetByKeysCall{Call: call}
+}
+
+// MockServiceBatchGetByKeysCall wrap *gomock.Call
+type MockServiceBatchGetByKeysCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceBatchGetByKeysCall) Return(arg0 map[string]domain.Notification, arg1 error) *MockServiceBatchGetByKeysCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceBatchGetByKeysCall) Do(f func(context.Context, int64, []string) (map[string]domain.Notification, error)) *MockServiceBatchGetByKeysCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceBatchGetByKeysCall) DoAndReturn(f func(context.Context, int64, []string) (map[string]domain.Notification, error)) *MockServiceBatchGetByKeysCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Cancel mocks base method.
+func (m *MockService) Cancel(ctx context.Context, bizID int64, key string) (domain.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cancel", ctx, bizID, key)
+	ret0, _ := ret[0].(domain.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Cancel indicates an expected call of Cancel.
+func (mr *MockServiceMockRecorder) Cancel(ctx, bizID, key any) *MockServiceCancelCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockService)(nil).Cancel), ctx, bizID, key)
+	return &MockServiceCancelCall{Call: call}
+}
+
+// MockServiceCancelCall wrap *gomock.Call
+type MockServiceCancelCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceCancelCall) Return(arg0 domain.Notification, arg1 error) *MockServiceCancelCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceCancelCall) Do(f func(context.Context, int64, string) (domain.Notification, error)) *MockServiceCancelCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceCancelCall) DoAndReturn(f func(context.Context, int64, string) (domain.Notification, error)) *MockServiceCancelCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindReadyByIDs mocks base method.
+func (m *MockService) FindReadyByIDs(ctx context.Context, ids []uint64) ([]domain.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindReadyByIDs", ctx, ids)
+	ret0, _ := ret[0].([]domain.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindReadyByIDs indicates an expected call of FindReadyByIDs.
+func (mr *MockServiceMockRecorder) FindReadyByIDs(ctx, ids any) *MockServiceFindReadyByIDsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindReadyByIDs", reflect.TypeOf((*MockService)(nil).FindReadyByIDs), ctx, ids)
+	return &MockServiceFindReadyByIDsCall{Call: call}
+}
+
+// MockServiceFindReadyByIDsCall wrap *gomock.Call
+type MockServiceFindReadyByIDsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceFindReadyByIDsCall) Return(arg0 []domain.Notification, arg1 error) *MockServiceFindReadyByIDsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceFindReadyByIDsCall) Do(f func(context.Context, []uint64) ([]domain.Notification, error)) *MockServiceFindReadyByIDsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceFindReadyByIDsCall) DoAndReturn(f func(context.Context, []uint64) ([]domain.Notification, error)) *MockServiceFindReadyByIDsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // FindReadyNotifications mocks base method.
-func (m *MockService) FindReadyNotifications(ctx context.Context, offset, limit int) ([]domain.Notification, error) {
+func (m *MockService) FindReadyNotifications(ctx context.Context, partition domain.Partition, priority domain.Priority, offset, limit int) ([]domain.Notification, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindReadyNotifications", ctx, offset, limit)
+	ret := m.ctrl.Call(m, "FindReadyNotifications", ctx, partition, priority, offset, limit)
 	ret0, _ := ret[0].([]domain.Notification)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindReadyNotifications indicates an expected call of FindReadyNotifications.
-func (mr *MockServiceMockRecorder) FindReadyNotifications(ctx, offset, limit any) *MockServiceFindReadyNotificationsCall {
+func (mr *MockServiceMockRecorder) FindReadyNotifications(ctx, partition, priority, offset, limit any) *MockServiceFindReadyNotificationsCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindReadyNotifications", reflect.TypeOf((*MockService)(nil).FindReadyNotifications), ctx, offset, limit)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindReadyNotifications", reflect.TypeOf((*MockService)(nil).FindReadyNotifications), ctx, partition, priority, offset, limit)
 	return &MockServiceFindReadyNotificationsCall{Call: call}
 }
 
@@ -69,13 +186,249 @@ func (c *MockServiceFindReadyNotificationsCall) Return(arg0 []domain.Notificatio
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockServiceFindReadyNotificationsCall) Do(f func(context.Context, int, int) ([]domain.Notification, error)) *MockServiceFindReadyNotificationsCall {
+func (c *MockServiceFindReadyNotificationsCall) Do(f func(context.Context, domain.Partition, domain.Priority, int, int) ([]domain.Notification, error)) *MockServiceFindReadyNotificationsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceFindReadyNotificationsCall) DoAndReturn(f func(context.Context, domain.Partition, domain.Priority, int, int) ([]domain.Notification, error)) *MockServiceFindReadyNotificationsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByKey mocks base method.
+func (m *MockService) GetByKey(ctx context.Context, bizID int64, key string) (domain.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByKey", ctx, bizID, key)
+	ret0, _ := ret[0].(domain.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByKey indicates an expected call of GetByKey.
+func (mr *MockServiceMockRecorder) GetByKey(ctx, bizID, key any) *MockServiceGetByKeyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByKey", reflect.TypeOf((*MockService)(nil).GetByKey), ctx, bizID, key)
+	return &MockServiceGetByKeyCall{Call: call}
+}
+
+// MockServiceGetByKeyCall wrap *gomock.Call
+type MockServiceGetByKeyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceGetByKeyCall) Return(arg0 domain.Notification, arg1 error) *MockServiceGetByKeyCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceGetByKeyCall) Do(f func(context.Context, int64, string) (domain.Notification, error)) *MockServiceGetByKeyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceGetByKeyCall) DoAndReturn(f func(context.Context, int64, string) (domain.Notification, error)) *MockServiceGetByKeyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockService) List(ctx context.Context, filter domain.NotificationFilter, cursor uint64, limit int) ([]domain.Notification, uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, filter, cursor, limit)
+	ret0, _ := ret[0].([]domain.Notification)
+	ret1, _ := ret[1].(uint64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockServiceMockRecorder) List(ctx, filter, cursor, limit any) *MockServiceListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockService)(nil).List), ctx, filter, cursor, limit)
+	return &MockServiceListCall{Call: call}
+}
+
+// MockServiceListCall wrap *gomock.Call
+type MockServiceListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceListCall) Return(ns []domain.Notification, nextCursor uint64, err error) *MockServiceListCall {
+	c.Call = c.Call.Return(ns, nextCursor, err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceListCall) Do(f func(context.Context, domain.NotificationFilter, uint64, int) ([]domain.Notification, uint64, error)) *MockServiceListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceListCall) DoAndReturn(f func(context.Context, domain.NotificationFilter, uint64, int) ([]domain.Notification, uint64, error)) *MockServiceListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MarkSending mocks base method.
+func (m *MockService) MarkSending(ctx context.Context, notifications []domain.Notification) ([]domain.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkSending", ctx, notifications)
+	ret0, _ := ret[0].([]domain.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkSending indicates an expected call of MarkSending.
+func (mr *MockServiceMockRecorder) MarkSending(ctx, notifications any) *MockServiceMarkSendingCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkSending", reflect.TypeOf((*MockService)(nil).MarkSending), ctx, notifications)
+	return &MockServiceMarkSendingCall{Call: call}
+}
+
+// MockServiceMarkSendingCall wrap *gomock.Call
+type MockServiceMarkSendingCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceMarkSendingCall) Return(arg0 []domain.Notification, arg1 error) *MockServiceMarkSendingCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceMarkSendingCall) Do(f func(context.Context, []domain.Notification) ([]domain.Notification, error)) *MockServiceMarkSendingCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceMarkSendingCall) DoAndReturn(f func(context.Context, []domain.Notification) ([]domain.Notification, error)) *MockServiceMarkSendingCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReadStatusEvents mocks base method.
+func (m *MockService) ReadStatusEvents(ctx context.Context, bizID int64, cursor string, limit int) ([]domain.NotificationStatusEvent, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadStatusEvents", ctx, bizID, cursor, limit)
+	ret0, _ := ret[0].([]domain.NotificationStatusEvent)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ReadStatusEvents indicates an expected call of ReadStatusEvents.
+func (mr *MockServiceMockRecorder) ReadStatusEvents(ctx, bizID, cursor, limit any) *MockServiceReadStatusEventsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadStatusEvents", reflect.TypeOf((*MockService)(nil).ReadStatusEvents), ctx, bizID, cursor, limit)
+	return &MockServiceReadStatusEventsCall{Call: call}
+}
+
+// MockServiceReadStatusEventsCall wrap *gomock.Call
+type MockServiceReadStatusEventsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceReadStatusEventsCall) Return(arg0 []domain.NotificationStatusEvent, arg1 string, arg2 error) *MockServiceReadStatusEventsCall {
+	c.Call = c.Call.Return(arg0, arg1, arg2)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceReadStatusEventsCall) Do(f func(context.Context, int64, string, int) ([]domain.NotificationStatusEvent, string, error)) *MockServiceReadStatusEventsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceReadStatusEventsCall) DoAndReturn(f func(context.Context, int64, string, int) ([]domain.NotificationStatusEvent, string, error)) *MockServiceReadStatusEventsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Resend mocks base method.
+func (m *MockService) Resend(ctx context.Context, n domain.Notification, immediately bool) (domain.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resend", ctx, n, immediately)
+	ret0, _ := ret[0].(domain.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Resend indicates an expected call of Resend.
+func (mr *MockServiceMockRecorder) Resend(ctx, n, immediately any) *MockServiceResendCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resend", reflect.TypeOf((*MockService)(nil).Resend), ctx, n, immediately)
+	return &MockServiceResendCall{Call: call}
+}
+
+// MockServiceResendCall wrap *gomock.Call
+type MockServiceResendCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceResendCall) Return(arg0 domain.Notification, arg1 error) *MockServiceResendCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceResendCall) Do(f func(context.Context, domain.Notification, bool) (domain.Notification, error)) *MockServiceResendCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceResendCall) DoAndReturn(f func(context.Context, domain.Notification, bool) (domain.Notification, error)) *MockServiceResendCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdatePending mocks base method.
+func (m *MockService) UpdatePending(ctx context.Context, bizID int64, key string, strategy *domain.SendStrategyConfig, params map[string]string) (domain.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePending", ctx, bizID, key, strategy, params)
+	ret0, _ := ret[0].(domain.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePending indicates an expected call of UpdatePending.
+func (mr *MockServiceMockRecorder) UpdatePending(ctx, bizID, key, strategy, params any) *MockServiceUpdatePendingCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePending", reflect.TypeOf((*MockService)(nil).UpdatePending), ctx, bizID, key, strategy, params)
+	return &MockServiceUpdatePendingCall{Call: call}
+}
+
+// MockServiceUpdatePendingCall wrap *gomock.Call
+type MockServiceUpdatePendingCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceUpdatePendingCall) Return(arg0 domain.Notification, arg1 error) *MockServiceUpdatePendingCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceUpdatePendingCall) Do(f func(context.Context, int64, string, *domain.SendStrategyConfig, map[string]string) (domain.Notification, error)) *MockServiceUpdatePendingCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockServiceFindReadyNotificationsCall) DoAndReturn(f func(context.Context, int, int) ([]domain.Notification, error)) *MockServiceFindReadyNotificationsCall {
+func (c *MockServiceUpdatePendingCall) DoAndReturn(f func(context.Context, int64, string, *domain.SendStrategyConfig, map[string]string) (domain.Notification, error)) *MockServiceUpdatePendingCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -129,6 +129,45 @@ func (c *MockSendServiceBatchSendNotificationsAsyncCall) DoAndReturn(f func(cont
 	return c
 }
 
+// ResendNotification mocks base method.
+func (m *MockSendService) ResendNotification(ctx context.Context, bizID int64, key string, immediately bool) (domain.SendResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResendNotification", ctx, bizID, key, immediately)
+	ret0, _ := ret[0].(domain.SendResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResendNotification indicates an expected call of ResendNotification.
+func (mr *MockSendServiceMockRecorder) ResendNotification(ctx, bizID, key, immediately any) *MockSendServiceResendNotificationCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendNotification", reflect.TypeOf((*MockSendService)(nil).ResendNotification), ctx, bizID, key, immediately)
+	return &MockSendServiceResendNotificationCall{Call: call}
+}
+
+// MockSendServiceResendNotificationCall wrap *gomock.Call
+type MockSendServiceResendNotificationCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSendServiceResendNotificationCall) Return(arg0 domain.SendResponse, arg1 error) *MockSendServiceResendNotificationCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSendServiceResendNotificationCall) Do(f func(context.Context, int64, string, bool) (domain.SendResponse, error)) *MockSendServiceResendNotificationCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSendServiceResendNotificationCall) DoAndReturn(f func(context.Context, int64, string, bool) (domain.SendResponse, error)) *MockSendServiceResendNotificationCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // SendNotification mocks base method.
 func (m *MockSendService) SendNotification(ctx context.Context, n domain.Notification) (domain.SendResponse, error) {
 	m.ctrl.T.Helper()
@@ -2,32 +2,334 @@ package notification
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"time"
 
+	"github.com/ego-component/egorm"
+	"github.com/gotomicro/ego/core/elog"
 	"github.com/robinlg/notification-platform/internal/domain"
+	"github.com/robinlg/notification-platform/internal/errs"
 	"github.com/robinlg/notification-platform/internal/repository"
+	"github.com/robinlg/notification-platform/internal/repository/cache"
+	"github.com/robinlg/notification-platform/internal/service/notification/callback"
+	"github.com/robinlg/notification-platform/internal/service/template/manage"
+)
+
+const (
+	canceledByBizReason = "业务方取消"
+	// statusEventsBlock 读取状态变更事件时没有新事件的最长等待时间
+	statusEventsBlock = 5 * time.Second
+	// defaultListLimit 查询通知列表时未指定数量的默认值
+	defaultListLimit = 20
+	// maxListLimit 查询通知列表时每页的最大数量
+	maxListLimit = 100
 )
 
 // Service 通知服务接口
 //
 //go:generate mockgen -source=./notification.go -destination=./mocks/notification.mock.go -package=notificationmocks -typed Service
 type Service interface {
-	// FindReadyNotifications 准备好调度发送的通知
-	FindReadyNotifications(ctx context.Context, offset, limit int) ([]domain.Notification, error)
+	// FindReadyNotifications 准备好调度发送的指定分区、指定优先级的通知
+	FindReadyNotifications(ctx context.Context, partition domain.Partition, priority domain.Priority, offset, limit int) ([]domain.Notification, error)
+	// FindReadyByIDs 从指定的通知ID中找出准备好调度发送的通知
+	FindReadyByIDs(ctx context.Context, ids []uint64) ([]domain.Notification, error)
+	// MarkSending 发送之前将等待发送的通知标记为发送中，返回实际标记成功的通知，只有这些通知可以发送
+	// 查询之后被取消、修改或者被其他调度器标记的通知会被跳过
+	MarkSending(ctx context.Context, notifications []domain.Notification) ([]domain.Notification, error)
+	// GetByKey 根据业务ID和业务内唯一标识获取通知
+	GetByKey(ctx context.Context, bizID int64, key string) (domain.Notification, error)
+	// BatchGetByKeys 根据业务ID和业务内唯一标识批量获取通知，返回以 key 为键的映射，不存在的 key 不会出现在结果中
+	BatchGetByKeys(ctx context.Context, bizID int64, keys []string) (map[string]domain.Notification, error)
+	// Cancel 取消等待发送的通知，返回通知的最新状态
+	// 通知正在发送或者已经有结果时返回 errs.ErrInvalidNotificationStatus
+	Cancel(ctx context.Context, bizID int64, key string) (domain.Notification, error)
+	// UpdatePending 修改等待发送的通知，strategy 为 nil 时不修改计划发送时间，params 为 nil 时不修改模版参数
+	// 通知已经开始发送或者已经有结果时返回 errs.ErrInvalidNotificationStatus
+	UpdatePending(ctx context.Context, bizID int64, key string, strategy *domain.SendStrategyConfig, params map[string]string) (domain.Notification, error)
+	// Resend 将发送失败的通知重新置为待发送，immediately 为 true 时置为发送中，由调用方立刻发送
+	// 返回更新之后的通知，通知不是发送失败状态时返回 errs.ErrInvalidNotificationStatus
+	Resend(ctx context.Context, n domain.Notification, immediately bool) (domain.Notification, error)
+	// ReadStatusEvents 读取业务方在 cursor 之后的通知状态变更事件，cursor 为空时从当前最新的位置开始
+	// 没有新事件时会阻塞一段时间，返回读到的事件和下一次读取的位置
+	ReadStatusEvents(ctx context.Context, bizID int64, cursor string, limit int) ([]domain.NotificationStatusEvent, string, error)
+	// List 按照 ID 倒序分页查询符合过滤条件的通知，cursor 为上一页返回的 nextCursor，第一页为 0
+	// 返回的 nextCursor 为 0 时表示没有下一页，limit 不大于 0 时使用默认值，超过上限时使用上限
+	List(ctx context.Context, filter domain.NotificationFilter, cursor uint64, limit int) (ns []domain.Notification, nextCursor uint64, err error)
 }
 
 // notificationService 通知服务实现
 type notificationService struct {
-	repo repository.NotificationRepository
+	repo        repository.NotificationRepository
+	callbackSvc callback.Service
+	templateSvc manage.ChannelTemplateService
+	// queue 延迟队列，为 nil 时修改之后依赖调度器扫描数据库
+	queue        cache.DelayQueue
+	statusStream cache.NotificationStatusStream
+	logger       *elog.Component
 }
 
-// NewNotificationService 创建通知服务实例
-func NewNotificationService(repo repository.NotificationRepository) Service {
+// NewNotificationService 创建通知服务实例，queue 可以为 nil
+func NewNotificationService(repo repository.NotificationRepository, callbackSvc callback.Service,
+	templateSvc manage.ChannelTemplateService, queue cache.DelayQueue, statusStream cache.NotificationStatusStream,
+) Service {
 	return &notificationService{
-		repo: repo,
+		repo:         repo,
+		callbackSvc:  callbackSvc,
+		templateSvc:  templateSvc,
+		queue:        queue,
+		statusStream: statusStream,
+		logger:       elog.DefaultLogger,
+	}
+}
+
+// FindReadyNotifications 准备好调度发送的指定分区、指定优先级的通知
+func (s *notificationService) FindReadyNotifications(ctx context.Context, partition domain.Partition, priority domain.Priority, offset, limit int) ([]domain.Notification, error) {
+	return s.repo.FindReadyNotifications(ctx, partition, priority, offset, limit)
+}
+
+// FindReadyByIDs 从指定的通知ID中找出准备好调度发送的通知
+func (s *notificationService) FindReadyByIDs(ctx context.Context, ids []uint64) ([]domain.Notification, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	ns, err := s.repo.BatchGetByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	res := make([]domain.Notification, 0, len(ns))
+	for _, id := range ids {
+		n, ok := ns[id]
+		if ok && n.IsReady(now) {
+			res = append(res, n)
+		}
+	}
+	return res, nil
+}
+
+// MarkSending 发送之前将等待发送的通知标记为发送中
+func (s *notificationService) MarkSending(ctx context.Context, notifications []domain.Notification) ([]domain.Notification, error) {
+	return s.repo.CASMarkSending(ctx, notifications)
+}
+
+// GetByKey 根据业务ID和业务内唯一标识获取通知
+func (s *notificationService) GetByKey(ctx context.Context, bizID int64, key string) (domain.Notification, error) {
+	if key == "" {
+		return domain.Notification{}, fmt.Errorf("%w: Key = %q", errs.ErrInvalidParameter, key)
+	}
+	n, err := s.repo.GetByKey(ctx, bizID, key)
+	if err != nil {
+		if errors.Is(err, egorm.ErrRecordNotFound) {
+			return domain.Notification{}, fmt.Errorf("%w: key = %s", errs.ErrNotificationNotFound, key)
+		}
+		return domain.Notification{}, fmt.Errorf("%w: %w", errs.ErrDatabaseError, err)
+	}
+	return n, nil
+}
+
+// BatchGetByKeys 根据业务ID和业务内唯一标识批量获取通知
+func (s *notificationService) BatchGetByKeys(ctx context.Context, bizID int64, keys []string) (map[string]domain.Notification, error) {
+	if len(keys) == 0 {
+		return make(map[string]domain.Notification), nil
+	}
+	ns, err := s.repo.BatchGetByKeys(ctx, bizID, keys)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrDatabaseError, err)
+	}
+	return ns, nil
+}
+
+// Cancel 取消等待发送的通知
+func (s *notificationService) Cancel(ctx context.Context, bizID int64, key string) (domain.Notification, error) {
+	n, err := s.GetByKey(ctx, bizID, key)
+	if err != nil {
+		return domain.Notification{}, err
+	}
+	if n.Status != domain.SendStatusPending {
+		return n, fmt.Errorf("%w: 只能取消等待发送的通知, status = %s", errs.ErrInvalidNotificationStatus, n.Status)
+	}
+
+	n.Status = domain.SendStatusCanceled
+	n.StatusReason = canceledByBizReason
+	err = s.repo.CASCancel(ctx, n)
+	if err != nil {
+		if !errors.Is(err, errs.ErrNotificationVersionMismatch) {
+			return domain.Notification{}, fmt.Errorf("%w: %w", errs.ErrDatabaseError, err)
+		}
+		// 在此期间调度器开始发送了，或者被其他请求修改了
+		latest, err1 := s.GetByKey(ctx, bizID, key)
+		if err1 != nil {
+			return domain.Notification{}, err1
+		}
+		return latest, fmt.Errorf("%w: 通知状态已变更, status = %s", errs.ErrInvalidNotificationStatus, latest.Status)
+	}
+
+	// 取消是确定的结果，回调业务方
+	err = s.callbackSvc.SendCallbackByNotification(ctx, n)
+	if err != nil {
+		s.logger.Warn("通知取消回调业务方失败", elog.Any("notificationID", n.ID), elog.FieldErr(err))
 	}
+	return n, nil
 }
 
-// FindReadyNotifications 准备好调度发送的通知
-func (s *notificationService) FindReadyNotifications(ctx context.Context, offset, limit int) ([]domain.Notification, error) {
-	return s.repo.FindReadyNotifications(ctx, offset, limit)
+// UpdatePending 修改等待发送的通知
+func (s *notificationService) UpdatePending(ctx context.Context, bizID int64, key string, strategy *domain.SendStrategyConfig, params map[string]string) (domain.Notification, error) {
+	if strategy == nil && params == nil {
+		return domain.Notification{}, fmt.Errorf("%w: 发送策略和模版参数至少指定一个", errs.ErrInvalidParameter)
+	}
+	if strategy != nil {
+		if err := strategy.Validate(); err != nil {
+			return domain.Notification{}, err
+		}
+	}
+
+	n, err := s.GetByKey(ctx, bizID, key)
+	if err != nil {
+		return domain.Notification{}, err
+	}
+	if n.Status != domain.SendStatusPending {
+		return n, fmt.Errorf("%w: 只能修改等待发送的通知, status = %s", errs.ErrInvalidNotificationStatus, n.Status)
+	}
+
+	if strategy != nil {
+		n.SendStrategyConfig = *strategy
+		// 与异步发送一致，立刻发送修改为在一分钟内发送
+		n.ReplaceAsyncImmediate()
+		n.SetSendTime()
+		n.NextRetryTime = 0
+	}
+	if params != nil {
+		if err = s.validateParams(ctx, n.Template.ID, params); err != nil {
+			return n, err
+		}
+		n.Template.Params = params
+	}
+	err = s.repo.CASUpdatePending(ctx, n)
+	if err != nil {
+		if !errors.Is(err, errs.ErrNotificationVersionMismatch) {
+			return domain.Notification{}, fmt.Errorf("%w: %w", errs.ErrDatabaseError, err)
+		}
+		// 在此期间调度器开始发送了，或者被其他请求修改了
+		latest, err1 := s.GetByKey(ctx, bizID, key)
+		if err1 != nil {
+			return domain.Notification{}, err1
+		}
+		return latest, fmt.Errorf("%w: 通知状态已变更, status = %s", errs.ErrInvalidNotificationStatus, latest.Status)
+	}
+	n.Version++
+
+	if s.queue != nil {
+		// 调度器可能已经从延迟队列中取出了通知，但是因为版本号变化没能标记为发送中，所以无论是否修改计划发送时间都要重新加入
+		// 旧的记录会被覆盖
+		if err = s.queue.Add(ctx, n); err != nil {
+			s.logger.Warn("通知重新加入延迟队列失败", elog.Any("notificationID", n.ID), elog.FieldErr(err))
+		}
+	}
+	return n, nil
+}
+
+// validateParams 校验新的模版参数是否覆盖了模版当前发布版本中的所有变量，发送时使用的是当前发布版本
+func (s *notificationService) validateParams(ctx context.Context, templateID int64, params map[string]string) error {
+	tmpl, err := s.templateSvc.GetTemplateByID(ctx, templateID)
+	if err != nil {
+		return fmt.Errorf("%w: 模板ID: %d", errs.ErrInvalidParameter, templateID)
+	}
+	activeVersion := tmpl.ActiveVersion()
+	if activeVersion == nil {
+		return fmt.Errorf("%w: 模板ID: %d 未发布", errs.ErrInvalidParameter, templateID)
+	}
+	return activeVersion.ValidateParams(params)
+}
+
+// Resend 将发送失败的通知重新置为待发送或者发送中
+func (s *notificationService) Resend(ctx context.Context, n domain.Notification, immediately bool) (domain.Notification, error) {
+	if n.Status != domain.SendStatusFailed {
+		return n, fmt.Errorf("%w: 只能重新发送发送失败的通知, status = %s", errs.ErrInvalidNotificationStatus, n.Status)
+	}
+
+	next := n
+	// 原有的计划发送时间已经失效，按照立刻发送重新计算
+	next.SendStrategyConfig = domain.SendStrategyConfig{Type: domain.SendStrategyImmediate}
+	next.SetSendTime()
+	next.Status = domain.SendStatusPending
+	if immediately {
+		next.Status = domain.SendStatusSending
+	}
+	next.StatusReason = ""
+	next.DeliveredChannel = ""
+	next.RetryCount = 0
+	next.NextRetryTime = 0
+
+	err := s.repo.Resend(ctx, n, next)
+	if err != nil {
+		if errors.Is(err, errs.ErrNoQuota) {
+			return n, err
+		}
+		if !errors.Is(err, errs.ErrNotificationVersionMismatch) {
+			return domain.Notification{}, fmt.Errorf("%w: %w", errs.ErrDatabaseError, err)
+		}
+		// 在此期间被其他请求重新发送了
+		latest, err1 := s.GetByKey(ctx, n.BizID, n.Key)
+		if err1 != nil {
+			return domain.Notification{}, err1
+		}
+		return latest, fmt.Errorf("%w: 通知状态已变更, status = %s", errs.ErrInvalidNotificationStatus, latest.Status)
+	}
+	next.Version++
+
+	if !immediately && s.queue != nil {
+		if err = s.queue.Add(ctx, next); err != nil {
+			s.logger.Warn("通知加入延迟队列失败", elog.Any("notificationID", next.ID), elog.FieldErr(err))
+		}
+	}
+	return next, nil
+}
+
+// ReadStatusEvents 读取业务方的通知状态变更事件
+func (s *notificationService) ReadStatusEvents(ctx context.Context, bizID int64, cursor string, limit int) ([]domain.NotificationStatusEvent, string, error) {
+	if limit <= 0 {
+		return nil, "", fmt.Errorf("%w: limit = %d", errs.ErrInvalidParameter, limit)
+	}
+	if cursor == "" {
+		latest, err := s.statusStream.LatestCursor(ctx, bizID)
+		if err != nil {
+			return nil, "", fmt.Errorf("%w: %w", errs.ErrDatabaseError, err)
+		}
+		cursor = latest
+	}
+	events, err := s.statusStream.Read(ctx, bizID, cursor, limit, statusEventsBlock)
+	if err != nil {
+		if errors.Is(err, errs.ErrInvalidParameter) {
+			return nil, "", err
+		}
+		return nil, "", fmt.Errorf("%w: %w", errs.ErrDatabaseError, err)
+	}
+	if len(events) > 0 {
+		cursor = events[len(events)-1].Cursor
+	}
+	return events, cursor, nil
+}
+
+// List 按照 ID 倒序分页查询符合过滤条件的通知，雪花算法ID按照生成时间递增，所以也基本上是创建时间倒序
+func (s *notificationService) List(ctx context.Context, filter domain.NotificationFilter, cursor uint64, limit int) ([]domain.Notification, uint64, error) {
+	if err := filter.Validate(); err != nil {
+		return nil, 0, err
+	}
+	switch {
+	case limit <= 0:
+		limit = defaultListLimit
+	case limit > maxListLimit:
+		limit = maxListLimit
+	}
+	// 多查一条用于判断是否还有下一页
+	ns, err := s.repo.List(ctx, filter, cursor, limit+1)
+	if err != nil {
+		return nil, 0, fmt.Errorf("%w: %w", errs.ErrDatabaseError, err)
+	}
+	if len(ns) <= limit {
+		return ns, 0, nil
+	}
+	ns = ns[:limit]
+	return ns, ns[limit-1].ID, nil
 }
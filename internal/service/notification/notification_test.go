@@ -0,0 +1,368 @@
+//go:build unit
+
+package notification
+
+import (
+	"context"
+	"testing"
+
+	"github.com/robinlg/notification-platform/internal/domain"
+	"github.com/robinlg/notification-platform/internal/errs"
+	cachemocks "github.com/robinlg/notification-platform/internal/repository/cache/mocks"
+	repositorymocks "github.com/robinlg/notification-platform/internal/repository/mocks"
+	callbackmocks "github.com/robinlg/notification-platform/internal/service/notification/callback/mocks"
+	templatemocks "github.com/robinlg/notification-platform/internal/service/template/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestNotificationService_Cancel(t *testing.T) {
+	t.Parallel()
+
+	const (
+		bizID = int64(1)
+		key   = "key-1"
+	)
+	pending := domain.Notification{ID: 1, BizID: bizID, Key: key, Channel: domain.ChannelSMS, Status: domain.SendStatusPending, Version: 1}
+	sending := pending
+	sending.Status = domain.SendStatusSending
+	sending.Version = 2
+
+	testCases := []struct {
+		name       string
+		mock       func(repo *repositorymocks.MockNotificationRepository, callbackSvc *callbackmocks.MockService)
+		wantStatus domain.SendStatus
+		wantErr    error
+	}{
+		{
+			name: "取消成功",
+			mock: func(repo *repositorymocks.MockNotificationRepository, callbackSvc *callbackmocks.MockService) {
+				repo.EXPECT().GetByKey(gomock.Any(), bizID, key).Return(pending, nil)
+				repo.EXPECT().CASCancel(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, n domain.Notification) error {
+					assert.Equal(t, domain.SendStatusCanceled, n.Status)
+					assert.Equal(t, 1, n.Version)
+					return nil
+				})
+				callbackSvc.EXPECT().SendCallbackByNotification(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			wantStatus: domain.SendStatusCanceled,
+		},
+		{
+			name: "已经开始发送",
+			mock: func(repo *repositorymocks.MockNotificationRepository, _ *callbackmocks.MockService) {
+				repo.EXPECT().GetByKey(gomock.Any(), bizID, key).Return(sending, nil)
+			},
+			wantStatus: domain.SendStatusSending,
+			wantErr:    errs.ErrInvalidNotificationStatus,
+		},
+		{
+			// 查询之后调度器将通知标记为发送中，取消失败，不归还额度也不回调
+			name: "取消期间调度器开始发送",
+			mock: func(repo *repositorymocks.MockNotificationRepository, _ *callbackmocks.MockService) {
+				gomock.InOrder(
+					repo.EXPECT().GetByKey(gomock.Any(), bizID, key).Return(pending, nil),
+					repo.EXPECT().CASCancel(gomock.Any(), gomock.Any()).Return(errs.ErrNotificationVersionMismatch),
+					repo.EXPECT().GetByKey(gomock.Any(), bizID, key).Return(sending, nil),
+				)
+			},
+			wantStatus: domain.SendStatusSending,
+			wantErr:    errs.ErrInvalidNotificationStatus,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := repositorymocks.NewMockNotificationRepository(ctrl)
+			callbackSvc := callbackmocks.NewMockService(ctrl)
+			tc.mock(repo, callbackSvc)
+
+			svc := NewNotificationService(repo, callbackSvc, nil, nil, nil)
+			n, err := svc.Cancel(context.Background(), bizID, key)
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.wantStatus, n.Status)
+		})
+	}
+}
+
+func TestNotificationService_UpdatePending(t *testing.T) {
+	t.Parallel()
+
+	const (
+		bizID = int64(1)
+		key   = "key-1"
+	)
+	pending := domain.Notification{
+		ID: 1, BizID: bizID, Key: key, Channel: domain.ChannelSMS, Status: domain.SendStatusPending, Version: 1,
+		Template:      domain.Template{ID: 10, Params: map[string]string{"code": "1234"}},
+		NextRetryTime: 3000,
+	}
+	sending := pending
+	sending.Status = domain.SendStatusSending
+	sending.Version = 2
+	tmpl := domain.ChannelTemplate{
+		ID: 10, ActiveVersionID: 100,
+		Versions: []domain.ChannelTemplateVersion{{ID: 100, Content: "您的验证码是${code}"}},
+	}
+
+	testCases := []struct {
+		name       string
+		params     map[string]string
+		mock       func(repo *repositorymocks.MockNotificationRepository, templateSvc *templatemocks.MockChannelTemplateService, queue *cachemocks.MockDelayQueue)
+		wantStatus domain.SendStatus
+		wantErr    error
+	}{
+		{
+			// 调度器可能因为版本号变化丢弃了延迟队列中的记录，只修改参数也需要重新加入
+			name:   "修改模版参数成功",
+			params: map[string]string{"code": "5678"},
+			mock: func(repo *repositorymocks.MockNotificationRepository, templateSvc *templatemocks.MockChannelTemplateService, queue *cachemocks.MockDelayQueue) {
+				repo.EXPECT().GetByKey(gomock.Any(), bizID, key).Return(pending, nil)
+				templateSvc.EXPECT().GetTemplateByID(gomock.Any(), int64(10)).Return(tmpl, nil)
+				repo.EXPECT().CASUpdatePending(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, n domain.Notification) error {
+					assert.Equal(t, "5678", n.Template.Params["code"])
+					assert.Equal(t, int64(3000), n.NextRetryTime)
+					return nil
+				})
+				queue.EXPECT().Add(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, ns ...domain.Notification) error {
+					assert.Equal(t, 2, ns[0].Version)
+					return nil
+				})
+			},
+			wantStatus: domain.SendStatusPending,
+		},
+		{
+			name:   "缺少模版变量",
+			params: map[string]string{"name": "Tom"},
+			mock: func(repo *repositorymocks.MockNotificationRepository, templateSvc *templatemocks.MockChannelTemplateService, _ *cachemocks.MockDelayQueue) {
+				repo.EXPECT().GetByKey(gomock.Any(), bizID, key).Return(pending, nil)
+				templateSvc.EXPECT().GetTemplateByID(gomock.Any(), int64(10)).Return(tmpl, nil)
+			},
+			wantStatus: domain.SendStatusPending,
+			wantErr:    errs.ErrInvalidParameter,
+		},
+		{
+			// 查询之后调度器将通知标记为发送中，修改失败，不加入延迟队列
+			name:   "修改期间调度器开始发送",
+			params: map[string]string{"code": "5678"},
+			mock: func(repo *repositorymocks.MockNotificationRepository, templateSvc *templatemocks.MockChannelTemplateService, _ *cachemocks.MockDelayQueue) {
+				templateSvc.EXPECT().GetTemplateByID(gomock.Any(), int64(10)).Return(tmpl, nil)
+				gomock.InOrder(
+					repo.EXPECT().GetByKey(gomock.Any(), bizID, key).Return(pending, nil),
+					repo.EXPECT().CASUpdatePending(gomock.Any(), gomock.Any()).Return(errs.ErrNotificationVersionMismatch),
+					repo.EXPECT().GetByKey(gomock.Any(), bizID, key).Return(sending, nil),
+				)
+			},
+			wantStatus: domain.SendStatusSending,
+			wantErr:    errs.ErrInvalidNotificationStatus,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := repositorymocks.NewMockNotificationRepository(ctrl)
+			templateSvc := templatemocks.NewMockChannelTemplateService(ctrl)
+			queue := cachemocks.NewMockDelayQueue(ctrl)
+			tc.mock(repo, templateSvc, queue)
+
+			svc := NewNotificationService(repo, callbackmocks.NewMockService(ctrl), templateSvc, queue, nil)
+			n, err := svc.UpdatePending(context.Background(), bizID, key, nil, tc.params)
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.wantStatus, n.Status)
+		})
+	}
+}
+
+func TestNotificationService_Resend(t *testing.T) {
+	t.Parallel()
+
+	failed := domain.Notification{
+		ID: 1, BizID: 1, Key: "key-1", Channel: domain.ChannelSMS, Status: domain.SendStatusFailed,
+		StatusReason: "供应商超时", RetryCount: 2, NextRetryTime: 3000, Version: 3,
+	}
+
+	testCases := []struct {
+		name        string
+		n           domain.Notification
+		immediately bool
+		mock        func(repo *repositorymocks.MockNotificationRepository, queue *cachemocks.MockDelayQueue)
+		wantStatus  domain.SendStatus
+		wantErr     error
+	}{
+		{
+			// 置为待发送并加入延迟队列，由调度器发送
+			name: "重新置为待发送",
+			n:    failed,
+			mock: func(repo *repositorymocks.MockNotificationRepository, queue *cachemocks.MockDelayQueue) {
+				repo.EXPECT().Resend(gomock.Any(), failed, gomock.Any()).DoAndReturn(func(_ context.Context, _, next domain.Notification) error {
+					assert.Equal(t, domain.SendStatusPending, next.Status)
+					assert.Empty(t, next.StatusReason)
+					assert.Zero(t, next.RetryCount)
+					assert.Zero(t, next.NextRetryTime)
+					return nil
+				})
+				queue.EXPECT().Add(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, ns ...domain.Notification) error {
+					assert.Equal(t, 4, ns[0].Version)
+					return nil
+				})
+			},
+			wantStatus: domain.SendStatusPending,
+		},
+		{
+			// 置为发送中，由调用方立刻发送，不能再加入延迟队列
+			name:        "立刻发送",
+			n:           failed,
+			immediately: true,
+			mock: func(repo *repositorymocks.MockNotificationRepository, _ *cachemocks.MockDelayQueue) {
+				repo.EXPECT().Resend(gomock.Any(), failed, gomock.Any()).DoAndReturn(func(_ context.Context, _, next domain.Notification) error {
+					assert.Equal(t, domain.SendStatusSending, next.Status)
+					return nil
+				})
+			},
+			wantStatus: domain.SendStatusSending,
+		},
+		{
+			name: "额度不足",
+			n:    failed,
+			mock: func(repo *repositorymocks.MockNotificationRepository, _ *cachemocks.MockDelayQueue) {
+				repo.EXPECT().Resend(gomock.Any(), failed, gomock.Any()).Return(errs.ErrNoQuota)
+			},
+			wantStatus: domain.SendStatusFailed,
+			wantErr:    errs.ErrNoQuota,
+		},
+		{
+			name: "不是发送失败状态",
+			n: func() domain.Notification {
+				n := failed
+				n.Status = domain.SendStatusSucceeded
+				return n
+			}(),
+			mock:       func(_ *repositorymocks.MockNotificationRepository, _ *cachemocks.MockDelayQueue) {},
+			wantStatus: domain.SendStatusSucceeded,
+			wantErr:    errs.ErrInvalidNotificationStatus,
+		},
+		{
+			// 被其他请求抢先重新发送，返回最新状态
+			name: "并发重新发送",
+			n:    failed,
+			mock: func(repo *repositorymocks.MockNotificationRepository, _ *cachemocks.MockDelayQueue) {
+				latest := failed
+				latest.Status = domain.SendStatusPending
+				latest.Version = 4
+				gomock.InOrder(
+					repo.EXPECT().Resend(gomock.Any(), failed, gomock.Any()).Return(errs.ErrNotificationVersionMismatch),
+					repo.EXPECT().GetByKey(gomock.Any(), failed.BizID, failed.Key).Return(latest, nil),
+				)
+			},
+			wantStatus: domain.SendStatusPending,
+			wantErr:    errs.ErrInvalidNotificationStatus,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := repositorymocks.NewMockNotificationRepository(ctrl)
+			queue := cachemocks.NewMockDelayQueue(ctrl)
+			tc.mock(repo, queue)
+
+			svc := NewNotificationService(repo, callbackmocks.NewMockService(ctrl), nil, queue, nil)
+			n, err := svc.Resend(context.Background(), tc.n, tc.immediately)
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.wantStatus, n.Status)
+		})
+	}
+}
+
+func TestNotificationService_List(t *testing.T) {
+	t.Parallel()
+
+	filter := domain.NotificationFilter{BizID: 1, Status: domain.SendStatusFailed}
+	newNotifications := func(ids ...uint64) []domain.Notification {
+		ns := make([]domain.Notification, 0, len(ids))
+		for _, id := range ids {
+			ns = append(ns, domain.Notification{ID: id, BizID: 1})
+		}
+		return ns
+	}
+
+	testCases := []struct {
+		name       string
+		cursor     uint64
+		limit      int
+		mock       func(repo *repositorymocks.MockNotificationRepository)
+		wantIDs    []uint64
+		wantCursor uint64
+		wantErr    error
+	}{
+		{
+			// 多查一条判断是否还有下一页，下一页的游标为本页最后一条通知的 ID
+			name:  "还有下一页",
+			limit: 2,
+			mock: func(repo *repositorymocks.MockNotificationRepository) {
+				repo.EXPECT().List(gomock.Any(), filter, uint64(0), 3).Return(newNotifications(9, 7, 5), nil)
+			},
+			wantIDs:    []uint64{9, 7},
+			wantCursor: 7,
+		},
+		{
+			name:   "最后一页",
+			cursor: 7,
+			limit:  2,
+			mock: func(repo *repositorymocks.MockNotificationRepository) {
+				repo.EXPECT().List(gomock.Any(), filter, uint64(7), 3).Return(newNotifications(5), nil)
+			},
+			wantIDs: []uint64{5},
+		},
+		{
+			name:  "超过每页上限",
+			limit: 1000,
+			mock: func(repo *repositorymocks.MockNotificationRepository) {
+				repo.EXPECT().List(gomock.Any(), filter, uint64(0), maxListLimit+1).Return(nil, nil)
+			},
+		},
+		{
+			name:   "查询失败",
+			cursor: 7,
+			limit:  2,
+			mock: func(repo *repositorymocks.MockNotificationRepository) {
+				repo.EXPECT().List(gomock.Any(), filter, uint64(7), 3).Return(nil, assert.AnError)
+			},
+			wantErr: errs.ErrDatabaseError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := repositorymocks.NewMockNotificationRepository(ctrl)
+			tc.mock(repo)
+
+			svc := NewNotificationService(repo, callbackmocks.NewMockService(ctrl), nil, nil, nil)
+			ns, next, err := svc.List(context.Background(), filter, tc.cursor, tc.limit)
+			assert.ErrorIs(t, err, tc.wantErr)
+			if err != nil {
+				return
+			}
+			ids := make([]uint64, 0, len(ns))
+			for i := range ns {
+				ids = append(ids, ns[i].ID)
+			}
+			assert.ElementsMatch(t, tc.wantIDs, ids)
+			assert.Equal(t, tc.wantCursor, next)
+		})
+	}
+}
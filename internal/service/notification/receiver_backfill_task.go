@@ -0,0 +1,63 @@
+package notification
+
+import (
+	"context"
+	"time"
+
+	"github.com/gotomicro/ego/core/elog"
+	"github.com/meoying/dlock-go"
+	"github.com/robinlg/notification-platform/internal/pkg/loopjob"
+	"github.com/robinlg/notification-platform/internal/repository"
+)
+
+const (
+	ReceiverBackfillTaskKey        = "receiver_backfill_job"
+	defaultReceiverBackfillTimeout = 5 * time.Second
+	defaultReceiverBackfillBatch   = 500
+)
+
+// ReceiverBackfillTask 为引入接收者表之前创建的通知回填接收者
+// 回填完成之前，按照接收者查询通知列表时查不到这些通知，全部回填完成后任务结束
+type ReceiverBackfillTask struct {
+	repo      repository.NotificationRepository
+	lock      dlock.Client
+	batchSize int
+	// startID 下一批的起始ID，切换到其他实例执行时从头开始，已经回填的接收者会被忽略
+	startID uint64
+	cancel  context.CancelFunc
+	logger  *elog.Component
+}
+
+func NewReceiverBackfillTask(repo repository.NotificationRepository, lock dlock.Client) *ReceiverBackfillTask {
+	return &ReceiverBackfillTask{
+		repo:      repo,
+		lock:      lock,
+		batchSize: defaultReceiverBackfillBatch,
+		logger:    elog.DefaultLogger,
+	}
+}
+
+// Start 回填完成或者 ctx 被取消时返回
+func (task *ReceiverBackfillTask) Start(ctx context.Context) {
+	ctx, task.cancel = context.WithCancel(ctx)
+	defer task.cancel()
+	job := loopjob.NewInfiniteLoop(task.lock, task.oneLoop, ReceiverBackfillTaskKey)
+	job.Run(ctx)
+}
+
+func (task *ReceiverBackfillTask) oneLoop(ctx context.Context) error {
+	loopCtx, cancel := context.WithTimeout(ctx, defaultReceiverBackfillTimeout)
+	defer cancel()
+
+	next, err := task.repo.BackfillReceivers(loopCtx, task.startID, task.batchSize)
+	if err != nil {
+		return err
+	}
+	if next == 0 {
+		task.logger.Info("通知接收者回填完成")
+		task.cancel()
+		return nil
+	}
+	task.startID = next
+	return nil
+}
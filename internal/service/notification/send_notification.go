@@ -2,14 +2,17 @@ package notification
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/robinlg/notification-platform/internal/domain"
 	"github.com/robinlg/notification-platform/internal/errs"
+	configsvc "github.com/robinlg/notification-platform/internal/service/config"
 	"github.com/robinlg/notification-platform/internal/service/template/manage"
 
 	idgen "github.com/robinlg/notification-platform/internal/pkg/id_generator"
 	sendstrategy "github.com/robinlg/notification-platform/internal/service/send_strategy"
+	"github.com/robinlg/notification-platform/internal/service/sender"
 )
 
 // SendService 负责处理发送
@@ -24,26 +27,59 @@ type SendService interface {
 	BatchSendNotifications(ctx context.Context, ns ...domain.Notification) (domain.BatchSendResponse, error)
 	// BatchSendNotificationsAsync 异步批量发送
 	BatchSendNotificationsAsync(ctx context.Context, ns ...domain.Notification) (domain.BatchSendAsyncResponse, error)
+	// ResendNotification 重新发送发送失败的通知，immediately 为 true 时同步发送，否则等待调度器发送
+	ResendNotification(ctx context.Context, bizID int64, key string, immediately bool) (domain.SendResponse, error)
 }
 
 // sendService 执行器实现
 type sendService struct {
 	notificationSvc Service
 	templateSvc     manage.ChannelTemplateService
+	configSvc       configsvc.BusinessConfigService
 	idGenerator     *idgen.Generator
 	sendStrategy    sendstrategy.SendStrategy
+	sender          sender.NotificationSender
 }
 
 // NewSendService 创建执行器实例
-func NewSendService(templateSvc manage.ChannelTemplateService, notificationSvc Service, sendStrategy sendstrategy.SendStrategy) SendService {
+func NewSendService(
+	templateSvc manage.ChannelTemplateService,
+	notificationSvc Service,
+	configSvc configsvc.BusinessConfigService,
+	sendStrategy sendstrategy.SendStrategy,
+	sender sender.NotificationSender,
+) SendService {
 	return &sendService{
 		notificationSvc: notificationSvc,
 		templateSvc:     templateSvc,
+		configSvc:       configSvc,
 		idGenerator:     idgen.NewGenerator(),
 		sendStrategy:    sendStrategy,
+		sender:          sender,
 	}
 }
 
+// checkChannel 检查通知的渠道是否被业务方禁用，未提供业务配置时不做限制
+func (e *sendService) checkChannel(ctx context.Context, ns ...domain.Notification) error {
+	const first = 0
+	cfg, err := e.configSvc.GetByID(ctx, ns[first].BizID)
+	if err != nil {
+		if errors.Is(err, errs.ErrConfigNotFound) {
+			return nil
+		}
+		return fmt.Errorf("%w: %w", errs.ErrDatabaseError, err)
+	}
+	if cfg.ChannelConfig == nil {
+		return nil
+	}
+	for i := range ns {
+		if cfg.ChannelConfig.IsChannelDisabled(ns[i].Channel) {
+			return fmt.Errorf("%w: Channel = %s", errs.ErrChannelDisabled, ns[i].Channel)
+		}
+	}
+	return nil
+}
+
 // SendNotification 同步单条发送
 func (e *sendService) SendNotification(ctx context.Context, n domain.Notification) (domain.SendResponse, error) {
 	resp := domain.SendResponse{
@@ -55,6 +91,10 @@ func (e *sendService) SendNotification(ctx context.Context, n domain.Notificatio
 		return resp, err
 	}
 
+	if err := e.checkChannel(ctx, n); err != nil {
+		return resp, err
+	}
+
 	// 生成通知ID(后续考虑分库分表)
 	id := e.idGenerator.GenerateID(n.BizID, n.Key)
 	n.ID = uint64(id)
@@ -75,6 +115,10 @@ func (e *sendService) SendNotificationAsync(ctx context.Context, n domain.Notifi
 	if err := n.Validate(); err != nil {
 		return domain.SendResponse{}, err
 	}
+
+	if err := e.checkChannel(ctx, n); err != nil {
+		return domain.SendResponse{}, err
+	}
 	// 生成通知ID
 	id := e.idGenerator.GenerateID(n.BizID, n.Key)
 	n.ID = uint64(id)
@@ -105,6 +149,10 @@ func (e *sendService) BatchSendNotifications(ctx context.Context, notifications
 		notifications[i].ID = uint64(id)
 	}
 
+	if err := e.checkChannel(ctx, notifications...); err != nil {
+		return domain.BatchSendResponse{}, err
+	}
+
 	// 发送通知，这里有一个隐含的假设，就是发送策略必须是相同的。
 	results, err := e.sendStrategy.BatchSend(ctx, notifications)
 	response.Results = results
@@ -135,12 +183,47 @@ func (e *sendService) BatchSendNotificationsAsync(ctx context.Context, notificat
 		notifications[i].ReplaceAsyncImmediate()
 	}
 
+	if err := e.checkChannel(ctx, notifications...); err != nil {
+		return domain.BatchSendAsyncResponse{}, err
+	}
+
 	// 发送通知，隐含假设这一批的发送策略是一样的。
 	_, err := e.sendStrategy.BatchSend(ctx, notifications)
 	if err != nil {
-		return domain.BatchSendAsyncResponse{}, fmt.Errorf("发送失败 %w", errs.ErrSendNotificationFailed)
+		// 保留原始错误，调用方需要区分 key 重复这类由个别通知导致的失败
+		return domain.BatchSendAsyncResponse{}, fmt.Errorf("发送失败 %w: %w", errs.ErrSendNotificationFailed, err)
 	}
 	return domain.BatchSendAsyncResponse{
 		NotificationIDs: ids,
 	}, nil
 }
+
+// ResendNotification 重新发送发送失败的通知
+func (e *sendService) ResendNotification(ctx context.Context, bizID int64, key string, immediately bool) (domain.SendResponse, error) {
+	n, err := e.notificationSvc.GetByKey(ctx, bizID, key)
+	if err != nil {
+		return domain.SendResponse{}, err
+	}
+	resp := domain.SendResponse{
+		NotificationID: n.ID,
+		Status:         n.Status,
+	}
+	if err = e.checkChannel(ctx, n); err != nil {
+		return resp, err
+	}
+
+	next, err := e.notificationSvc.Resend(ctx, n, immediately)
+	if err != nil {
+		resp.Status = next.Status
+		return resp, err
+	}
+	if !immediately {
+		resp.Status = next.Status
+		return resp, nil
+	}
+	response, err := e.sender.Send(ctx, next)
+	if err != nil {
+		return resp, fmt.Errorf("%w, 发送通知失败，原因：%w", errs.ErrSendNotificationFailed, err)
+	}
+	return response, nil
+}
@@ -0,0 +1,101 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gotomicro/ego/core/elog"
+	"github.com/robinlg/notification-platform/internal/domain"
+	"github.com/robinlg/notification-platform/internal/errs"
+	"github.com/robinlg/notification-platform/internal/pkg/ratelimit"
+	configsvc "github.com/robinlg/notification-platform/internal/service/config"
+)
+
+var _ SendService = (*rateLimitSendService)(nil)
+
+// rateLimitSendService 按照业务配置中的 RateLimit 对发送请求限流的装饰器
+// 批量请求按照通知条数计数
+type rateLimitSendService struct {
+	SendService
+	*bizLimiter
+}
+
+// NewRateLimitSendService 创建带业务限流的发送服务
+func NewRateLimitSendService(svc SendService, configSvc configsvc.BusinessConfigService, limiter ratelimit.Limiter) SendService {
+	return &rateLimitSendService{
+		SendService: svc,
+		bizLimiter:  newBizLimiter(configSvc, limiter),
+	}
+}
+
+func (r *rateLimitSendService) SendNotification(ctx context.Context, n domain.Notification) (domain.SendResponse, error) {
+	if err := r.limit(ctx, n.BizID, 1); err != nil {
+		return domain.SendResponse{Status: domain.SendStatusFailed}, err
+	}
+	return r.SendService.SendNotification(ctx, n)
+}
+
+func (r *rateLimitSendService) SendNotificationAsync(ctx context.Context, n domain.Notification) (domain.SendResponse, error) {
+	if err := r.limit(ctx, n.BizID, 1); err != nil {
+		return domain.SendResponse{}, err
+	}
+	return r.SendService.SendNotificationAsync(ctx, n)
+}
+
+func (r *rateLimitSendService) BatchSendNotifications(ctx context.Context, ns ...domain.Notification) (domain.BatchSendResponse, error) {
+	if len(ns) > 0 {
+		const first = 0
+		if err := r.limit(ctx, ns[first].BizID, len(ns)); err != nil {
+			return domain.BatchSendResponse{}, err
+		}
+	}
+	return r.SendService.BatchSendNotifications(ctx, ns...)
+}
+
+func (r *rateLimitSendService) BatchSendNotificationsAsync(ctx context.Context, ns ...domain.Notification) (domain.BatchSendAsyncResponse, error) {
+	if len(ns) > 0 {
+		const first = 0
+		if err := r.limit(ctx, ns[first].BizID, len(ns)); err != nil {
+			return domain.BatchSendAsyncResponse{}, err
+		}
+	}
+	return r.SendService.BatchSendNotificationsAsync(ctx, ns...)
+}
+
+// bizLimiter 按照业务配置中的 RateLimit 限流，发送和事务通知共用同一个限额
+type bizLimiter struct {
+	configSvc configsvc.BusinessConfigService
+	limiter   ratelimit.Limiter
+	logger    *elog.Component
+}
+
+func newBizLimiter(configSvc configsvc.BusinessConfigService, limiter ratelimit.Limiter) *bizLimiter {
+	return &bizLimiter{
+		configSvc: configSvc,
+		limiter:   limiter,
+		logger:    elog.DefaultLogger,
+	}
+}
+
+// limit 为业务方占用 n 次请求，超过限制时返回 errs.ErrRateLimited
+// 获取配置或者限流器出错时放行，避免因为限流组件故障导致无法发送
+func (r *bizLimiter) limit(ctx context.Context, bizID int64, n int) error {
+	cfg, err := r.configSvc.GetByID(ctx, bizID)
+	if err != nil {
+		r.logger.Warn("获取业务配置失败，跳过限流", elog.Int64("bizID", bizID), elog.FieldErr(err))
+		return nil
+	}
+	if cfg.RateLimit <= 0 {
+		return nil
+	}
+
+	limited, err := r.limiter.Limit(ctx, fmt.Sprintf("ratelimit:biz:%d", bizID), n, cfg.RateLimit)
+	if err != nil {
+		r.logger.Warn("限流器出错，跳过限流", elog.Int64("bizID", bizID), elog.FieldErr(err))
+		return nil
+	}
+	if limited {
+		return fmt.Errorf("%w: bizID = %d, rateLimit = %d, n = %d", errs.ErrRateLimited, bizID, cfg.RateLimit, n)
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+//go:build unit
+
+package notification
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/robinlg/notification-platform/internal/domain"
+	"github.com/robinlg/notification-platform/internal/errs"
+	limitmocks "github.com/robinlg/notification-platform/internal/pkg/ratelimit/mocks"
+	configmocks "github.com/robinlg/notification-platform/internal/service/config/mocks"
+	notificationmocks "github.com/robinlg/notification-platform/internal/service/notification/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRateLimitSendService_BatchSendNotifications(t *testing.T) {
+	t.Parallel()
+
+	const bizID = int64(1)
+	ns := []domain.Notification{{BizID: bizID}, {BizID: bizID}, {BizID: bizID}}
+
+	testCases := []struct {
+		name    string
+		mock    func(ctrl *gomock.Controller) (SendService, *configmocks.MockBusinessConfigService, *limitmocks.MockLimiter)
+		wantErr error
+	}{
+		{
+			name: "未超过限制",
+			mock: func(ctrl *gomock.Controller) (SendService, *configmocks.MockBusinessConfigService, *limitmocks.MockLimiter) {
+				svc := notificationmocks.NewMockSendService(ctrl)
+				configSvc := configmocks.NewMockBusinessConfigService(ctrl)
+				limiter := limitmocks.NewMockLimiter(ctrl)
+				configSvc.EXPECT().GetByID(gomock.Any(), bizID).Return(domain.BusinessConfig{ID: bizID, RateLimit: 10}, nil)
+				// 批量请求按照通知条数计数
+				limiter.EXPECT().Limit(gomock.Any(), "ratelimit:biz:1", len(ns), 10).Return(false, nil)
+				svc.EXPECT().BatchSendNotifications(gomock.Any(), gomock.Any()).Return(domain.BatchSendResponse{}, nil)
+				return svc, configSvc, limiter
+			},
+		},
+		{
+			name: "超过限制",
+			mock: func(ctrl *gomock.Controller) (SendService, *configmocks.MockBusinessConfigService, *limitmocks.MockLimiter) {
+				svc := notificationmocks.NewMockSendService(ctrl)
+				configSvc := configmocks.NewMockBusinessConfigService(ctrl)
+				limiter := limitmocks.NewMockLimiter(ctrl)
+				configSvc.EXPECT().GetByID(gomock.Any(), bizID).Return(domain.BusinessConfig{ID: bizID, RateLimit: 2}, nil)
+				limiter.EXPECT().Limit(gomock.Any(), "ratelimit:biz:1", len(ns), 2).Return(true, nil)
+				return svc, configSvc, limiter
+			},
+			wantErr: errs.ErrRateLimited,
+		},
+		{
+			name: "限流器出错时放行",
+			mock: func(ctrl *gomock.Controller) (SendService, *configmocks.MockBusinessConfigService, *limitmocks.MockLimiter) {
+				svc := notificationmocks.NewMockSendService(ctrl)
+				configSvc := configmocks.NewMockBusinessConfigService(ctrl)
+				limiter := limitmocks.NewMockLimiter(ctrl)
+				configSvc.EXPECT().GetByID(gomock.Any(), bizID).Return(domain.BusinessConfig{ID: bizID, RateLimit: 2}, nil)
+				limiter.EXPECT().Limit(gomock.Any(), "ratelimit:biz:1", len(ns), 2).Return(false, errors.New("mock redis error"))
+				svc.EXPECT().BatchSendNotifications(gomock.Any(), gomock.Any()).Return(domain.BatchSendResponse{}, nil)
+				return svc, configSvc, limiter
+			},
+		},
+		{
+			name: "未配置限流",
+			mock: func(ctrl *gomock.Controller) (SendService, *configmocks.MockBusinessConfigService, *limitmocks.MockLimiter) {
+				svc := notificationmocks.NewMockSendService(ctrl)
+				configSvc := configmocks.NewMockBusinessConfigService(ctrl)
+				limiter := limitmocks.NewMockLimiter(ctrl)
+				configSvc.EXPECT().GetByID(gomock.Any(), bizID).Return(domain.BusinessConfig{ID: bizID}, nil)
+				svc.EXPECT().BatchSendNotifications(gomock.Any(), gomock.Any()).Return(domain.BatchSendResponse{}, nil)
+				return svc, configSvc, limiter
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			svc := NewRateLimitSendService(tc.mock(ctrl))
+			_, err := svc.BatchSendNotifications(context.Background(), ns...)
+			assert.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
@@ -0,0 +1,87 @@
+//go:build unit
+
+package notification
+
+import (
+	"context"
+	"testing"
+
+	"github.com/robinlg/notification-platform/internal/domain"
+	"github.com/robinlg/notification-platform/internal/errs"
+	configmocks "github.com/robinlg/notification-platform/internal/service/config/mocks"
+	notificationmocks "github.com/robinlg/notification-platform/internal/service/notification/mocks"
+	sendermocks "github.com/robinlg/notification-platform/internal/service/sender/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSendService_ResendNotification(t *testing.T) {
+	t.Parallel()
+
+	const (
+		bizID = int64(1)
+		key   = "key-1"
+	)
+	failed := domain.Notification{ID: 1, BizID: bizID, Key: key, Channel: domain.ChannelSMS, Status: domain.SendStatusFailed, Version: 3}
+	next := failed
+	next.Version = 4
+
+	testCases := []struct {
+		name        string
+		immediately bool
+		mock        func(svc *notificationmocks.MockService, s *sendermocks.MockNotificationSender)
+		wantStatus  domain.SendStatus
+		wantErr     error
+	}{
+		{
+			// 等待调度器发送，不会同步发送
+			name: "重新置为待发送",
+			mock: func(svc *notificationmocks.MockService, _ *sendermocks.MockNotificationSender) {
+				pending := next
+				pending.Status = domain.SendStatusPending
+				svc.EXPECT().Resend(gomock.Any(), failed, false).Return(pending, nil)
+			},
+			wantStatus: domain.SendStatusPending,
+		},
+		{
+			name:        "立刻发送",
+			immediately: true,
+			mock: func(svc *notificationmocks.MockService, s *sendermocks.MockNotificationSender) {
+				sending := next
+				sending.Status = domain.SendStatusSending
+				svc.EXPECT().Resend(gomock.Any(), failed, true).Return(sending, nil)
+				s.EXPECT().Send(gomock.Any(), sending).Return(domain.SendResponse{NotificationID: 1, Status: domain.SendStatusSucceeded}, nil)
+			},
+			wantStatus: domain.SendStatusSucceeded,
+		},
+		{
+			// 额度不足时不会发送
+			name:        "额度不足",
+			immediately: true,
+			mock: func(svc *notificationmocks.MockService, _ *sendermocks.MockNotificationSender) {
+				svc.EXPECT().Resend(gomock.Any(), failed, true).Return(failed, errs.ErrNoQuota)
+			},
+			wantStatus: domain.SendStatusFailed,
+			wantErr:    errs.ErrNoQuota,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			svc := notificationmocks.NewMockService(ctrl)
+			configSvc := configmocks.NewMockBusinessConfigService(ctrl)
+			s := sendermocks.NewMockNotificationSender(ctrl)
+			svc.EXPECT().GetByKey(gomock.Any(), bizID, key).Return(failed, nil)
+			configSvc.EXPECT().GetByID(gomock.Any(), bizID).Return(domain.BusinessConfig{}, errs.ErrConfigNotFound)
+			tc.mock(svc, s)
+
+			resp, err := NewSendService(nil, svc, configSvc, nil, s).ResendNotification(context.Background(), bizID, key, tc.immediately)
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.wantStatus, resp.Status)
+		})
+	}
+}
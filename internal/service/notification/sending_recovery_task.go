@@ -0,0 +1,118 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/gotomicro/ego/core/elog"
+	"github.com/meoying/dlock-go"
+	"github.com/robinlg/notification-platform/internal/domain"
+	"github.com/robinlg/notification-platform/internal/errs"
+	"github.com/robinlg/notification-platform/internal/pkg/loopjob"
+	"github.com/robinlg/notification-platform/internal/repository"
+	"github.com/robinlg/notification-platform/internal/repository/cache"
+)
+
+const (
+	SendingRecoveryTaskKey        = "sending_recovery_job"
+	defaultSendingTimeout         = 5 * time.Minute
+	defaultSendingRecoveryTimeout = 5 * time.Second
+
+	sendingTimeoutRetryReason  = "SENDING 状态超时，重新等待调度发送"
+	sendingTimeoutFailedReason = "SENDING 状态超时，且已超出计划发送时间"
+)
+
+// SendingRecoveryTask 修正在 SENDING 状态停留过久的通知
+// 进程在 CAS 为 SENDING 之后、标记发送结果之前崩溃，通知会一直停留在 SENDING 状态，
+// 仍在计划发送时间内的回到 PENDING 等待调度器重新发送，否则标记为 FAILED 并归还额度
+type SendingRecoveryTask struct {
+	repo      repository.NotificationRepository
+	lock      dlock.Client
+	queue     cache.DelayQueue
+	timeout   time.Duration
+	batchSize int
+	logger    *elog.Component
+}
+
+// NewSendingRecoveryTask 创建 SENDING 状态修正任务，timeout 为通知在 SENDING 状态允许停留的最长时间，小于等于0时使用默认值
+// queue 可以为 nil，此时回到 PENDING 的通知依赖调度器扫描数据库
+func NewSendingRecoveryTask(repo repository.NotificationRepository, lock dlock.Client, queue cache.DelayQueue, timeout time.Duration) *SendingRecoveryTask {
+	if timeout <= 0 {
+		timeout = defaultSendingTimeout
+	}
+	return &SendingRecoveryTask{
+		repo:      repo,
+		lock:      lock,
+		queue:     queue,
+		timeout:   timeout,
+		batchSize: defaultBatchSize,
+		logger:    elog.DefaultLogger,
+	}
+}
+
+func (task *SendingRecoveryTask) Start(ctx context.Context) {
+	job := loopjob.NewInfiniteLoop(task.lock, task.oneLoop, SendingRecoveryTaskKey)
+	job.Run(ctx)
+}
+
+func (task *SendingRecoveryTask) oneLoop(ctx context.Context) error {
+	loopCtx, cancel := context.WithTimeout(ctx, defaultSendingRecoveryTimeout)
+	defer cancel()
+
+	notifications, err := task.repo.FindTimeoutSending(loopCtx, task.timeout, task.batchSize)
+	if err != nil {
+		return err
+	}
+
+	if len(notifications) == 0 {
+		// 避免立刻又调度
+		time.Sleep(time.Second)
+		return nil
+	}
+
+	now := time.Now()
+	pending := make([]domain.Notification, 0, len(notifications))
+	for i := range notifications {
+		n := notifications[i]
+		if now.Before(n.ScheduledETime) {
+			n.Status = domain.SendStatusPending
+			n.NextRetryTime = now.UnixMilli()
+			n.StatusReason = sendingTimeoutRetryReason
+		} else {
+			n.Status = domain.SendStatusFailed
+			n.StatusReason = sendingTimeoutFailedReason
+		}
+		err1 := task.repo.CASRecoverSending(loopCtx, n)
+		if err1 != nil {
+			if errors.Is(err1, errs.ErrNotificationVersionMismatch) {
+				// 在此期间发送已经完成或者被其他节点修正
+				continue
+			}
+			task.logger.Warn("修正 SENDING 状态的通知失败",
+				elog.Any("notificationID", n.ID),
+				elog.String("status", n.Status.String()),
+				elog.FieldErr(err1))
+			continue
+		}
+		task.logger.Info("修正 SENDING 状态的通知",
+			elog.Any("notificationID", n.ID),
+			elog.String("status", n.Status.String()),
+			elog.String("reason", n.StatusReason))
+		if n.Status == domain.SendStatusPending {
+			pending = append(pending, n)
+		}
+	}
+	task.enqueue(loopCtx, pending)
+	return nil
+}
+
+// enqueue 将回到 PENDING 的通知重新加入延迟队列，加入失败时等待调度器兜底扫描数据库
+func (task *SendingRecoveryTask) enqueue(ctx context.Context, notifications []domain.Notification) {
+	if task.queue == nil || len(notifications) == 0 {
+		return
+	}
+	if err := task.queue.Add(ctx, notifications...); err != nil {
+		task.logger.Warn("通知重新加入延迟队列失败", elog.Int("count", len(notifications)), elog.FieldErr(err))
+	}
+}
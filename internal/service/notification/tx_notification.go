@@ -2,12 +2,16 @@ package notification
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/gotomicro/ego/core/elog"
 	"github.com/meoying/dlock-go"
 	"github.com/robinlg/notification-platform/internal/domain"
+	"github.com/robinlg/notification-platform/internal/errs"
 	"github.com/robinlg/notification-platform/internal/repository"
+	"github.com/robinlg/notification-platform/internal/repository/cache"
 	"github.com/robinlg/notification-platform/internal/service/config"
 	"github.com/robinlg/notification-platform/internal/service/sender"
 )
@@ -29,14 +33,18 @@ type txNotificationService struct {
 	logger    *elog.Component
 	lock      dlock.Client
 	sender    sender.NotificationSender
+	// queue 延迟队列，为 nil 时提交之后依赖调度器扫描数据库
+	queue cache.DelayQueue
 }
 
+// NewTxNotificationService 创建事务通知服务，queue 可以为 nil
 func NewTxNotificationService(
 	repo repository.TxNotificationRepository,
 	configSvc config.BusinessConfigService,
 	notiRepo repository.NotificationRepository,
 	lock dlock.Client,
 	sender sender.NotificationSender,
+	queue cache.DelayQueue,
 ) TxNotificationService {
 	return &txNotificationService{
 		repo:      repo,
@@ -45,6 +53,7 @@ func NewTxNotificationService(
 		notiRepo:  notiRepo,
 		lock:      lock,
 		sender:    sender,
+		queue:     queue,
 	}
 }
 
@@ -62,7 +71,14 @@ func (t *txNotificationService) Prepare(ctx context.Context, notification domain
 	}
 
 	cfg, err := t.configSvc.GetByID(ctx, notification.BizID)
+	if err != nil && !errors.Is(err, errs.ErrConfigNotFound) {
+		return 0, fmt.Errorf("%w: %w", errs.ErrDatabaseError, err)
+	}
 	if err == nil {
+		// 和直接发送一样，被业务方禁用的渠道不允许准备事务通知
+		if cfg.ChannelConfig != nil && cfg.ChannelConfig.IsChannelDisabled(notification.Channel) {
+			return 0, fmt.Errorf("%w: Channel = %s", errs.ErrChannelDisabled, notification.Channel)
+		}
 		now := time.Now().UnixMilli()
 		const second = 1000
 		if cfg.TxnConfig != nil {
@@ -82,9 +98,27 @@ func (t *txNotificationService) Commit(ctx context.Context, bizID int64, key str
 		return err
 	}
 	if notification.IsImmediate() {
-		_, err = t.sender.Send(ctx, notification)
+		// 先标记为发送中，避免和调度器重复发送
+		claimed, err1 := t.notiRepo.CASMarkSending(ctx, []domain.Notification{notification})
+		switch {
+		case err1 != nil:
+			// 标记失败时通知仍然是 PENDING 状态，交给调度器发送
+			t.logger.Warn("事务通知标记为发送中失败", elog.Any("notificationID", notification.ID), elog.FieldErr(err1))
+		case len(claimed) == 0:
+			// 调度器已经开始发送了
+			return nil
+		default:
+			_, err = t.sender.Send(ctx, claimed[0])
+			return err
+		}
+	}
+	if t.queue != nil {
+		// 加入失败时不影响提交结果，调度器兜底扫描数据库时依旧能够发现
+		if err1 := t.queue.Add(ctx, notification); err1 != nil {
+			t.logger.Warn("通知加入延迟队列失败", elog.Any("notificationID", notification.ID), elog.FieldErr(err1))
+		}
 	}
-	return err
+	return nil
 }
 
 func (t *txNotificationService) Cancel(ctx context.Context, bizID int64, key string) error {
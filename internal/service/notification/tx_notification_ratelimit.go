@@ -0,0 +1,33 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/robinlg/notification-platform/internal/domain"
+	"github.com/robinlg/notification-platform/internal/pkg/ratelimit"
+	configsvc "github.com/robinlg/notification-platform/internal/service/config"
+)
+
+var _ TxNotificationService = (*rateLimitTxNotificationService)(nil)
+
+// rateLimitTxNotificationService 对事务通知的 Prepare 限流的装饰器
+// Prepare 会创建通知，和发送请求共用业务方的限额；Commit 和 Cancel 只是推进已有通知的状态，不限流
+type rateLimitTxNotificationService struct {
+	TxNotificationService
+	*bizLimiter
+}
+
+// NewRateLimitTxNotificationService 创建带业务限流的事务通知服务
+func NewRateLimitTxNotificationService(svc TxNotificationService, configSvc configsvc.BusinessConfigService, limiter ratelimit.Limiter) TxNotificationService {
+	return &rateLimitTxNotificationService{
+		TxNotificationService: svc,
+		bizLimiter:            newBizLimiter(configSvc, limiter),
+	}
+}
+
+func (r *rateLimitTxNotificationService) Prepare(ctx context.Context, notification domain.Notification) (uint64, error) {
+	if err := r.limit(ctx, notification.BizID, 1); err != nil {
+		return 0, err
+	}
+	return r.TxNotificationService.Prepare(ctx, notification)
+}
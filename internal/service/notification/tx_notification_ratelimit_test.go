@@ -0,0 +1,68 @@
+//go:build unit
+
+package notification
+
+import (
+	"context"
+	"testing"
+
+	"github.com/robinlg/notification-platform/internal/domain"
+	"github.com/robinlg/notification-platform/internal/errs"
+	limitmocks "github.com/robinlg/notification-platform/internal/pkg/ratelimit/mocks"
+	configmocks "github.com/robinlg/notification-platform/internal/service/config/mocks"
+	notificationmocks "github.com/robinlg/notification-platform/internal/service/notification/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRateLimitTxNotificationService_Prepare(t *testing.T) {
+	t.Parallel()
+
+	const bizID = int64(1)
+
+	testCases := []struct {
+		name    string
+		mock    func(ctrl *gomock.Controller) (TxNotificationService, *configmocks.MockBusinessConfigService, *limitmocks.MockLimiter)
+		wantID  uint64
+		wantErr error
+	}{
+		{
+			name: "未超过限制",
+			mock: func(ctrl *gomock.Controller) (TxNotificationService, *configmocks.MockBusinessConfigService, *limitmocks.MockLimiter) {
+				svc := notificationmocks.NewMockTxNotificationService(ctrl)
+				configSvc := configmocks.NewMockBusinessConfigService(ctrl)
+				limiter := limitmocks.NewMockLimiter(ctrl)
+				configSvc.EXPECT().GetByID(gomock.Any(), bizID).Return(domain.BusinessConfig{ID: bizID, RateLimit: 10}, nil)
+				limiter.EXPECT().Limit(gomock.Any(), "ratelimit:biz:1", 1, 10).Return(false, nil)
+				svc.EXPECT().Prepare(gomock.Any(), gomock.Any()).Return(uint64(2), nil)
+				return svc, configSvc, limiter
+			},
+			wantID: 2,
+		},
+		{
+			name: "超过限制",
+			mock: func(ctrl *gomock.Controller) (TxNotificationService, *configmocks.MockBusinessConfigService, *limitmocks.MockLimiter) {
+				svc := notificationmocks.NewMockTxNotificationService(ctrl)
+				configSvc := configmocks.NewMockBusinessConfigService(ctrl)
+				limiter := limitmocks.NewMockLimiter(ctrl)
+				configSvc.EXPECT().GetByID(gomock.Any(), bizID).Return(domain.BusinessConfig{ID: bizID, RateLimit: 2}, nil)
+				limiter.EXPECT().Limit(gomock.Any(), "ratelimit:biz:1", 1, 2).Return(true, nil)
+				return svc, configSvc, limiter
+			},
+			wantErr: errs.ErrRateLimited,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			svc := NewRateLimitTxNotificationService(tc.mock(ctrl))
+			id, err := svc.Prepare(context.Background(), domain.Notification{BizID: bizID})
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.wantID, id)
+		})
+	}
+}
@@ -0,0 +1,103 @@
+//go:build unit
+
+package notification
+
+import (
+	"context"
+	"testing"
+
+	"github.com/robinlg/notification-platform/internal/domain"
+	"github.com/robinlg/notification-platform/internal/errs"
+	repositorymocks "github.com/robinlg/notification-platform/internal/repository/mocks"
+	configmocks "github.com/robinlg/notification-platform/internal/service/config/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestTxNotificationService_Prepare(t *testing.T) {
+	t.Parallel()
+
+	const bizID = int64(1)
+	notification := domain.Notification{
+		BizID:     bizID,
+		Key:       "key-1",
+		Channel:   domain.ChannelSMS,
+		Receivers: []string{"13800138000"},
+		Template:  domain.Template{ID: 100},
+	}
+
+	testCases := []struct {
+		name    string
+		mock    func(ctrl *gomock.Controller) (*repositorymocks.MockTxNotificationRepository, *configmocks.MockBusinessConfigService)
+		wantID  uint64
+		wantErr error
+	}{
+		{
+			name: "准备成功",
+			mock: func(ctrl *gomock.Controller) (*repositorymocks.MockTxNotificationRepository, *configmocks.MockBusinessConfigService) {
+				repo := repositorymocks.NewMockTxNotificationRepository(ctrl)
+				configSvc := configmocks.NewMockBusinessConfigService(ctrl)
+				configSvc.EXPECT().GetByID(gomock.Any(), bizID).Return(domain.BusinessConfig{
+					ID:            bizID,
+					ChannelConfig: &domain.ChannelConfig{Channels: []domain.ChannelItem{{Channel: "SMS", Enabled: true}}},
+					TxnConfig:     &domain.TxnConfig{InitialDelay: 10},
+				}, nil)
+				repo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, txn domain.TxNotification) (uint64, error) {
+					assert.Equal(t, domain.SendStatusPrepare, txn.Notification.Status)
+					assert.Equal(t, domain.TxNotificationStatusPrepare, txn.Status)
+					assert.Positive(t, txn.NextCheckTime)
+					return 2, nil
+				})
+				return repo, configSvc
+			},
+			wantID: 2,
+		},
+		{
+			name: "渠道被禁用",
+			mock: func(ctrl *gomock.Controller) (*repositorymocks.MockTxNotificationRepository, *configmocks.MockBusinessConfigService) {
+				repo := repositorymocks.NewMockTxNotificationRepository(ctrl)
+				configSvc := configmocks.NewMockBusinessConfigService(ctrl)
+				configSvc.EXPECT().GetByID(gomock.Any(), bizID).Return(domain.BusinessConfig{
+					ID:            bizID,
+					ChannelConfig: &domain.ChannelConfig{Channels: []domain.ChannelItem{{Channel: "SMS", Enabled: false}}},
+				}, nil)
+				return repo, configSvc
+			},
+			wantErr: errs.ErrChannelDisabled,
+		},
+		{
+			name: "没有业务配置时不限制渠道",
+			mock: func(ctrl *gomock.Controller) (*repositorymocks.MockTxNotificationRepository, *configmocks.MockBusinessConfigService) {
+				repo := repositorymocks.NewMockTxNotificationRepository(ctrl)
+				configSvc := configmocks.NewMockBusinessConfigService(ctrl)
+				configSvc.EXPECT().GetByID(gomock.Any(), bizID).Return(domain.BusinessConfig{}, errs.ErrConfigNotFound)
+				repo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(uint64(3), nil)
+				return repo, configSvc
+			},
+			wantID: 3,
+		},
+		{
+			name: "获取业务配置失败",
+			mock: func(ctrl *gomock.Controller) (*repositorymocks.MockTxNotificationRepository, *configmocks.MockBusinessConfigService) {
+				configSvc := configmocks.NewMockBusinessConfigService(ctrl)
+				configSvc.EXPECT().GetByID(gomock.Any(), bizID).Return(domain.BusinessConfig{}, assert.AnError)
+				return repositorymocks.NewMockTxNotificationRepository(ctrl), configSvc
+			},
+			wantErr: errs.ErrDatabaseError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo, configSvc := tc.mock(ctrl)
+			svc := NewTxNotificationService(repo, configSvc, nil, nil, nil, nil)
+			id, err := svc.Prepare(context.Background(), notification)
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.wantID, id)
+		})
+	}
+}
@@ -0,0 +1,147 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"mime"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// defaultSMTPTimeout ctx 没有截止时间时单次发送的超时时间，避免 SMTP 服务没有响应时一直占用发送协程
+const defaultSMTPTimeout = 30 * time.Second
+
+// SMTPClient 基于 SMTP 协议的邮件客户端
+type SMTPClient struct {
+	addr     string
+	host     string
+	username string
+	password string
+	dialer   net.Dialer
+	timeout  time.Duration
+}
+
+// NewSMTPClient 创建 SMTP 客户端，username 为空时不进行认证，便于对接本地的 SMTP 服务
+func NewSMTPClient(host string, port int, username, password string) *SMTPClient {
+	return &SMTPClient{
+		addr:     net.JoinHostPort(host, strconv.Itoa(port)),
+		host:     host,
+		username: username,
+		password: password,
+		timeout:  defaultSMTPTimeout,
+	}
+}
+
+func (s *SMTPClient) Send(ctx context.Context, req SendReq) error {
+	// 发件人可以是 "名称 <地址>" 的形式，信封中只能使用地址
+	from, err := mail.ParseAddress(req.From)
+	if err != nil {
+		return fmt.Errorf("%w: 发件人 %q 不合法 %w", ErrInvalidParameter, req.From, err)
+	}
+	if len(req.To) == 0 {
+		return fmt.Errorf("%w: %v", ErrInvalidParameter, "收件人不能为空")
+	}
+
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.timeout)
+		defer cancel()
+	}
+
+	err = s.sendMail(ctx, from.Address, req.To, s.buildMessage(from, req))
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrSendFailed, err)
+	}
+	return nil
+}
+
+// sendMail 与 smtp.SendMail 的流程相同，区别在于连接的建立和读写都受 ctx 约束
+func (s *SMTPClient) sendMail(ctx context.Context, from string, to []string, msg []byte) error {
+	conn, err := s.dialer.DialContext(ctx, "tcp", s.addr)
+	if err != nil {
+		return err
+	}
+	deadline, _ := ctx.Deadline()
+	if err = conn.SetDeadline(deadline); err != nil {
+		_ = conn.Close()
+		return err
+	}
+	// ctx 被取消时关闭连接，让阻塞中的读写立刻返回
+	stop := context.AfterFunc(ctx, func() { _ = conn.Close() })
+	defer stop()
+
+	c, err := smtp.NewClient(conn, s.host)
+	if err != nil {
+		_ = conn.Close()
+		return err
+	}
+	defer c.Close()
+
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		if err = c.StartTLS(&tls.Config{ServerName: s.host}); err != nil {
+			return err
+		}
+	}
+	if s.username != "" {
+		if ok, _ := c.Extension("AUTH"); !ok {
+			return errors.New("SMTP 服务不支持认证")
+		}
+		if err = c.Auth(smtp.PlainAuth("", s.username, s.password, s.host)); err != nil {
+			return err
+		}
+	}
+	if err = c.Mail(from); err != nil {
+		return err
+	}
+	for _, addr := range to {
+		if err = c.Rcpt(addr); err != nil {
+			return err
+		}
+	}
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err = w.Write(msg); err != nil {
+		return err
+	}
+	if err = w.Close(); err != nil {
+		return err
+	}
+	return c.Quit()
+}
+
+func (s *SMTPClient) buildMessage(from *mail.Address, req SendReq) []byte {
+	contentType := req.ContentType
+	if contentType == "" {
+		contentType = ContentTypeText
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("From: " + from.String() + "\r\n")
+	buf.WriteString("To: " + strings.Join(req.To, ", ") + "\r\n")
+	buf.WriteString("Subject: " + mime.BEncoding.Encode("UTF-8", req.Subject) + "\r\n")
+	buf.WriteString("Date: " + time.Now().Format(time.RFC1123Z) + "\r\n")
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: " + contentType + "; charset=UTF-8\r\n")
+	buf.WriteString("Content-Transfer-Encoding: base64\r\n")
+	buf.WriteString("\r\n")
+
+	// 正文按照每行 76 个字符进行 base64 编码
+	const lineLen = 76
+	encoded := base64.StdEncoding.EncodeToString([]byte(req.Body))
+	for len(encoded) > lineLen {
+		buf.WriteString(encoded[:lineLen] + "\r\n")
+		encoded = encoded[lineLen:]
+	}
+	buf.WriteString(encoded + "\r\n")
+	return buf.Bytes()
+}
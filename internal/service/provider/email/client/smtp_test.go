@@ -0,0 +1,198 @@
+//go:build unit
+
+package client
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"io"
+	"mime"
+	"net"
+	"net/mail"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSMTPServer 最简单的本地 SMTP 服务，只记录收到的邮件
+type fakeSMTPServer struct {
+	listener net.Listener
+	from     string
+	to       []string
+	data     chan string
+}
+
+func newFakeSMTPServer(t *testing.T) *fakeSMTPServer {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	s := &fakeSMTPServer{listener: l, data: make(chan string, 1)}
+	go s.serve()
+	t.Cleanup(func() { _ = l.Close() })
+	return s
+}
+
+func (s *fakeSMTPServer) port() int {
+	return s.listener.Addr().(*net.TCPAddr).Port
+}
+
+func (s *fakeSMTPServer) serve() {
+	conn, err := s.listener.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	r := bufio.NewReader(conn)
+	reply := func(line string) { _, _ = conn.Write([]byte(line + "\r\n")) }
+	reply("220 localhost ESMTP")
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		cmd := strings.TrimSpace(line)
+		switch upper := strings.ToUpper(cmd); {
+		case strings.HasPrefix(upper, "EHLO"), strings.HasPrefix(upper, "HELO"):
+			reply("250 localhost")
+		case strings.HasPrefix(upper, "MAIL FROM:"):
+			s.from = strings.Trim(cmd[len("MAIL FROM:"):], "<> ")
+			reply("250 OK")
+		case strings.HasPrefix(upper, "RCPT TO:"):
+			s.to = append(s.to, strings.Trim(cmd[len("RCPT TO:"):], "<> "))
+			reply("250 OK")
+		case upper == "DATA":
+			reply("354 End data with <CR><LF>.<CR><LF>")
+			var sb strings.Builder
+			for {
+				l, err := r.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if l == ".\r\n" {
+					break
+				}
+				sb.WriteString(l)
+			}
+			s.data <- sb.String()
+			reply("250 OK")
+		case upper == "QUIT":
+			reply("221 Bye")
+			return
+		default:
+			reply("250 OK")
+		}
+	}
+}
+
+func TestSMTPClient_Send(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name            string
+		contentType     string
+		wantContentType string
+	}{
+		{
+			name:            "未指定正文类型",
+			wantContentType: ContentTypeText,
+		},
+		{
+			name:            "HTML",
+			contentType:     ContentTypeHTML,
+			wantContentType: ContentTypeHTML,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			testSMTPClientSend(t, tc.contentType, tc.wantContentType)
+		})
+	}
+}
+
+func testSMTPClientSend(t *testing.T, contentType, wantContentType string) {
+	server := newFakeSMTPServer(t)
+	c := NewSMTPClient("127.0.0.1", server.port(), "", "")
+
+	err := c.Send(context.Background(), SendReq{
+		From:        "通知平台 <no-reply@example.com>",
+		To:          []string{"a@example.com", "b@example.com"},
+		Subject:     "验证码",
+		Body:        "您的验证码是 123456",
+		ContentType: contentType,
+	})
+	require.NoError(t, err)
+
+	data := <-server.data
+	assert.Equal(t, "no-reply@example.com", server.from)
+	assert.Equal(t, []string{"a@example.com", "b@example.com"}, server.to)
+
+	msg, err := mail.ReadMessage(strings.NewReader(data))
+	require.NoError(t, err)
+	subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	require.NoError(t, err)
+	assert.Equal(t, "验证码", subject)
+	mediaType, _, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	require.NoError(t, err)
+	assert.Equal(t, wantContentType, mediaType)
+
+	from, err := mail.ParseAddress(msg.Header.Get("From"))
+	require.NoError(t, err)
+	assert.Equal(t, "通知平台", from.Name)
+
+	body, err := io.ReadAll(base64.NewDecoder(base64.StdEncoding, msg.Body))
+	require.NoError(t, err)
+	assert.Equal(t, "您的验证码是 123456", string(body))
+}
+
+func TestSMTPClient_SendInvalidParameter(t *testing.T) {
+	t.Parallel()
+
+	c := NewSMTPClient("127.0.0.1", 25, "", "")
+	err := c.Send(context.Background(), SendReq{From: "", To: []string{"a@example.com"}})
+	assert.ErrorIs(t, err, ErrInvalidParameter)
+
+	err = c.Send(context.Background(), SendReq{From: "no-reply@example.com"})
+	assert.ErrorIs(t, err, ErrInvalidParameter)
+
+	// 没有可用的 SMTP 服务
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	port := l.Addr().(*net.TCPAddr).Port
+	require.NoError(t, l.Close())
+	c = NewSMTPClient("127.0.0.1", port, "", "")
+	err = c.Send(context.Background(), SendReq{From: "no-reply@example.com", To: []string{"a@example.com"}})
+	assert.ErrorIs(t, err, ErrSendFailed)
+}
+
+func TestSMTPClient_SendTimeout(t *testing.T) {
+	t.Parallel()
+
+	// 只建立连接不返回任何响应的 SMTP 服务
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = l.Close() })
+	done := make(chan struct{})
+	t.Cleanup(func() { close(done) })
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		<-done
+		_ = conn.Close()
+	}()
+
+	c := NewSMTPClient("127.0.0.1", l.Addr().(*net.TCPAddr).Port, "", "")
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	err = c.Send(ctx, SendReq{From: "no-reply@example.com", To: []string{"a@example.com"}})
+	assert.ErrorIs(t, err, ErrSendFailed)
+	assert.Less(t, time.Since(start), time.Second)
+}
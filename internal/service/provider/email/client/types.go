@@ -0,0 +1,36 @@
+package client
+
+import (
+	"context"
+	"errors"
+)
+
+// 通用错误定义
+var (
+	ErrSendFailed       = errors.New("发送邮件失败")
+	ErrInvalidParameter = errors.New("参数无效")
+)
+
+// Client 邮件客户端接口 (抽象)
+//
+//go:generate mockgen -source=./types.go -destination=./mocks/email.mock.go -package=emailmocks -typed Client
+type Client interface {
+	// Send 发送邮件，ctx 的截止时间约束整个发送过程
+	Send(ctx context.Context, req SendReq) error
+}
+
+// SendReq 发送邮件请求参数
+type SendReq struct {
+	From    string   // 发件人
+	To      []string // 收件人
+	Subject string   // 主题
+	Body    string   // 正文
+	// ContentType 正文类型，为空时按照纯文本发送
+	ContentType string
+}
+
+// 正文类型
+const (
+	ContentTypeText = "text/plain"
+	ContentTypeHTML = "text/html"
+)
@@ -0,0 +1,65 @@
+package email
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/robinlg/notification-platform/internal/domain"
+	"github.com/robinlg/notification-platform/internal/errs"
+	"github.com/robinlg/notification-platform/internal/service/provider"
+	"github.com/robinlg/notification-platform/internal/service/provider/email/client"
+	"github.com/robinlg/notification-platform/internal/service/template/manage"
+)
+
+// emailProvider 邮件供应商
+type emailProvider struct {
+	name        string
+	templateSvc manage.ChannelTemplateService
+	client      client.Client
+	// contentType 邮件正文类型，同一个供应商下的模板使用相同的正文类型
+	contentType string
+}
+
+// NewEmailProvider 创建邮件供应商，contentType 为 client.ContentTypeText 或 client.ContentTypeHTML，为空时按照纯文本发送
+func NewEmailProvider(name string, templateSvc manage.ChannelTemplateService, client client.Client, contentType string) provider.Provider {
+	return &emailProvider{
+		name:        name,
+		templateSvc: templateSvc,
+		client:      client,
+		contentType: contentType,
+	}
+}
+
+// Send 发送邮件
+// 模板内容的第一行作为邮件主题，其余部分作为邮件正文，模板签名作为发件人
+func (p *emailProvider) Send(ctx context.Context, notification domain.Notification) (domain.SendResponse, error) {
+	tmpl, err := p.templateSvc.GetTemplateByIDAndProviderInfo(ctx, notification.Template.ID, p.name, domain.ChannelEmail)
+	if err != nil {
+		return domain.SendResponse{}, fmt.Errorf("%w: %w", errs.ErrSendNotificationFailed, err)
+	}
+
+	activeVersion := tmpl.ActiveVersion()
+	if activeVersion == nil {
+		return domain.SendResponse{}, fmt.Errorf("%w: 无已发布模版", errs.ErrSendNotificationFailed)
+	}
+
+	subject, body := activeVersion.RenderTitleAndBody(notification.Template.Params)
+	err = p.client.Send(ctx, client.SendReq{
+		From:        activeVersion.Signature,
+		To:          notification.Receivers,
+		Subject:     subject,
+		Body:        body,
+		ContentType: p.contentType,
+	})
+	// SMTP 没有请求ID，只记录供应商名称
+	result := domain.ProviderResult{Name: p.name}
+	if err != nil {
+		return domain.SendResponse{Provider: result}, fmt.Errorf("%w: %w", errs.ErrSendNotificationFailed, err)
+	}
+
+	return domain.SendResponse{
+		NotificationID: notification.ID,
+		Status:         domain.SendStatusSucceeded,
+		Provider:       result,
+	}, nil
+}
@@ -0,0 +1,61 @@
+package inapp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/robinlg/notification-platform/internal/domain"
+	"github.com/robinlg/notification-platform/internal/errs"
+	"github.com/robinlg/notification-platform/internal/service/provider"
+	"github.com/robinlg/notification-platform/internal/service/template/manage"
+)
+
+// providerName 站内信由平台自己投递，没有外部供应商
+const providerName = "in_app"
+
+// inAppProvider 站内信供应商，为每个接收者渲染一条站内信
+// 站内信不在这里写入，而是随发送结果返回，由发送器在标记通知成功的同一个事务中写入收件箱
+type inAppProvider struct {
+	templateSvc manage.ChannelTemplateService
+}
+
+func NewInAppProvider(templateSvc manage.ChannelTemplateService) provider.Provider {
+	return &inAppProvider{
+		templateSvc: templateSvc,
+	}
+}
+
+// Send 为每个接收者（用户ID）生成一条站内信
+// 模板内容的第一行作为标题，其余部分作为内容，站内信不需要供应商审核，所以直接使用平台审核通过的版本
+func (p *inAppProvider) Send(ctx context.Context, notification domain.Notification) (domain.SendResponse, error) {
+	tmpl, err := p.templateSvc.GetTemplateByID(ctx, notification.Template.ID)
+	if err != nil {
+		return domain.SendResponse{}, fmt.Errorf("%w: %w", errs.ErrSendNotificationFailed, err)
+	}
+
+	activeVersion := tmpl.ActiveVersion()
+	if activeVersion == nil {
+		return domain.SendResponse{}, fmt.Errorf("%w: 无已发布模版", errs.ErrSendNotificationFailed)
+	}
+	if activeVersion.AuditStatus != domain.AuditStatusApproved {
+		return domain.SendResponse{}, fmt.Errorf("%w: %w: versionID=%d", errs.ErrSendNotificationFailed, errs.ErrTemplateVersionNotApprovedByPlatform, activeVersion.ID)
+	}
+
+	title, content := activeVersion.RenderTitleAndBody(notification.Template.Params)
+	messages := make([]domain.InboxMessage, 0, len(notification.Receivers))
+	for _, receiver := range notification.Receivers {
+		messages = append(messages, domain.InboxMessage{
+			NotificationID: notification.ID,
+			BizID:          notification.BizID,
+			Receiver:       receiver,
+			Title:          title,
+			Content:        content,
+		})
+	}
+	return domain.SendResponse{
+		NotificationID: notification.ID,
+		Status:         domain.SendStatusSucceeded,
+		Provider:       domain.ProviderResult{Name: providerName},
+		InboxMessages:  messages,
+	}, nil
+}
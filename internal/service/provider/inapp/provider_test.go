@@ -0,0 +1,79 @@
+//go:build unit
+
+package inapp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/robinlg/notification-platform/internal/domain"
+	"github.com/robinlg/notification-platform/internal/errs"
+	templatemocks "github.com/robinlg/notification-platform/internal/service/template/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestInAppProvider_Send(t *testing.T) {
+	t.Parallel()
+
+	notification := domain.Notification{
+		ID:        1,
+		BizID:     2,
+		Receivers: []string{"user1", "user2"},
+		Template: domain.Template{
+			ID:     3,
+			Params: map[string]string{"id": "42"},
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		template domain.ChannelTemplate
+		want     []domain.InboxMessage
+		wantErr  error
+	}{
+		{
+			name: "为每个接收者生成站内信",
+			template: domain.ChannelTemplate{
+				ActiveVersionID: 4,
+				Versions: []domain.ChannelTemplateVersion{
+					{ID: 4, AuditStatus: domain.AuditStatusApproved, Content: "订单已发货\n您的订单 ${id} 已经发货"},
+				},
+			},
+			want: []domain.InboxMessage{
+				{NotificationID: 1, BizID: 2, Receiver: "user1", Title: "订单已发货", Content: "您的订单 42 已经发货"},
+				{NotificationID: 1, BizID: 2, Receiver: "user2", Title: "订单已发货", Content: "您的订单 42 已经发货"},
+			},
+		},
+		{
+			name: "模版未通过审核",
+			template: domain.ChannelTemplate{
+				ActiveVersionID: 4,
+				Versions: []domain.ChannelTemplateVersion{
+					{ID: 4, AuditStatus: domain.AuditStatusPending, Content: "订单已发货"},
+				},
+			},
+			wantErr: errs.ErrTemplateVersionNotApprovedByPlatform,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			templateSvc := templatemocks.NewMockChannelTemplateService(ctrl)
+			templateSvc.EXPECT().GetTemplateByID(gomock.Any(), notification.Template.ID).Return(tc.template, nil)
+
+			resp, err := NewInAppProvider(templateSvc).Send(context.Background(), notification)
+			assert.ErrorIs(t, err, tc.wantErr)
+			if err != nil {
+				return
+			}
+			// 站内信随发送结果返回，由发送器和通知状态一起写入
+			assert.Equal(t, domain.SendStatusSucceeded, resp.Status)
+			assert.Equal(t, tc.want, resp.InboxMessages)
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package limit
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gotomicro/ego/core/elog"
+	"github.com/robinlg/notification-platform/internal/domain"
+	"github.com/robinlg/notification-platform/internal/errs"
+	"github.com/robinlg/notification-platform/internal/repository/cache"
+	"github.com/robinlg/notification-platform/internal/service/provider"
+)
+
+var _ provider.Provider = (*limitProvider)(nil)
+
+// limitProvider 供应商限流装饰器，按照供应商配置的 QPSLimit 和 DailyLimit 限制请求
+// 达到上限时直接返回错误而不调用供应商，由调用方继续尝试下一个供应商
+type limitProvider struct {
+	provider.Provider
+	info   domain.Provider
+	cache  cache.ProviderLimitCache
+	logger *elog.Component
+}
+
+// NewProvider 使用供应商信息 info 中的限额包装供应商 p
+// 限额在创建时确定，选择器刷新供应商信息时会重新创建，使限额的调整生效
+func NewProvider(p provider.Provider, info domain.Provider, limitCache cache.ProviderLimitCache) provider.Provider {
+	return &limitProvider{
+		Provider: p,
+		info:     info,
+		cache:    limitCache,
+		logger:   elog.DefaultLogger,
+	}
+}
+
+func (p *limitProvider) Send(ctx context.Context, notification domain.Notification) (domain.SendResponse, error) {
+	err := p.cache.Acquire(ctx, p.info.ID, p.info.QPSLimit, p.info.DailyLimit)
+	if err != nil {
+		if errors.Is(err, errs.ErrProviderQPSLimited) || errors.Is(err, errs.ErrProviderDailyLimited) {
+			return domain.SendResponse{Provider: domain.ProviderResult{Name: p.info.Name}}, err
+		}
+		// Redis 出错时放行，由供应商自身的限流兜底
+		p.logger.Warn("获取供应商限流额度失败",
+			elog.Int64("providerID", p.info.ID),
+			elog.String("provider", p.info.Name),
+			elog.FieldErr(err))
+	}
+	return p.Provider.Send(ctx, notification)
+}
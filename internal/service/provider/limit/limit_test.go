@@ -0,0 +1,107 @@
+//go:build unit
+
+package limit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/robinlg/notification-platform/internal/domain"
+	"github.com/robinlg/notification-platform/internal/errs"
+	"github.com/robinlg/notification-platform/internal/repository/cache"
+	rediscache "github.com/robinlg/notification-platform/internal/repository/cache/redis"
+	providermocks "github.com/robinlg/notification-platform/internal/service/provider/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestLimitProvider_Send(t *testing.T) {
+	t.Parallel()
+
+	loc := time.FixedZone("UTC+14", 14*3600)
+	tests := []struct {
+		name string
+		info domain.Provider
+		// sends 发送次数
+		sends int
+		// wantCalls 实际调用供应商的次数
+		wantCalls int
+		wantErr   error
+	}{
+		{
+			name:      "达到每秒上限",
+			info:      domain.Provider{ID: 1, Name: "aliyun", QPSLimit: 2, DailyLimit: 100},
+			sends:     3,
+			wantCalls: 2,
+			wantErr:   errs.ErrProviderQPSLimited,
+		},
+		{
+			name:      "达到每日上限",
+			info:      domain.Provider{ID: 2, Name: "aliyun", QPSLimit: 100, DailyLimit: 2},
+			sends:     3,
+			wantCalls: 2,
+			wantErr:   errs.ErrProviderDailyLimited,
+		},
+		{
+			name:      "没有达到上限",
+			info:      domain.Provider{ID: 3, Name: "aliyun", QPSLimit: 100, DailyLimit: 100},
+			sends:     3,
+			wantCalls: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mr := miniredis.RunT(t)
+			limitCache := rediscache.NewProviderLimitCache(redis.NewClient(&redis.Options{Addr: mr.Addr()}), loc)
+			p := providermocks.NewMockProvider(ctrl)
+			p.EXPECT().Send(gomock.Any(), gomock.Any()).Return(domain.SendResponse{}, nil).Times(tt.wantCalls)
+			lp := NewProvider(p, tt.info, limitCache)
+
+			// 每秒计数按照秒划分，从一秒的开头开始发送，避免跨秒
+			time.Sleep(time.Until(time.Now().Truncate(time.Second).Add(time.Second)))
+			var err error
+			for i := 0; i < tt.sends; i++ {
+				var resp domain.SendResponse
+				resp, err = lp.Send(context.Background(), domain.Notification{})
+				if err != nil {
+					assert.Equal(t, tt.info.Name, resp.Provider.Name)
+				}
+			}
+			assert.ErrorIs(t, err, tt.wantErr)
+
+			used, err := limitCache.DailyUsed(context.Background(), tt.info.ID)
+			require.NoError(t, err)
+			assert.Equal(t, int64(tt.wantCalls), used)
+			// 每日计数按照配置的时区划分自然日
+			assert.True(t, mr.Exists(cache.ProviderDailyKey(tt.info.ID, time.Now().In(loc))))
+		})
+	}
+}
+
+func TestLimitProvider_RedisError(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mr := miniredis.RunT(t)
+	limitCache := rediscache.NewProviderLimitCache(redis.NewClient(&redis.Options{Addr: mr.Addr()}), time.UTC)
+	mr.Close()
+
+	// Redis 不可用时放行
+	p := providermocks.NewMockProvider(ctrl)
+	p.EXPECT().Send(gomock.Any(), gomock.Any()).Return(domain.SendResponse{}, nil).Times(2)
+	lp := NewProvider(p, domain.Provider{ID: 1, Name: "aliyun", QPSLimit: 1, DailyLimit: 1}, limitCache)
+	for i := 0; i < 2; i++ {
+		_, err := lp.Send(context.Background(), domain.Notification{})
+		assert.NoError(t, err)
+	}
+}
@@ -2,9 +2,14 @@ package manage
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
+	"github.com/ego-component/egorm"
 	"github.com/robinlg/notification-platform/internal/domain"
+	"github.com/robinlg/notification-platform/internal/errs"
 	"github.com/robinlg/notification-platform/internal/repository"
+	"github.com/robinlg/notification-platform/internal/repository/cache"
 )
 
 // Service 供应商服务接口
@@ -13,17 +18,21 @@ import (
 type Service interface {
 	// Create 创建供应商
 	Create(ctx context.Context, provider domain.Provider) (domain.Provider, error)
+	// GetDailyRemaining 获取供应商当日剩余的请求数
+	GetDailyRemaining(ctx context.Context, providerID int64) (int64, error)
 }
 
 // providerService 供应商服务实现
 type providerService struct {
-	repo repository.ProviderRepository
+	repo       repository.ProviderRepository
+	limitCache cache.ProviderLimitCache
 }
 
 // NewProviderService 创建供应商服务
-func NewProviderService(repo repository.ProviderRepository) Service {
+func NewProviderService(repo repository.ProviderRepository, limitCache cache.ProviderLimitCache) Service {
 	return &providerService{
-		repo: repo,
+		repo:       repo,
+		limitCache: limitCache,
 	}
 }
 
@@ -34,3 +43,20 @@ func (s *providerService) Create(ctx context.Context, provider domain.Provider)
 	}
 	return s.repo.Create(ctx, provider)
 }
+
+// GetDailyRemaining 获取供应商当日剩余的请求数
+func (s *providerService) GetDailyRemaining(ctx context.Context, providerID int64) (int64, error) {
+	provider, err := s.repo.GetByID(ctx, providerID)
+	if err != nil {
+		if errors.Is(err, egorm.ErrRecordNotFound) {
+			return 0, fmt.Errorf("%w: providerID = %d", errs.ErrProviderNotFound, providerID)
+		}
+		return 0, fmt.Errorf("%w: %w", errs.ErrDatabaseError, err)
+	}
+
+	used, err := s.limitCache.DailyUsed(ctx, providerID)
+	if err != nil {
+		return 0, err
+	}
+	return max(int64(provider.DailyLimit)-used, 0), nil
+}
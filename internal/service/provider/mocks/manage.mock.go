@@ -79,3 +79,42 @@ func (c *MockServiceCreateCall) DoAndReturn(f func(context.Context, domain.Provi
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// GetDailyRemaining mocks base method.
+func (m *MockService) GetDailyRemaining(ctx context.Context, providerID int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDailyRemaining", ctx, providerID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDailyRemaining indicates an expected call of GetDailyRemaining.
+func (mr *MockServiceMockRecorder) GetDailyRemaining(ctx, providerID any) *MockServiceGetDailyRemainingCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDailyRemaining", reflect.TypeOf((*MockService)(nil).GetDailyRemaining), ctx, providerID)
+	return &MockServiceGetDailyRemainingCall{Call: call}
+}
+
+// MockServiceGetDailyRemainingCall wrap *gomock.Call
+type MockServiceGetDailyRemainingCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceGetDailyRemainingCall) Return(arg0 int64, arg1 error) *MockServiceGetDailyRemainingCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceGetDailyRemainingCall) Do(f func(context.Context, int64) (int64, error)) *MockServiceGetDailyRemainingCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceGetDailyRemainingCall) DoAndReturn(f func(context.Context, int64) (int64, error)) *MockServiceGetDailyRemainingCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -38,6 +38,7 @@ func (p *smsProvider) Send(ctx context.Context, notification domain.Notification
 		return domain.SendResponse{}, fmt.Errorf("%w: 无已发布模版", errs.ErrSendNotificationFailed)
 	}
 
+	result := domain.ProviderResult{Name: p.name}
 	const first = 0
 	resp, err := p.client.Send(client.SendReq{
 		PhoneNumbers:  notification.Receivers,
@@ -46,17 +47,21 @@ func (p *smsProvider) Send(ctx context.Context, notification domain.Notification
 		TemplateParam: notification.Template.Params,
 	})
 	if err != nil {
-		return domain.SendResponse{}, fmt.Errorf("%w: %w", errs.ErrSendNotificationFailed, err)
+		return domain.SendResponse{Provider: result}, fmt.Errorf("%w: %w", errs.ErrSendNotificationFailed, err)
 	}
 
+	result.RequestID = resp.RequestID
+	result.Code = client.OK
 	for _, status := range resp.PhoneNumbers {
 		if !strings.EqualFold(status.Code, "OK") {
-			return domain.SendResponse{}, fmt.Errorf("%w: Code = %s, Message = %s", errs.ErrSendNotificationFailed, status.Code, status.Message)
+			result.Code, result.Message = status.Code, status.Message
+			return domain.SendResponse{Provider: result}, fmt.Errorf("%w: Code = %s, Message = %s", errs.ErrSendNotificationFailed, status.Code, status.Message)
 		}
 	}
 
 	return domain.SendResponse{
 		NotificationID: notification.ID,
 		Status:         domain.SendStatusSucceeded,
+		Provider:       result,
 	}, nil
 }
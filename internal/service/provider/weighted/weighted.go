@@ -0,0 +1,207 @@
+package weighted
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gotomicro/ego/core/elog"
+	"github.com/robinlg/notification-platform/internal/domain"
+	"github.com/robinlg/notification-platform/internal/errs"
+	"github.com/robinlg/notification-platform/internal/repository"
+	"github.com/robinlg/notification-platform/internal/repository/cache"
+	"github.com/robinlg/notification-platform/internal/service/provider"
+	"github.com/robinlg/notification-platform/internal/service/provider/limit"
+	"golang.org/x/sync/singleflight"
+)
+
+var (
+	_ provider.Selector        = (*selector)(nil)
+	_ provider.SelectorBuilder = (*SelectorBuilder)(nil)
+)
+
+const (
+	defaultRefreshInterval = 30 * time.Second
+	defaultLoadTimeout     = 3 * time.Second
+)
+
+// selector 第一次调用 Next 返回平滑加权轮询选出的供应商，
+// 之后按照权重从高到低依次返回剩余的供应商
+type selector struct {
+	idx       int
+	providers []provider.Provider
+}
+
+func (s *selector) Next(_ context.Context, _ domain.Notification) (provider.Provider, error) {
+	if len(s.providers) == s.idx {
+		return nil, fmt.Errorf("%w", errs.ErrNoAvailableProvider)
+	}
+
+	p := s.providers[s.idx]
+	s.idx++
+	return p, nil
+}
+
+// node 参与加权轮询的供应商
+type node struct {
+	name          string
+	provider      provider.Provider
+	weight        int
+	currentWeight int
+}
+
+// SelectorBuilder 基于平滑加权轮询的选择器构造器
+// 权重和限额来自 providers 表，并且会定期刷新，因此调整权重或者限额后无需重新部署即可生效
+type SelectorBuilder struct {
+	channel   domain.Channel
+	repo      repository.ProviderRepository
+	providers map[string]provider.Provider // 供应商名称 -> 供应商实现
+	// limitCache 不为 nil 时，使用供应商配置的 QPSLimit 和 DailyLimit 限流
+	limitCache cache.ProviderLimitCache
+
+	refreshInterval time.Duration
+	loadTimeout     time.Duration
+
+	mu       sync.Mutex
+	nodes    []*node
+	loadTime time.Time
+	// group 保证同一时刻只有一个刷新权重的请求
+	group singleflight.Group
+
+	logger *elog.Component
+}
+
+// NewSelectorBuilder 创建加权选择器构造器
+// providers 是供应商名称到供应商实现的映射，只有在 providers 表中处于启用状态的供应商才会参与选择
+// limitCache 为 nil 时不限流
+func NewSelectorBuilder(
+	channel domain.Channel,
+	repo repository.ProviderRepository,
+	providers map[string]provider.Provider,
+	limitCache cache.ProviderLimitCache,
+) *SelectorBuilder {
+	return &SelectorBuilder{
+		channel:         channel,
+		repo:            repo,
+		providers:       providers,
+		limitCache:      limitCache,
+		refreshInterval: defaultRefreshInterval,
+		loadTimeout:     defaultLoadTimeout,
+		logger:          elog.DefaultLogger,
+	}
+}
+
+func (b *SelectorBuilder) Build() (provider.Selector, error) {
+	b.mu.Lock()
+	expired := time.Since(b.loadTime) >= b.refreshInterval
+	hasNodes := len(b.nodes) > 0
+	b.mu.Unlock()
+	if expired {
+		if hasNodes {
+			// 已经有权重时在后台刷新，不阻塞本次发送
+			b.group.DoChan(b.channel.String(), b.load)
+		} else {
+			// 还没有权重，只能等待加载完成，并发调用时只会加载一次
+			_, err, _ := b.group.Do(b.channel.String(), b.load)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if len(b.nodes) == 0 {
+		return nil, fmt.Errorf("%w: channel = %s", errs.ErrNoAvailableProvider, b.channel)
+	}
+	return &selector{providers: b.order()}, nil
+}
+
+// order 返回本次发送尝试供应商的顺序，调用方必须持有锁
+func (b *SelectorBuilder) order() []provider.Provider {
+	// 平滑加权轮询选出首选供应商
+	total := 0
+	var best *node
+	for _, n := range b.nodes {
+		n.currentWeight += n.weight
+		total += n.weight
+		if best == nil || n.currentWeight > best.currentWeight {
+			best = n
+		}
+	}
+	best.currentWeight -= total
+
+	// 剩余的供应商按照权重从高到低兜底
+	rest := make([]*node, 0, len(b.nodes)-1)
+	for _, n := range b.nodes {
+		if n != best {
+			rest = append(rest, n)
+		}
+	}
+	sort.SliceStable(rest, func(i, j int) bool {
+		return rest[i].weight > rest[j].weight
+	})
+
+	res := make([]provider.Provider, 0, len(b.nodes))
+	res = append(res, best.provider)
+	for _, n := range rest {
+		res = append(res, n.provider)
+	}
+	return res
+}
+
+// load 从数据库中重新加载权重
+// 加载失败时如果已经有旧的权重，那么继续使用旧的权重
+func (b *SelectorBuilder) load() (any, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), b.loadTimeout)
+	defer cancel()
+	providers, err := b.repo.FindActiveByChannel(ctx, b.channel)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if err != nil {
+		if len(b.nodes) > 0 {
+			b.logger.Warn("刷新供应商权重失败，继续使用旧的权重",
+				elog.String("channel", b.channel.String()),
+				elog.FieldErr(err))
+			return nil, nil
+		}
+		return nil, fmt.Errorf("%w: 加载供应商权重失败 %w", errs.ErrNoAvailableProvider, err)
+	}
+	b.reset(providers)
+	b.loadTime = time.Now()
+	return nil, nil
+}
+
+// reset 使用新的权重替换原有节点，保留仍然存在的供应商的当前权重，避免刷新打乱轮询节奏
+// 限流装饰器也在这里使用最新的限额重新创建
+func (b *SelectorBuilder) reset(providers []domain.Provider) {
+	old := make(map[string]*node, len(b.nodes))
+	for _, n := range b.nodes {
+		old[n.name] = n
+	}
+
+	nodes := make([]*node, 0, len(providers))
+	for i := range providers {
+		p, ok := b.providers[providers[i].Name]
+		if !ok || providers[i].Weight <= 0 {
+			continue
+		}
+		if b.limitCache != nil {
+			p = limit.NewProvider(p, providers[i], b.limitCache)
+		}
+		n := &node{
+			name:     providers[i].Name,
+			provider: p,
+			weight:   providers[i].Weight,
+		}
+		if o, ok := old[n.name]; ok {
+			n.currentWeight = o.currentWeight
+		}
+		nodes = append(nodes, n)
+	}
+	b.nodes = nodes
+}
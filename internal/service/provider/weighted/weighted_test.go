@@ -0,0 +1,234 @@
+//go:build unit
+
+package weighted
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/robinlg/notification-platform/internal/domain"
+	"github.com/robinlg/notification-platform/internal/errs"
+	"github.com/robinlg/notification-platform/internal/repository"
+	"github.com/robinlg/notification-platform/internal/repository/cache"
+	"github.com/robinlg/notification-platform/internal/service/provider"
+	providermocks "github.com/robinlg/notification-platform/internal/service/provider/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+type stubProviderRepo struct {
+	repository.ProviderRepository
+	mu        sync.Mutex
+	providers []domain.Provider
+	err       error
+	calls     int
+	// block 不为 nil 时查询会阻塞到它被关闭
+	block chan struct{}
+}
+
+func (s *stubProviderRepo) FindActiveByChannel(_ context.Context, _ domain.Channel) ([]domain.Provider, error) {
+	if s.block != nil {
+		<-s.block
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls++
+	return s.providers, s.err
+}
+
+func (s *stubProviderRepo) set(providers []domain.Provider, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.providers, s.err = providers, err
+}
+
+func (s *stubProviderRepo) callCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.calls
+}
+
+func TestSelectorBuilder_Distribution(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	a, b, c := providermocks.NewMockProvider(ctrl), providermocks.NewMockProvider(ctrl), providermocks.NewMockProvider(ctrl)
+	repo := &stubProviderRepo{providers: []domain.Provider{
+		{Name: "a", Weight: 5},
+		{Name: "b", Weight: 3},
+		{Name: "c", Weight: 2},
+		// 未注册实现的供应商会被忽略
+		{Name: "d", Weight: 10},
+	}}
+	builder := NewSelectorBuilder(domain.ChannelSMS, repo, map[string]provider.Provider{"a": a, "b": b, "c": c}, nil)
+
+	counts := make(map[provider.Provider]int)
+	for i := 0; i < 100; i++ {
+		s, err := builder.Build()
+		require.NoError(t, err)
+
+		first, err := s.Next(context.Background(), domain.Notification{})
+		require.NoError(t, err)
+		counts[first]++
+
+		// 剩余的供应商全部可以作为兜底
+		seen := map[provider.Provider]bool{first: true}
+		for j := 0; j < 2; j++ {
+			p, err := s.Next(context.Background(), domain.Notification{})
+			require.NoError(t, err)
+			seen[p] = true
+		}
+		assert.Len(t, seen, 3)
+
+		_, err = s.Next(context.Background(), domain.Notification{})
+		assert.ErrorIs(t, err, errs.ErrNoAvailableProvider)
+	}
+
+	assert.Equal(t, 50, counts[a])
+	assert.Equal(t, 30, counts[b])
+	assert.Equal(t, 20, counts[c])
+	// 权重在刷新周期内只加载一次
+	assert.Equal(t, 1, repo.calls)
+}
+
+func TestSelectorBuilder_Refresh(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	a := providermocks.NewMockProvider(ctrl)
+	repo := &stubProviderRepo{err: errors.New("mock db error")}
+	builder := NewSelectorBuilder(domain.ChannelSMS, repo, map[string]provider.Provider{"a": a}, nil)
+	builder.refreshInterval = 0
+
+	// 首次加载失败且没有旧的权重
+	_, err := builder.Build()
+	assert.ErrorIs(t, err, errs.ErrNoAvailableProvider)
+
+	repo.set([]domain.Provider{{Name: "a", Weight: 1}}, nil)
+	s, err := builder.Build()
+	require.NoError(t, err)
+	p, err := s.Next(context.Background(), domain.Notification{})
+	require.NoError(t, err)
+	assert.Equal(t, a, p)
+
+	// 刷新失败时继续使用旧的权重
+	repo.set(nil, errors.New("mock db error"))
+	_, err = builder.Build()
+	assert.NoError(t, err)
+}
+
+func TestSelectorBuilder_ConcurrentRefresh(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	a := providermocks.NewMockProvider(ctrl)
+	repo := &stubProviderRepo{
+		providers: []domain.Provider{{Name: "a", Weight: 1}},
+		block:     make(chan struct{}),
+	}
+	builder := NewSelectorBuilder(domain.ChannelSMS, repo, map[string]provider.Provider{"a": a}, nil)
+
+	// 首次加载时并发调用只会查询一次数据库
+	const n = 10
+	var wg sync.WaitGroup
+	wg.Add(n)
+	for i := 0; i < n; i++ {
+		go func() {
+			defer wg.Done()
+			_, err := builder.Build()
+			assert.NoError(t, err)
+		}()
+	}
+	// 等待所有调用都在等待同一次加载
+	time.Sleep(100 * time.Millisecond)
+	close(repo.block)
+	wg.Wait()
+	assert.Equal(t, 1, repo.callCount())
+
+	// 权重过期之后在后台刷新，不阻塞发送
+	repo.block = make(chan struct{})
+	builder.mu.Lock()
+	builder.loadTime = time.Time{}
+	builder.mu.Unlock()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < n; i++ {
+			_, err := builder.Build()
+			assert.NoError(t, err)
+		}
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("刷新权重阻塞了 Build")
+	}
+	close(repo.block)
+	assert.Eventually(t, func() bool {
+		return repo.callCount() == 2
+	}, time.Second, 10*time.Millisecond)
+}
+
+// recordLimitCache 记录每次占用额度时使用的限额
+type recordLimitCache struct {
+	cache.ProviderLimitCache
+	mu     sync.Mutex
+	limits []int
+}
+
+func (r *recordLimitCache) Acquire(_ context.Context, _ int64, qpsLimit, _ int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.limits = append(r.limits, qpsLimit)
+	return nil
+}
+
+func (r *recordLimitCache) lastLimit() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.limits[len(r.limits)-1]
+}
+
+func TestSelectorBuilder_LimitRefresh(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	a := providermocks.NewMockProvider(ctrl)
+	a.EXPECT().Send(gomock.Any(), gomock.Any()).Return(domain.SendResponse{}, nil).AnyTimes()
+	repo := &stubProviderRepo{providers: []domain.Provider{{ID: 1, Name: "a", Weight: 1, QPSLimit: 1, DailyLimit: 10}}}
+	limitCache := &recordLimitCache{}
+	builder := NewSelectorBuilder(domain.ChannelSMS, repo, map[string]provider.Provider{"a": a}, limitCache)
+
+	send := func() {
+		s, err := builder.Build()
+		require.NoError(t, err)
+		p, err := s.Next(context.Background(), domain.Notification{})
+		require.NoError(t, err)
+		_, err = p.Send(context.Background(), domain.Notification{})
+		require.NoError(t, err)
+	}
+	send()
+	assert.Equal(t, 1, limitCache.lastLimit())
+
+	// 修改限额之后，刷新供应商信息时使用新的限额
+	repo.set([]domain.Provider{{ID: 1, Name: "a", Weight: 1, QPSLimit: 5, DailyLimit: 10}}, nil)
+	builder.mu.Lock()
+	builder.loadTime = time.Time{}
+	builder.mu.Unlock()
+	assert.Eventually(t, func() bool {
+		send()
+		return limitCache.lastLimit() == 5
+	}, time.Second, 10*time.Millisecond)
+}
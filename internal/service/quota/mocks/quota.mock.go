@@ -0,0 +1,197 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./quota.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./quota.go -destination=./mocks/quota.mock.go -package=quotamocks -typed Service
+//
+
+// Package quotamocks is a generated GoMock package.
+package quotamocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/robinlg/notification-platform/internal/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockService is a mock of Service interface.
+type MockService struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockServiceMockRecorder is the mock recorder for MockService.
+type MockServiceMockRecorder struct {
+	mock *MockService
+}
+
+// NewMockService creates a new mock instance.
+func NewMockService(ctrl *gomock.Controller) *MockService {
+	mock := &MockService{ctrl: ctrl}
+	mock.recorder = &MockServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockService) EXPECT() *MockServiceMockRecorder {
+	return m.recorder
+}
+
+// Adjust mocks base method.
+func (m *MockService) Adjust(ctx context.Context, bizID int64, channel domain.Channel, delta int64, operator, reason string) (domain.QuotaBalance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Adjust", ctx, bizID, channel, delta, operator, reason)
+	ret0, _ := ret[0].(domain.QuotaBalance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Adjust indicates an expected call of Adjust.
+func (mr *MockServiceMockRecorder) Adjust(ctx, bizID, channel, delta, operator, reason any) *MockServiceAdjustCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Adjust", reflect.TypeOf((*MockService)(nil).Adjust), ctx, bizID, channel, delta, operator, reason)
+	return &MockServiceAdjustCall{Call: call}
+}
+
+// MockServiceAdjustCall wrap *gomock.Call
+type MockServiceAdjustCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceAdjustCall) Return(arg0 domain.QuotaBalance, arg1 error) *MockServiceAdjustCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceAdjustCall) Do(f func(context.Context, int64, domain.Channel, int64, string, string) (domain.QuotaBalance, error)) *MockServiceAdjustCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceAdjustCall) DoAndReturn(f func(context.Context, int64, domain.Channel, int64, string, string) (domain.QuotaBalance, error)) *MockServiceAdjustCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetBalances mocks base method.
+func (m *MockService) GetBalances(ctx context.Context, bizID int64) ([]domain.QuotaBalance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBalances", ctx, bizID)
+	ret0, _ := ret[0].([]domain.QuotaBalance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBalances indicates an expected call of GetBalances.
+func (mr *MockServiceMockRecorder) GetBalances(ctx, bizID any) *MockServiceGetBalancesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalances", reflect.TypeOf((*MockService)(nil).GetBalances), ctx, bizID)
+	return &MockServiceGetBalancesCall{Call: call}
+}
+
+// MockServiceGetBalancesCall wrap *gomock.Call
+type MockServiceGetBalancesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceGetBalancesCall) Return(arg0 []domain.QuotaBalance, arg1 error) *MockServiceGetBalancesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceGetBalancesCall) Do(f func(context.Context, int64) ([]domain.QuotaBalance, error)) *MockServiceGetBalancesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceGetBalancesCall) DoAndReturn(f func(context.Context, int64) ([]domain.QuotaBalance, error)) *MockServiceGetBalancesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Reconcile mocks base method.
+func (m *MockService) Reconcile(ctx context.Context, bizID int64, autoFix bool) ([]domain.QuotaDiscrepancy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reconcile", ctx, bizID, autoFix)
+	ret0, _ := ret[0].([]domain.QuotaDiscrepancy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reconcile indicates an expected call of Reconcile.
+func (mr *MockServiceMockRecorder) Reconcile(ctx, bizID, autoFix any) *MockServiceReconcileCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reconcile", reflect.TypeOf((*MockService)(nil).Reconcile), ctx, bizID, autoFix)
+	return &MockServiceReconcileCall{Call: call}
+}
+
+// MockServiceReconcileCall wrap *gomock.Call
+type MockServiceReconcileCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceReconcileCall) Return(arg0 []domain.QuotaDiscrepancy, arg1 error) *MockServiceReconcileCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceReconcileCall) Do(f func(context.Context, int64, bool) ([]domain.QuotaDiscrepancy, error)) *MockServiceReconcileCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceReconcileCall) DoAndReturn(f func(context.Context, int64, bool) ([]domain.QuotaDiscrepancy, error)) *MockServiceReconcileCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Sync mocks base method.
+func (m *MockService) Sync(ctx context.Context, cfg domain.BusinessConfig) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Sync", ctx, cfg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Sync indicates an expected call of Sync.
+func (mr *MockServiceMockRecorder) Sync(ctx, cfg any) *MockServiceSyncCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sync", reflect.TypeOf((*MockService)(nil).Sync), ctx, cfg)
+	return &MockServiceSyncCall{Call: call}
+}
+
+// MockServiceSyncCall wrap *gomock.Call
+type MockServiceSyncCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceSyncCall) Return(arg0 error) *MockServiceSyncCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceSyncCall) Do(f func(context.Context, domain.BusinessConfig) error) *MockServiceSyncCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceSyncCall) DoAndReturn(f func(context.Context, domain.BusinessConfig) error) *MockServiceSyncCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,416 @@
+package quota
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gotomicro/ego/core/elog"
+	"github.com/robinlg/notification-platform/internal/domain"
+	"github.com/robinlg/notification-platform/internal/errs"
+	"github.com/robinlg/notification-platform/internal/repository"
+	"github.com/robinlg/notification-platform/internal/repository/cache"
+)
+
+// channels 需要维护额度的渠道
+var channels = []domain.Channel{domain.ChannelSMS, domain.ChannelEmail, domain.ChannelInApp}
+
+// Service 额度服务，根据业务配置维护 Redis 中的额度计数，并将每一次变更记录到流水中
+//
+//go:generate mockgen -source=./quota.go -destination=./mocks/quota.mock.go -package=quotamocks -typed Service
+type Service interface {
+	// GetBalances 获取业务方各渠道本周期的额度及其使用情况
+	GetBalances(ctx context.Context, bizID int64) ([]domain.QuotaBalance, error)
+	// Adjust 手动调整业务方某个渠道本周期的额度，delta 为负数时表示扣减
+	Adjust(ctx context.Context, bizID int64, channel domain.Channel, delta int64, operator, reason string) (domain.QuotaBalance, error)
+	// Reconcile 根据通知记录重新统计业务方各渠道本周期已使用的额度，与 Redis 中的剩余额度对账
+	// autoFix 为 true 时修正发现的差异，返回发现的差异
+	Reconcile(ctx context.Context, bizID int64, autoFix bool) ([]domain.QuotaDiscrepancy, error)
+	// Sync 根据业务配置同步额度，覆盖首次初始化、跨月重置、每月额度变更以及 Redis 数据丢失后重新写入这几种情况
+	// 重复调用是安全的
+	Sync(ctx context.Context, cfg domain.BusinessConfig) error
+}
+
+type service struct {
+	repo             repository.QuotaRepository
+	notificationRepo repository.NotificationRepository
+	quotaCache       cache.QuotaCache
+	loc              *time.Location
+	// settle 对账发现差异之后再次对账之前等待的时间，等待正在进行中的扣减和归还完成
+	settle time.Duration
+	logger *elog.Component
+}
+
+const defaultReconcileSettle = 2 * time.Second
+
+// NewService 创建额度服务，loc 为计算自然月时使用的时区，为 nil 时使用本地时区
+func NewService(
+	repo repository.QuotaRepository,
+	notificationRepo repository.NotificationRepository,
+	quotaCache cache.QuotaCache,
+	loc *time.Location,
+) Service {
+	if loc == nil {
+		loc = time.Local
+	}
+	return &service{
+		repo:             repo,
+		notificationRepo: notificationRepo,
+		quotaCache:       quotaCache,
+		loc:              loc,
+		settle:           defaultReconcileSettle,
+		logger:           elog.DefaultLogger,
+	}
+}
+
+func (s *service) Sync(ctx context.Context, cfg domain.BusinessConfig) error {
+	records, err := s.repo.FindByBizID(ctx, cfg.ID)
+	if err != nil {
+		return err
+	}
+	channelToRecord := make(map[domain.Channel]domain.QuotaRecord, len(records))
+	for i := range records {
+		channelToRecord[records[i].Channel] = records[i]
+	}
+
+	var syncErrs []error
+	for _, ch := range channels {
+		record, ok := channelToRecord[ch]
+		err1 := s.syncChannel(ctx, cfg.ID, ch, cfg.Quota.Limit(ch), record, ok)
+		if err1 != nil {
+			syncErrs = append(syncErrs, fmt.Errorf("同步额度失败 bizID = %d, channel = %s: %w", cfg.ID, ch, err1))
+		}
+	}
+	return errors.Join(syncErrs...)
+}
+
+func (s *service) GetBalances(ctx context.Context, bizID int64) ([]domain.QuotaBalance, error) {
+	records, err := s.repo.FindByBizID(ctx, bizID)
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("%w: bizID = %d", errs.ErrQuotaNotFound, bizID)
+	}
+	balances := make([]domain.QuotaBalance, 0, len(records))
+	for i := range records {
+		remaining, err1 := s.getRemaining(ctx, records[i])
+		if err1 != nil {
+			return nil, err1
+		}
+		balances = append(balances, domain.QuotaBalance{QuotaRecord: records[i], Remaining: remaining})
+	}
+	return balances, nil
+}
+
+// getRemaining 获取剩余额度，Redis 中的额度丢失时根据通知记录估算
+func (s *service) getRemaining(ctx context.Context, record domain.QuotaRecord) (int64, error) {
+	remaining, err := s.quotaCache.Get(ctx, record.BizID, record.Channel)
+	if err == nil || !errors.Is(err, cache.ErrKeyNotFound) {
+		return remaining, err
+	}
+	_, start, end := domain.QuotaPeriod(time.Now(), s.loc)
+	used, err := s.notificationRepo.CountUsedQuota(ctx, record.BizID, record.Channel, start, end)
+	if err != nil {
+		return 0, err
+	}
+	return record.Total() - used, nil
+}
+
+func (s *service) Adjust(ctx context.Context, bizID int64, channel domain.Channel, delta int64, operator, reason string) (domain.QuotaBalance, error) {
+	if !channel.IsValid() || delta == 0 {
+		return domain.QuotaBalance{}, fmt.Errorf("%w: channel = %s, delta = %d", errs.ErrInvalidParameter, channel, delta)
+	}
+	// 先原子地调整 Redis，再使用调整后的剩余额度写入流水
+	remaining, err := s.quotaCache.Adjust(ctx, bizID, channel, delta)
+	if errors.Is(err, cache.ErrKeyNotFound) {
+		// Redis 中的额度丢失，先按照调整前的额度重新写入再调整，否则会从 0 开始调整
+		err = s.reseed(ctx, bizID, channel)
+		if err != nil {
+			return domain.QuotaBalance{}, err
+		}
+		remaining, err = s.quotaCache.Adjust(ctx, bizID, channel, delta)
+	}
+	if err != nil {
+		return domain.QuotaBalance{}, err
+	}
+	record, err := s.repo.Adjust(ctx, domain.QuotaLedger{
+		BizID:     bizID,
+		Channel:   channel,
+		Type:      domain.QuotaLedgerTypeAdjust,
+		Delta:     delta,
+		Remaining: remaining,
+		Reason:    reason,
+		Operator:  operator,
+	})
+	if err != nil {
+		// 流水写入失败，撤销 Redis 中的调整
+		if _, err1 := s.quotaCache.Adjust(ctx, bizID, channel, -delta); err1 != nil {
+			s.logger.Error("撤销额度调整失败，等待对账任务修正",
+				elog.Int64("bizID", bizID),
+				elog.String("channel", channel.String()),
+				elog.Int64("delta", delta),
+				elog.FieldErr(err1))
+		}
+		return domain.QuotaBalance{}, err
+	}
+	s.logger.Info("手动调整额度",
+		elog.Int64("bizID", bizID),
+		elog.String("channel", channel.String()),
+		elog.Int64("delta", delta),
+		elog.String("operator", operator),
+		elog.String("reason", reason))
+	return domain.QuotaBalance{QuotaRecord: record, Remaining: remaining}, nil
+}
+
+// reseed 按照保存的额度重新写入 Redis 中丢失的剩余额度
+func (s *service) reseed(ctx context.Context, bizID int64, channel domain.Channel) error {
+	records, err := s.repo.FindByBizID(ctx, bizID)
+	if err != nil {
+		return err
+	}
+	for i := range records {
+		record := records[i]
+		if record.Channel != channel {
+			continue
+		}
+		period, start, end := domain.QuotaPeriod(time.Now(), s.loc)
+		if record.Period != period {
+			// 还没有重置到当前周期，按照保存的每月额度重置
+			return s.reset(ctx, record, period, record.Monthly, start, end)
+		}
+		return s.seed(ctx, record, start, end, domain.QuotaLedgerTypeReseed, 0, "Redis 中的额度丢失，重新写入")
+	}
+	return fmt.Errorf("%w: bizID = %d, channel = %s", errs.ErrQuotaNotFound, bizID, channel)
+}
+
+func (s *service) syncChannel(ctx context.Context, bizID int64, channel domain.Channel, monthly int64, record domain.QuotaRecord, exists bool) error {
+	period, start, end := domain.QuotaPeriod(time.Now(), s.loc)
+	switch {
+	case !exists:
+		if monthly <= 0 {
+			// 没有配置该渠道的额度
+			return nil
+		}
+		record = domain.QuotaRecord{BizID: bizID, Channel: channel, Period: period, Monthly: monthly}
+		return s.seed(ctx, record, start, end, domain.QuotaLedgerTypeInit, monthly, "初始化每月额度")
+	case record.Period != period:
+		return s.reset(ctx, record, period, monthly, start, end)
+	case record.Monthly != monthly:
+		return s.changeMonthly(ctx, record, monthly, start, end)
+	default:
+		_, err := s.quotaCache.Get(ctx, bizID, channel)
+		if err == nil {
+			return nil
+		}
+		if !errors.Is(err, cache.ErrKeyNotFound) {
+			return err
+		}
+		return s.seed(ctx, record, start, end, domain.QuotaLedgerTypeReseed, 0, "Redis 中的额度丢失，重新写入")
+	}
+}
+
+// seed Redis 中没有额度时将剩余额度设置为本周期额度总量减去已经占用的额度，已经占用的额度从通知记录中统计
+// 使用 SETNX 写入，不会覆盖其他实例同时写入的额度以及之后发生的扣减，此时以已经写入的额度为准
+// 统计和写入之间并发扣减的额度会产生少量偏差，由对账任务修正
+func (s *service) seed(ctx context.Context, record domain.QuotaRecord, start, end time.Time,
+	typ domain.QuotaLedgerType, delta int64, reason string,
+) error {
+	remaining, err := s.expectedRemaining(ctx, record, start, end)
+	if err != nil {
+		return err
+	}
+	// 先写 Redis，保存失败时下次同步会重新执行
+	ok, err := s.quotaCache.SetNX(ctx, record.BizID, record.Channel, remaining)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		s.logger.Info("额度已经存在，跳过写入",
+			elog.Int64("bizID", record.BizID),
+			elog.String("channel", record.Channel.String()),
+			elog.String("type", typ.String()))
+		return nil
+	}
+	return s.save(ctx, record, typ, delta, remaining, reason)
+}
+
+// reset 进入新的周期，按照新周期的额度重置剩余额度，手动调整的额度不延续
+// 上个周期的剩余额度还在 Redis 中，不能使用 SETNX，使用 CAS 避免覆盖期间发生的扣减和归还，失败时下一轮同步重试
+func (s *service) reset(ctx context.Context, record domain.QuotaRecord, period string, monthly int64, start, end time.Time) error {
+	const reason = "每月重置额度"
+	delta := monthly - record.Total()
+	record.Period, record.Monthly, record.Adjusted = period, monthly, 0
+	old, err := s.quotaCache.Get(ctx, record.BizID, record.Channel)
+	if errors.Is(err, cache.ErrKeyNotFound) {
+		return s.seed(ctx, record, start, end, domain.QuotaLedgerTypeReset, delta, reason)
+	}
+	if err != nil {
+		return err
+	}
+	remaining, err := s.expectedRemaining(ctx, record, start, end)
+	if err != nil {
+		return err
+	}
+	ok, err := s.quotaCache.CompareAndSet(ctx, record.BizID, record.Channel, old, remaining)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("重置期间剩余额度发生变化 bizID = %d, channel = %s", record.BizID, record.Channel)
+	}
+	return s.save(ctx, record, domain.QuotaLedgerTypeReset, delta, remaining, reason)
+}
+
+// expectedRemaining 本周期应有的剩余额度，即额度总量减去通知记录中已经占用的额度
+func (s *service) expectedRemaining(ctx context.Context, record domain.QuotaRecord, start, end time.Time) (int64, error) {
+	used, err := s.notificationRepo.CountUsedQuota(ctx, record.BizID, record.Channel, start, end)
+	if err != nil {
+		return 0, err
+	}
+	return record.Total() - used, nil
+}
+
+// save 保存额度并写入流水
+func (s *service) save(ctx context.Context, record domain.QuotaRecord, typ domain.QuotaLedgerType, delta, remaining int64, reason string) error {
+	err := s.repo.Save(ctx, record, domain.QuotaLedger{
+		BizID:     record.BizID,
+		Channel:   record.Channel,
+		Period:    record.Period,
+		Type:      typ,
+		Delta:     delta,
+		Total:     record.Total(),
+		Remaining: remaining,
+		Reason:    reason,
+	})
+	if err != nil {
+		return err
+	}
+	s.logger.Info("写入额度",
+		elog.Int64("bizID", record.BizID),
+		elog.String("channel", record.Channel.String()),
+		elog.String("type", typ.String()),
+		elog.Int64("remaining", remaining))
+	return nil
+}
+
+// changeMonthly 业务配置中的每月额度发生变更，按照差值调整剩余额度
+func (s *service) changeMonthly(ctx context.Context, record domain.QuotaRecord, monthly int64, start, end time.Time) error {
+	const reason = "每月额度配置变更"
+	delta := monthly - record.Monthly
+	record.Monthly = monthly
+	remaining, err := s.quotaCache.Get(ctx, record.BizID, record.Channel)
+	if errors.Is(err, cache.ErrKeyNotFound) {
+		// Redis 中的额度同时丢失，直接重新写入
+		return s.seed(ctx, record, start, end, domain.QuotaLedgerTypeConfig, delta, reason)
+	}
+	if err != nil {
+		return err
+	}
+	// 先保存再调整，调整不是幂等的，避免保存失败后下次同步重复调整
+	err = s.repo.Save(ctx, record, domain.QuotaLedger{
+		BizID:     record.BizID,
+		Channel:   record.Channel,
+		Period:    record.Period,
+		Type:      domain.QuotaLedgerTypeConfig,
+		Delta:     delta,
+		Total:     record.Total(),
+		Remaining: remaining + delta,
+		Reason:    reason,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = s.quotaCache.Adjust(ctx, record.BizID, record.Channel, delta)
+	return err
+}
+
+func (s *service) Reconcile(ctx context.Context, bizID int64, autoFix bool) ([]domain.QuotaDiscrepancy, error) {
+	records, err := s.repo.FindByBizID(ctx, bizID)
+	if err != nil {
+		return nil, err
+	}
+	period, start, end := domain.QuotaPeriod(time.Now(), s.loc)
+	var res []domain.QuotaDiscrepancy
+	for i := range records {
+		record := records[i]
+		if record.Period != period {
+			// 还没有重置到当前周期，由同步任务处理
+			continue
+		}
+		d, ok, err1 := s.measure(ctx, record, start, end)
+		if err1 != nil {
+			return res, err1
+		}
+		if !ok || d.Diff() == 0 {
+			continue
+		}
+		if autoFix {
+			d.Fixed, err1 = s.fix(ctx, record, d, start, end)
+			if err1 != nil {
+				return res, err1
+			}
+		}
+		res = append(res, d)
+	}
+	return res, nil
+}
+
+// measure 根据通知记录统计应有的剩余额度，与 Redis 中的剩余额度比较，Redis 中的额度丢失时返回 false，由同步任务重新写入
+func (s *service) measure(ctx context.Context, record domain.QuotaRecord, start, end time.Time) (domain.QuotaDiscrepancy, bool, error) {
+	actual, err := s.quotaCache.Get(ctx, record.BizID, record.Channel)
+	if errors.Is(err, cache.ErrKeyNotFound) {
+		return domain.QuotaDiscrepancy{}, false, nil
+	}
+	if err != nil {
+		return domain.QuotaDiscrepancy{}, false, err
+	}
+	used, err := s.notificationRepo.CountUsedQuota(ctx, record.BizID, record.Channel, start, end)
+	if err != nil {
+		return domain.QuotaDiscrepancy{}, false, err
+	}
+	return domain.QuotaDiscrepancy{
+		BizID:    record.BizID,
+		Channel:  record.Channel,
+		Period:   record.Period,
+		Total:    record.Total(),
+		Used:     used,
+		Expected: record.Total() - used,
+		Actual:   actual,
+	}, true, nil
+}
+
+// fix 修正 Redis 中的剩余额度，并写入流水
+// 创建通知时先扣减额度再写入通知记录，归还额度时先修改通知状态再归还额度，
+// 正在进行中的这两种操作都会让一次对账看到的剩余额度偏少，直接修正会多归还额度。
+// 所以等待 settle 之后再对账一次，两次的差异相同才认为是真实的偏差，并按照差异原子地调整，不覆盖期间发生的扣减和归还
+func (s *service) fix(ctx context.Context, record domain.QuotaRecord, d domain.QuotaDiscrepancy, start, end time.Time) (bool, error) {
+	select {
+	case <-ctx.Done():
+		return false, ctx.Err()
+	case <-time.After(s.settle):
+	}
+	again, ok, err := s.measure(ctx, record, start, end)
+	if err != nil || !ok || again.Diff() != d.Diff() {
+		// 差异只是暂时的，或者期间发生了变化，下一轮再对账
+		return false, err
+	}
+	remaining, err := s.quotaCache.Adjust(ctx, d.BizID, d.Channel, -d.Diff())
+	if errors.Is(err, cache.ErrKeyNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	err = s.repo.Save(ctx, record, domain.QuotaLedger{
+		BizID:     d.BizID,
+		Channel:   d.Channel,
+		Period:    d.Period,
+		Type:      domain.QuotaLedgerTypeReconcile,
+		Total:     d.Total,
+		Remaining: remaining,
+		Reason:    fmt.Sprintf("对账修正剩余额度 %d -> %d", d.Actual, d.Expected),
+	})
+	return true, err
+}
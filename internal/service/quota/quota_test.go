@@ -0,0 +1,321 @@
+//go:build unit
+
+package quota
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/robinlg/notification-platform/internal/domain"
+	"github.com/robinlg/notification-platform/internal/repository/cache"
+	rediscache "github.com/robinlg/notification-platform/internal/repository/cache/redis"
+	repositorymocks "github.com/robinlg/notification-platform/internal/repository/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestService_Sync(t *testing.T) {
+	t.Parallel()
+
+	const bizID = int64(1)
+	period, _, _ := domain.QuotaPeriod(time.Now(), time.Local)
+	// 没有配置站内信额度的已有业务
+	cfg := domain.BusinessConfig{
+		ID:    bizID,
+		Quota: &domain.QuotaConfig{Monthly: domain.MonthlyConfig{SMS: 200}},
+	}
+
+	testCases := []struct {
+		name    string
+		records []domain.QuotaRecord
+		// initQuota Redis 中已有的剩余额度
+		initQuota map[domain.Channel]int64
+		used      map[domain.Channel]int64
+		// wantLedgers 写入的流水类型
+		wantLedgers map[domain.Channel]domain.QuotaLedgerType
+		wantQuota   map[domain.Channel]int64
+	}{
+		{
+			name: "首次初始化，站内信默认不限制额度",
+			used: map[domain.Channel]int64{domain.ChannelSMS: 10},
+			wantLedgers: map[domain.Channel]domain.QuotaLedgerType{
+				domain.ChannelSMS:   domain.QuotaLedgerTypeInit,
+				domain.ChannelInApp: domain.QuotaLedgerTypeInit,
+			},
+			wantQuota: map[domain.Channel]int64{
+				domain.ChannelSMS:   190,
+				domain.ChannelInApp: domain.UnlimitedQuota,
+			},
+		},
+		{
+			name: "其他实例已经写入时不覆盖",
+			initQuota: map[domain.Channel]int64{
+				domain.ChannelSMS:   50,
+				domain.ChannelInApp: 7,
+			},
+			wantQuota: map[domain.Channel]int64{
+				domain.ChannelSMS:   50,
+				domain.ChannelInApp: 7,
+			},
+		},
+		{
+			name: "跨月重置",
+			records: []domain.QuotaRecord{
+				{BizID: bizID, Channel: domain.ChannelSMS, Period: "2000-01", Monthly: 100, Adjusted: 20},
+				{BizID: bizID, Channel: domain.ChannelInApp, Period: "2000-01", Monthly: domain.UnlimitedQuota},
+			},
+			initQuota: map[domain.Channel]int64{
+				domain.ChannelSMS:   5,
+				domain.ChannelInApp: 9,
+			},
+			used: map[domain.Channel]int64{domain.ChannelSMS: 3},
+			wantLedgers: map[domain.Channel]domain.QuotaLedgerType{
+				domain.ChannelSMS:   domain.QuotaLedgerTypeReset,
+				domain.ChannelInApp: domain.QuotaLedgerTypeReset,
+			},
+			wantQuota: map[domain.Channel]int64{
+				domain.ChannelSMS:   197,
+				domain.ChannelInApp: domain.UnlimitedQuota,
+			},
+		},
+		{
+			name: "Redis 中的额度丢失后重新写入",
+			records: []domain.QuotaRecord{
+				{BizID: bizID, Channel: domain.ChannelSMS, Period: period, Monthly: 200, Adjusted: 10},
+				{BizID: bizID, Channel: domain.ChannelInApp, Period: period, Monthly: domain.UnlimitedQuota},
+			},
+			used: map[domain.Channel]int64{domain.ChannelSMS: 30},
+			wantLedgers: map[domain.Channel]domain.QuotaLedgerType{
+				domain.ChannelSMS:   domain.QuotaLedgerTypeReseed,
+				domain.ChannelInApp: domain.QuotaLedgerTypeReseed,
+			},
+			wantQuota: map[domain.Channel]int64{
+				domain.ChannelSMS:   180,
+				domain.ChannelInApp: domain.UnlimitedQuota,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			quotaCache := rediscache.NewQuotaCache(redis.NewClient(&redis.Options{Addr: miniredis.RunT(t).Addr()}))
+			for ch, quota := range tc.initQuota {
+				_, err := quotaCache.SetNX(t.Context(), bizID, ch, quota)
+				require.NoError(t, err)
+			}
+
+			repo := repositorymocks.NewMockQuotaRepository(ctrl)
+			repo.EXPECT().FindByBizID(gomock.Any(), bizID).Return(tc.records, nil)
+			ledgers := make(map[domain.Channel]domain.QuotaLedgerType)
+			repo.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, record domain.QuotaRecord, ledger domain.QuotaLedger) error {
+					assert.Equal(t, period, record.Period)
+					ledgers[ledger.Channel] = ledger.Type
+					return nil
+				}).AnyTimes()
+			notificationRepo := repositorymocks.NewMockNotificationRepository(ctrl)
+			notificationRepo.EXPECT().CountUsedQuota(gomock.Any(), bizID, gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ int64, ch domain.Channel, _, _ time.Time) (int64, error) {
+					return tc.used[ch], nil
+				}).AnyTimes()
+
+			svc := NewService(repo, notificationRepo, quotaCache, time.Local)
+			require.NoError(t, svc.Sync(t.Context(), cfg))
+
+			if tc.wantLedgers == nil {
+				tc.wantLedgers = map[domain.Channel]domain.QuotaLedgerType{}
+			}
+			assert.Equal(t, tc.wantLedgers, ledgers)
+			for _, ch := range channels {
+				got, err := quotaCache.Get(t.Context(), bizID, ch)
+				want, ok := tc.wantQuota[ch]
+				if !ok {
+					// 没有配置额度的渠道不写入
+					assert.ErrorIs(t, err, cache.ErrKeyNotFound)
+					continue
+				}
+				require.NoError(t, err)
+				assert.Equal(t, want, got, ch)
+			}
+		})
+	}
+}
+
+func TestService_Adjust(t *testing.T) {
+	t.Parallel()
+
+	const bizID = int64(1)
+	period, _, _ := domain.QuotaPeriod(time.Now(), time.Local)
+	record := domain.QuotaRecord{BizID: bizID, Channel: domain.ChannelSMS, Period: period, Monthly: 100}
+
+	testCases := []struct {
+		name string
+		// initQuota Redis 中已有的剩余额度，为 nil 表示额度丢失
+		initQuota     *int64
+		mock          func(t *testing.T, repo *repositorymocks.MockQuotaRepository, notificationRepo *repositorymocks.MockNotificationRepository)
+		wantRemaining int64
+		wantQuota     int64
+		wantErr       error
+	}{
+		{
+			name:      "使用调整后的剩余额度写入流水",
+			initQuota: func() *int64 { v := int64(40); return &v }(),
+			mock: func(t *testing.T, repo *repositorymocks.MockQuotaRepository, _ *repositorymocks.MockNotificationRepository) {
+				repo.EXPECT().Adjust(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, ledger domain.QuotaLedger) (domain.QuotaRecord, error) {
+					assert.Equal(t, domain.QuotaLedgerTypeAdjust, ledger.Type)
+					assert.Equal(t, int64(5), ledger.Delta)
+					assert.Equal(t, int64(45), ledger.Remaining)
+					r := record
+					r.Adjusted = 5
+					return r, nil
+				})
+			},
+			wantRemaining: 45,
+			wantQuota:     45,
+		},
+		{
+			name: "额度丢失时先重新写入再调整",
+			mock: func(t *testing.T, repo *repositorymocks.MockQuotaRepository, notificationRepo *repositorymocks.MockNotificationRepository) {
+				repo.EXPECT().FindByBizID(gomock.Any(), bizID).Return([]domain.QuotaRecord{record}, nil)
+				notificationRepo.EXPECT().CountUsedQuota(gomock.Any(), bizID, domain.ChannelSMS, gomock.Any(), gomock.Any()).Return(int64(30), nil)
+				gomock.InOrder(
+					repo.EXPECT().Save(gomock.Any(), record, gomock.Any()).DoAndReturn(func(_ context.Context, _ domain.QuotaRecord, ledger domain.QuotaLedger) error {
+						assert.Equal(t, domain.QuotaLedgerTypeReseed, ledger.Type)
+						assert.Equal(t, int64(70), ledger.Remaining)
+						return nil
+					}),
+					repo.EXPECT().Adjust(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, ledger domain.QuotaLedger) (domain.QuotaRecord, error) {
+						// 在重新写入的额度上调整，而不是从 0 开始
+						assert.Equal(t, int64(75), ledger.Remaining)
+						return record, nil
+					}),
+				)
+			},
+			wantRemaining: 75,
+			wantQuota:     75,
+		},
+		{
+			name:      "写入流水失败时撤销调整",
+			initQuota: func() *int64 { v := int64(40); return &v }(),
+			mock: func(_ *testing.T, repo *repositorymocks.MockQuotaRepository, _ *repositorymocks.MockNotificationRepository) {
+				repo.EXPECT().Adjust(gomock.Any(), gomock.Any()).Return(domain.QuotaRecord{}, assert.AnError)
+			},
+			wantQuota: 40,
+			wantErr:   assert.AnError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			quotaCache := rediscache.NewQuotaCache(redis.NewClient(&redis.Options{Addr: miniredis.RunT(t).Addr()}))
+			if tc.initQuota != nil {
+				_, err := quotaCache.SetNX(t.Context(), bizID, domain.ChannelSMS, *tc.initQuota)
+				require.NoError(t, err)
+			}
+			repo := repositorymocks.NewMockQuotaRepository(ctrl)
+			notificationRepo := repositorymocks.NewMockNotificationRepository(ctrl)
+			tc.mock(t, repo, notificationRepo)
+
+			svc := NewService(repo, notificationRepo, quotaCache, time.Local)
+			balance, err := svc.Adjust(t.Context(), bizID, domain.ChannelSMS, 5, "admin", "充值")
+			assert.ErrorIs(t, err, tc.wantErr)
+			if err == nil {
+				assert.Equal(t, tc.wantRemaining, balance.Remaining)
+			}
+			got, err := quotaCache.Get(t.Context(), bizID, domain.ChannelSMS)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantQuota, got)
+		})
+	}
+}
+
+func TestService_Reconcile(t *testing.T) {
+	t.Parallel()
+
+	const bizID = int64(1)
+	period, _, _ := domain.QuotaPeriod(time.Now(), time.Local)
+	record := domain.QuotaRecord{BizID: bizID, Channel: domain.ChannelSMS, Period: period, Monthly: 100}
+
+	testCases := []struct {
+		name    string
+		autoFix bool
+		// used 每次统计得到的已使用额度
+		used      []int64
+		mock      func(t *testing.T, repo *repositorymocks.MockQuotaRepository)
+		wantFixed bool
+		wantQuota int64
+	}{
+		{
+			name:    "两次对账差异相同时修正",
+			autoFix: true,
+			used:    []int64{30, 30},
+			mock: func(t *testing.T, repo *repositorymocks.MockQuotaRepository) {
+				repo.EXPECT().Save(gomock.Any(), record, gomock.Any()).DoAndReturn(func(_ context.Context, _ domain.QuotaRecord, ledger domain.QuotaLedger) error {
+					assert.Equal(t, domain.QuotaLedgerTypeReconcile, ledger.Type)
+					assert.Equal(t, int64(70), ledger.Remaining)
+					return nil
+				})
+			},
+			wantFixed: true,
+			wantQuota: 70,
+		},
+		{
+			// 创建通知时已经扣减了额度但是还没有写入通知记录，修正会多归还额度
+			name:      "正在进行中的扣减不修正",
+			autoFix:   true,
+			used:      []int64{30, 31},
+			mock:      func(*testing.T, *repositorymocks.MockQuotaRepository) {},
+			wantQuota: 69,
+		},
+		{
+			name:      "只报告不修正",
+			used:      []int64{30},
+			mock:      func(*testing.T, *repositorymocks.MockQuotaRepository) {},
+			wantQuota: 69,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			quotaCache := rediscache.NewQuotaCache(redis.NewClient(&redis.Options{Addr: miniredis.RunT(t).Addr()}))
+			_, err := quotaCache.SetNX(t.Context(), bizID, domain.ChannelSMS, 69)
+			require.NoError(t, err)
+
+			repo := repositorymocks.NewMockQuotaRepository(ctrl)
+			repo.EXPECT().FindByBizID(gomock.Any(), bizID).Return([]domain.QuotaRecord{record}, nil)
+			tc.mock(t, repo)
+			notificationRepo := repositorymocks.NewMockNotificationRepository(ctrl)
+			for _, used := range tc.used {
+				notificationRepo.EXPECT().CountUsedQuota(gomock.Any(), bizID, domain.ChannelSMS, gomock.Any(), gomock.Any()).Return(used, nil)
+			}
+
+			svc := NewService(repo, notificationRepo, quotaCache, time.Local).(*service)
+			svc.settle = 0
+			res, err := svc.Reconcile(t.Context(), bizID, tc.autoFix)
+			require.NoError(t, err)
+			require.Len(t, res, 1)
+			assert.Equal(t, int64(-1), res[0].Diff())
+			assert.Equal(t, tc.wantFixed, res[0].Fixed)
+
+			got, err := quotaCache.Get(t.Context(), bizID, domain.ChannelSMS)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantQuota, got)
+		})
+	}
+}
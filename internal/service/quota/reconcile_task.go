@@ -0,0 +1,108 @@
+package quota
+
+import (
+	"context"
+	"time"
+
+	"github.com/gotomicro/ego/core/elog"
+	"github.com/meoying/dlock-go"
+	"github.com/robinlg/notification-platform/internal/domain"
+	"github.com/robinlg/notification-platform/internal/pkg/loopjob"
+	"github.com/robinlg/notification-platform/internal/repository"
+)
+
+const (
+	ReconcileTaskKey = "quota_reconcile_job"
+	// defaultReconcileTimeout 每个业务对账的超时时间，对账发现差异时需要等待之后再次对账
+	defaultReconcileTimeout   = 20 * time.Second
+	defaultFindConfigTimeout  = 3 * time.Second
+	defaultReconcileInterval  = 10 * time.Minute
+	defaultReconcileIdleSleep = time.Second
+)
+
+// ReconcileTask 定时对账 Redis 中的剩余额度与通知记录
+// 额度在创建通知时扣减，在多个失败分支中归还，部分归还失败只记录日志，长期运行必然产生偏差
+type ReconcileTask struct {
+	svc        Service
+	configRepo repository.BusinessConfigRepository
+	lock       dlock.Client
+	autoFix    bool
+	interval   time.Duration
+	batchSize  int
+	lastRun    time.Time
+	logger     *elog.Component
+}
+
+// NewReconcileTask 创建额度对账任务，autoFix 为 true 时自动修正发现的差异，否则只报告
+func NewReconcileTask(svc Service, configRepo repository.BusinessConfigRepository, lock dlock.Client, autoFix bool) *ReconcileTask {
+	return &ReconcileTask{
+		svc:        svc,
+		configRepo: configRepo,
+		lock:       lock,
+		autoFix:    autoFix,
+		interval:   defaultReconcileInterval,
+		batchSize:  defaultBatchSize,
+		logger:     elog.DefaultLogger,
+	}
+}
+
+func (t *ReconcileTask) Start(ctx context.Context) {
+	job := loopjob.NewInfiniteLoop(t.lock, t.oneLoop, ReconcileTaskKey)
+	job.Run(ctx)
+}
+
+func (t *ReconcileTask) oneLoop(ctx context.Context) error {
+	if time.Since(t.lastRun) < t.interval {
+		// 对账间隔远大于分布式锁的过期时间，不能直接睡眠，否则锁来不及续约
+		time.Sleep(defaultReconcileIdleSleep)
+		return nil
+	}
+
+	// 中途失败同样记录运行时间，避免持续失败时每次加锁都重新遍历所有业务
+	defer func() {
+		t.lastRun = time.Now()
+	}()
+
+	offset := 0
+	for {
+		configs, err := t.findConfigs(ctx, offset)
+		if err != nil {
+			return err
+		}
+		for i := range configs {
+			t.reconcile(ctx, configs[i].ID)
+		}
+		if len(configs) < t.batchSize {
+			return nil
+		}
+		offset += len(configs)
+	}
+}
+
+func (t *ReconcileTask) findConfigs(ctx context.Context, offset int) ([]domain.BusinessConfig, error) {
+	ctx, cancel := context.WithTimeout(ctx, defaultFindConfigTimeout)
+	defer cancel()
+	return t.configRepo.Find(ctx, offset, t.batchSize)
+}
+
+// reconcile 对账单个业务，每个业务使用独立的超时时间，避免前面的业务耗尽后面业务的时间
+func (t *ReconcileTask) reconcile(ctx context.Context, bizID int64) {
+	ctx, cancel := context.WithTimeout(ctx, defaultReconcileTimeout)
+	defer cancel()
+	discrepancies, err := t.svc.Reconcile(ctx, bizID, t.autoFix)
+	if err != nil {
+		t.logger.Warn("额度对账失败", elog.Int64("bizID", bizID), elog.FieldErr(err))
+	}
+	for i := range discrepancies {
+		d := discrepancies[i]
+		t.logger.Warn("额度对账发现差异",
+			elog.Int64("bizID", d.BizID),
+			elog.String("channel", d.Channel.String()),
+			elog.String("period", d.Period),
+			elog.Int64("total", d.Total),
+			elog.Int64("used", d.Used),
+			elog.Int64("expected", d.Expected),
+			elog.Int64("actual", d.Actual),
+			elog.Any("fixed", d.Fixed))
+	}
+}
@@ -0,0 +1,66 @@
+//go:build unit
+
+package quota
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/robinlg/notification-platform/internal/domain"
+	repositorymocks "github.com/robinlg/notification-platform/internal/repository/mocks"
+	quotamocks "github.com/robinlg/notification-platform/internal/service/quota/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestReconcileTask_oneLoop(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		mock    func(svc *quotamocks.MockService, configRepo *repositorymocks.MockBusinessConfigRepository)
+		wantErr error
+	}{
+		{
+			name: "每个业务使用独立的超时时间",
+			mock: func(svc *quotamocks.MockService, configRepo *repositorymocks.MockBusinessConfigRepository) {
+				configRepo.EXPECT().Find(gomock.Any(), 0, defaultBatchSize).
+					Return([]domain.BusinessConfig{{ID: 1}, {ID: 2}}, nil)
+				svc.EXPECT().Reconcile(gomock.Any(), gomock.Any(), true).
+					DoAndReturn(func(ctx context.Context, _ int64, _ bool) ([]domain.QuotaDiscrepancy, error) {
+						deadline, ok := ctx.Deadline()
+						assert.True(t, ok)
+						assert.WithinDuration(t, time.Now().Add(defaultReconcileTimeout), deadline, time.Second)
+						return nil, nil
+					}).Times(2)
+			},
+		},
+		{
+			name: "中途失败",
+			mock: func(_ *quotamocks.MockService, configRepo *repositorymocks.MockBusinessConfigRepository) {
+				configRepo.EXPECT().Find(gomock.Any(), 0, defaultBatchSize).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			svc := quotamocks.NewMockService(ctrl)
+			configRepo := repositorymocks.NewMockBusinessConfigRepository(ctrl)
+			tc.mock(svc, configRepo)
+
+			task := NewReconcileTask(svc, configRepo, nil, true)
+			err := task.oneLoop(t.Context())
+			assert.ErrorIs(t, err, tc.wantErr)
+			// 无论成功失败都记录运行时间，下一次在对账间隔之后才会运行
+			assert.False(t, task.lastRun.IsZero())
+			assert.NoError(t, task.oneLoop(t.Context()))
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package quota
+
+import (
+	"context"
+	"time"
+
+	"github.com/gotomicro/ego/core/elog"
+	"github.com/meoying/dlock-go"
+	"github.com/robinlg/notification-platform/internal/pkg/loopjob"
+	"github.com/robinlg/notification-platform/internal/repository"
+)
+
+const (
+	SyncTaskKey         = "quota_sync_job"
+	defaultSyncTimeout  = 40 * time.Second
+	defaultSyncInterval = 10 * time.Second
+	defaultBatchSize    = 100
+)
+
+// SyncTask 定时遍历所有业务配置同步额度
+// 业务配置没有变更通知，配置变更、跨月重置以及 Redis 数据丢失都依赖这里定时发现
+type SyncTask struct {
+	svc        Service
+	configRepo repository.BusinessConfigRepository
+	lock       dlock.Client
+	batchSize  int
+	logger     *elog.Component
+}
+
+func NewSyncTask(svc Service, configRepo repository.BusinessConfigRepository, lock dlock.Client) *SyncTask {
+	return &SyncTask{
+		svc:        svc,
+		configRepo: configRepo,
+		lock:       lock,
+		batchSize:  defaultBatchSize,
+		logger:     elog.DefaultLogger,
+	}
+}
+
+func (t *SyncTask) Start(ctx context.Context) {
+	job := loopjob.NewInfiniteLoop(t.lock, t.oneLoop, SyncTaskKey)
+	job.Run(ctx)
+}
+
+func (t *SyncTask) oneLoop(ctx context.Context) error {
+	loopCtx, cancel := context.WithTimeout(ctx, defaultSyncTimeout)
+	defer cancel()
+	// 避免立刻又调度
+	defer time.Sleep(defaultSyncInterval)
+
+	offset := 0
+	for {
+		configs, err := t.configRepo.Find(loopCtx, offset, t.batchSize)
+		if err != nil {
+			return err
+		}
+		for i := range configs {
+			if err1 := t.svc.Sync(loopCtx, configs[i]); err1 != nil {
+				// 单个业务失败不影响其他业务，下一轮继续同步
+				t.logger.Warn("同步额度失败", elog.Int64("bizID", configs[i].ID), elog.FieldErr(err1))
+			}
+		}
+		if len(configs) < t.batchSize {
+			return nil
+		}
+		offset += len(configs)
+	}
+}
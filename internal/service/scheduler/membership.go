@@ -0,0 +1,145 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gotomicro/ego/core/elog"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+const (
+	defaultMembershipPrefix        = "/notification-platform/scheduler/members/"
+	defaultMembershipTTL           = 10 // 秒
+	defaultMembershipRetryInterval = time.Second
+)
+
+// Membership 调度器实例的成员关系，用于计算每个实例应该持有多少分区
+type Membership interface {
+	// Join 注册当前实例，并且在 ctx 被取消之前保持注册
+	Join(ctx context.Context) error
+	// Leave 注销当前实例
+	Leave(ctx context.Context) error
+	// Count 当前存活的实例数
+	Count(ctx context.Context) (int, error)
+}
+
+// etcdMembership 基于 etcd 租约实现的成员关系，实例宕机后租约过期，注册信息自动删除
+type etcdMembership struct {
+	client        *clientv3.Client
+	prefix        string
+	instanceID    string
+	ttl           int64
+	retryInterval time.Duration
+	logger        *elog.Component
+
+	mu      sync.Mutex
+	leaseID clientv3.LeaseID
+	left    bool
+}
+
+// NewEtcdMembership 创建基于 etcd 的成员关系，instanceID 在集群内需要唯一
+func NewEtcdMembership(client *clientv3.Client, instanceID string) Membership {
+	return &etcdMembership{
+		client:        client,
+		prefix:        defaultMembershipPrefix,
+		instanceID:    instanceID,
+		ttl:           defaultMembershipTTL,
+		retryInterval: defaultMembershipRetryInterval,
+		logger:        elog.DefaultLogger,
+	}
+}
+
+func (m *etcdMembership) Join(ctx context.Context) error {
+	m.mu.Lock()
+	m.left = false
+	m.mu.Unlock()
+	ch, err := m.register(ctx)
+	if err != nil {
+		return err
+	}
+	go m.keepAlive(ctx, ch)
+	return nil
+}
+
+// register 创建租约并使用它注册当前实例
+func (m *etcdMembership) register(ctx context.Context) (<-chan *clientv3.LeaseKeepAliveResponse, error) {
+	lease, err := m.client.Grant(ctx, m.ttl)
+	if err != nil {
+		return nil, fmt.Errorf("创建租约失败: %w", err)
+	}
+	_, err = m.client.Put(ctx, m.prefix+m.instanceID, m.instanceID, clientv3.WithLease(lease.ID))
+	if err != nil {
+		return nil, fmt.Errorf("注册调度器实例失败: %w", err)
+	}
+	ch, err := m.client.KeepAlive(ctx, lease.ID)
+	if err != nil {
+		return nil, fmt.Errorf("租约续约失败: %w", err)
+	}
+	m.mu.Lock()
+	m.leaseID = lease.ID
+	m.mu.Unlock()
+	return ch, nil
+}
+
+// keepAlive 消费续约响应，直到 ctx 被取消或者主动注销
+// 和 etcd 断开的时间超过租约有效期时租约已经过期，续约响应的 channel 会被关闭，
+// 此时注册信息已经被删除，需要重新创建租约并注册，否则其余实例会认为当前实例已经下线
+func (m *etcdMembership) keepAlive(ctx context.Context, ch <-chan *clientv3.LeaseKeepAliveResponse) {
+	for {
+		// 必须消费续约响应，否则 etcd 客户端会不断告警
+		for range ch {
+		}
+		if ctx.Err() != nil || m.hasLeft() {
+			return
+		}
+		m.logger.Warn("调度器实例租约续约中断，重新注册", elog.String("instanceID", m.instanceID))
+		for {
+			var err error
+			ch, err = m.register(ctx)
+			if err == nil && m.hasLeft() {
+				// 重新注册期间已经主动注销
+				_ = m.Leave(ctx)
+				return
+			}
+			if err == nil {
+				break
+			}
+			m.logger.Error("重新注册调度器实例失败", elog.String("instanceID", m.instanceID), elog.FieldErr(err))
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(m.retryInterval):
+			}
+		}
+	}
+}
+
+func (m *etcdMembership) hasLeft() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.left
+}
+
+func (m *etcdMembership) Leave(ctx context.Context) error {
+	m.mu.Lock()
+	leaseID := m.leaseID
+	m.leaseID = clientv3.NoLease
+	m.left = true
+	m.mu.Unlock()
+	if leaseID == clientv3.NoLease {
+		return nil
+	}
+	_, err := m.client.Revoke(ctx, leaseID)
+	return err
+}
+
+func (m *etcdMembership) Count(ctx context.Context) (int, error) {
+	resp, err := m.client.Get(ctx, m.prefix, clientv3.WithPrefix(), clientv3.WithCountOnly())
+	if err != nil {
+		return 0, err
+	}
+	return int(resp.Count), nil
+}
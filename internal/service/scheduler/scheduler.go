@@ -2,10 +2,15 @@ package scheduler
 
 import (
 	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/gotomicro/ego/core/elog"
 	"github.com/meoying/dlock-go"
-	"github.com/robinlg/notification-platform/internal/pkg/loopjob"
+	"github.com/robinlg/notification-platform/internal/domain"
+	"github.com/robinlg/notification-platform/internal/repository/cache"
 
 	notificationsvc "github.com/robinlg/notification-platform/internal/service/notification"
 	"github.com/robinlg/notification-platform/internal/service/sender"
@@ -17,52 +22,441 @@ type NotificationScheduler interface {
 	Start(ctx context.Context)
 }
 
-// staticScheduler 通知调度服务实现
-type staticScheduler struct {
+const (
+	partitionKeyPrefix       = "notification_platform_async_scheduler"
+	defaultPartitionCount    = 16
+	defaultBatchSize         = 10
+	defaultLockExpiration    = time.Minute
+	defaultLockTimeout       = 3 * time.Second
+	defaultProcessTimeout    = 3 * time.Second
+	defaultClaimInterval     = 3 * time.Second
+	defaultMembersInterval   = 5 * time.Second
+	defaultMembershipTimeout = 3 * time.Second
+	// defaultScanInterval 使用延迟队列时兜底扫描数据库的间隔
+	defaultScanInterval = 30 * time.Second
+	// defaultScanBudget 一轮兜底扫描的最长时间，必须小于 defaultProcessTimeout
+	defaultScanBudget = 2 * time.Second
+	// defaultQueueIdleInterval 使用延迟队列时没有到期通知的等待时间
+	defaultQueueIdleInterval = 100 * time.Millisecond
+	// defaultScanIdleInterval 只扫描数据库时没有待发送通知的等待时间
+	defaultScanIdleInterval = time.Second
+)
+
+// shardedScheduler 分片的通知调度服务实现
+// 所有通知按照 ID 中嵌入的 hash 值划分为多个分区，每个分区使用一把分布式锁，
+// 各个实例抢占分区并且最多持有 ceil(分区数/实例数) 个分区，实例加入或者退出时自动重新平衡，
+// 吞吐量随实例数增加而增加
+//
+// 配置了延迟队列时，优先从延迟队列中取出到期的通知，并且定时扫描数据库兜底，
+// 找回加入队列失败或者取出之后没有发送成功的通知；否则每一轮都扫描数据库
+type shardedScheduler struct {
 	notificationSvc notificationsvc.Service
 	sender          sender.NotificationSender
 	dclient         dlock.Client
+	membership      Membership
+	queue           cache.DelayQueue
+
+	batchSize  int
+	partitions []domain.Partition
+
+	// held 当前实例持有的分区数
+	held atomic.Int32
+	// members 最近一次观察到的实例数
+	members atomic.Int32
+
+	lockExpiration time.Duration
+	claimInterval  time.Duration
+	scanInterval   time.Duration
+	scanBudget     time.Duration
+	logger         *elog.Component
+}
 
-	batchSize int
+// lane 调度通道，每个优先级一个通道
+// 按照平滑加权轮询选择通道，高优先级通道被选中的次数更多，低优先级通道也不会被饿死
+type lane struct {
+	priority      domain.Priority
+	weight        int
+	currentWeight int
 }
 
-// NewScheduler 创建通知调度服务
+// defaultLaneWeights 各优先级通道的默认权重
+var defaultLaneWeights = map[domain.Priority]int{
+	domain.PriorityHigh:   6,
+	domain.PriorityMedium: 3,
+	domain.PriorityLow:    1,
+}
+
+// NewScheduler 创建通知调度服务，partitionCount 为分区数，小于等于0时使用默认值
+// 所有实例的分区数必须一致，并且与延迟队列的分区数一致。queue 为 nil 时只扫描数据库
 func NewScheduler(
 	notificationSvc notificationsvc.Service,
 	dispatcher sender.NotificationSender,
 	dclient dlock.Client,
+	membership Membership,
+	queue cache.DelayQueue,
+	partitionCount int,
 ) NotificationScheduler {
-	const defaultBatchSize = 10
-	return &staticScheduler{
+	if partitionCount <= 0 {
+		partitionCount = defaultPartitionCount
+	}
+	return newShardedScheduler(notificationSvc, dispatcher, dclient, membership, queue, partitionCount)
+}
+
+func newShardedScheduler(
+	notificationSvc notificationsvc.Service,
+	dispatcher sender.NotificationSender,
+	dclient dlock.Client,
+	membership Membership,
+	queue cache.DelayQueue,
+	partitionCount int,
+) *shardedScheduler {
+	s := &shardedScheduler{
 		notificationSvc: notificationSvc,
 		sender:          dispatcher,
-		batchSize:       defaultBatchSize,
 		dclient:         dclient,
+		membership:      membership,
+		queue:           queue,
+		batchSize:       defaultBatchSize,
+		partitions:      domain.Partitions(partitionCount),
+		lockExpiration:  defaultLockExpiration,
+		claimInterval:   defaultClaimInterval,
+		scanInterval:    defaultScanInterval,
+		scanBudget:      defaultScanBudget,
+		logger:          elog.DefaultLogger,
 	}
+	s.members.Store(1)
+	return s
+}
+
+func newLanes(weights map[domain.Priority]int) []*lane {
+	lanes := make([]*lane, 0, len(domain.Priorities))
+	for _, p := range domain.Priorities {
+		lanes = append(lanes, &lane{priority: p, weight: weights[p]})
+	}
+	return lanes
 }
 
 // Start 启动调度服务
 // 当 ctx 被取消的或者关闭的时候，就会结束循环
-func (s *staticScheduler) Start(ctx context.Context) {
-	const key = "notification_platform_async_scheduler"
-	lj := loopjob.NewInfiniteLoop(s.dclient, s.processPendingNotifications, key)
-	lj.Run(ctx)
+func (s *shardedScheduler) Start(ctx context.Context) {
+	err := s.membership.Join(ctx)
+	if err != nil {
+		// 注册失败时按照只有一个实例处理，其余实例持有的分区不会被抢占
+		s.logger.Error("注册调度器实例失败", elog.FieldErr(err))
+	}
+	s.refreshMembers(ctx)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		s.watchMembers(ctx)
+	}()
+	for i := range s.partitions {
+		w := &partitionWorker{
+			partition: s.partitions[i],
+			lanes:     newLanes(defaultLaneWeights),
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.runPartition(ctx, w)
+		}()
+	}
+	wg.Wait()
+
+	leaveCtx, cancel := context.WithTimeout(context.Background(), defaultMembershipTimeout)
+	defer cancel()
+	//nolint:contextcheck // 原始 ctx 已被取消，但仍需注销实例
+	if err = s.membership.Leave(leaveCtx); err != nil {
+		s.logger.Error("注销调度器实例失败", elog.FieldErr(err))
+	}
+}
+
+// watchMembers 定时刷新实例数
+func (s *shardedScheduler) watchMembers(ctx context.Context) {
+	ticker := time.NewTicker(defaultMembersInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.refreshMembers(ctx)
+		}
+	}
 }
 
-// processPendingNotifications 处理待发送的通知
-func (s *staticScheduler) processPendingNotifications(ctx context.Context) error {
-	const defaultTimeout = 3 * time.Second
-	ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+func (s *shardedScheduler) refreshMembers(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, defaultMembershipTimeout)
 	defer cancel()
-	const offset = 0
-	notifications, err := s.notificationSvc.FindReadyNotifications(ctx, offset, s.batchSize)
+	cnt, err := s.membership.Count(ctx)
 	if err != nil {
-		return err
+		// 保留上一次的结果
+		s.logger.Warn("获取调度器实例数失败", elog.FieldErr(err))
+		return
+	}
+	if cnt <= 0 {
+		cnt = 1
+	}
+	s.members.Store(int32(cnt))
+}
+
+// quota 当前实例最多持有的分区数
+func (s *shardedScheduler) quota() int32 {
+	members := int(s.members.Load())
+	return int32((len(s.partitions) + members - 1) / members)
+}
+
+// tryAcquireSlot 在没有超过配额的情况下占用一个分区名额
+func (s *shardedScheduler) tryAcquireSlot() bool {
+	for {
+		held := s.held.Load()
+		if held >= s.quota() {
+			return false
+		}
+		if s.held.CompareAndSwap(held, held+1) {
+			return true
+		}
+	}
+}
+
+// releaseIfOverQuota 实例数增加导致超出配额时让出一个分区名额
+func (s *shardedScheduler) releaseIfOverQuota() bool {
+	for {
+		held := s.held.Load()
+		if held <= s.quota() {
+			return false
+		}
+		if s.held.CompareAndSwap(held, held-1) {
+			return true
+		}
+	}
+}
+
+// runPartition 抢占并处理一个分区，直到 ctx 被取消
+func (s *shardedScheduler) runPartition(ctx context.Context, w *partitionWorker) {
+	key := fmt.Sprintf("%s:%d", partitionKeyPrefix, w.partition.ID)
+	logger := s.logger.With(elog.String("key", key))
+	for ctx.Err() == nil {
+		if !s.tryAcquireSlot() {
+			s.sleep(ctx, s.claimInterval)
+			continue
+		}
+		lock, err := s.claim(ctx, key)
+		if err != nil {
+			// 分区被其他实例持有，或者系统出现问题，稍后再试
+			s.held.Add(-1)
+			s.sleep(ctx, s.claimInterval)
+			continue
+		}
+
+		released, err := s.serve(ctx, w, lock)
+		if !released {
+			s.held.Add(-1)
+		}
+		if err != nil {
+			logger.Error("处理分区失败，将执行重试", elog.FieldErr(err))
+		}
+
+		// 要稍微摆脱 ctx 的控制，因为此时 ctx 可能被取消了
+		unCtx, cancel := context.WithTimeout(context.Background(), defaultLockTimeout)
+		//nolint:contextcheck // 这里必须使用 Background Context，因为原始 ctx 可能已被取消，但仍需尝试解锁操作。
+		unErr := lock.Unlock(unCtx)
+		cancel()
+		if unErr != nil {
+			logger.Error("释放分布式锁失败", elog.FieldErr(unErr))
+		}
+		// 让出分区之后暂停一会，让其他实例有机会抢到
+		s.sleep(ctx, s.claimInterval)
 	}
-	if len(notifications) == 0 {
-		time.Sleep(time.Second)
+}
+
+func (s *shardedScheduler) claim(ctx context.Context, key string) (dlock.Lock, error) {
+	lock, err := s.dclient.NewLock(ctx, key, s.lockExpiration)
+	if err != nil {
+		return nil, err
+	}
+	lockCtx, cancel := context.WithTimeout(ctx, defaultLockTimeout)
+	defer cancel()
+	if err = lock.Lock(lockCtx); err != nil {
+		return nil, err
+	}
+	return lock, nil
+}
+
+// serve 持有分区期间循环处理分区内的通知
+// 返回值 released 表示是否因为超出配额已经让出了分区名额
+func (s *shardedScheduler) serve(ctx context.Context, w *partitionWorker, lock dlock.Lock) (released bool, err error) {
+	for {
+		err = s.processPendingNotifications(ctx, w)
+		if err != nil {
+			s.logger.Error("业务执行失败", elog.Int("partition", w.partition.ID), elog.FieldErr(err))
+		}
+		if ctx.Err() != nil {
+			return false, nil
+		}
+		if s.releaseIfOverQuota() {
+			return true, nil
+		}
+		refCtx, cancel := context.WithTimeout(ctx, defaultLockTimeout)
+		err = lock.Refresh(refCtx)
+		cancel()
+		if err != nil {
+			return false, fmt.Errorf("分布式锁续约失败 %w", err)
+		}
+	}
+}
+
+// processPendingNotifications 处理分区内待发送的通知
+func (s *shardedScheduler) processPendingNotifications(ctx context.Context, w *partitionWorker) error {
+	ctx, cancel := context.WithTimeout(ctx, defaultProcessTimeout)
+	defer cancel()
+	// 先处理本轮选中的通道，没有待发送的通知时再按照优先级从高到低处理其余通道，避免空转
+	order := w.priorityOrder()
+
+	if s.queue == nil {
+		found, err := s.scan(ctx, w, order)
+		if err != nil || found {
+			return err
+		}
+		s.sleep(ctx, defaultScanIdleInterval)
 		return nil
 	}
-	_, err = s.sender.BatchSend(ctx, notifications)
+
+	now := time.Now()
+	if now.Sub(w.lastScan) >= s.scanInterval {
+		done, err := s.fallbackScan(ctx, w)
+		if err != nil || !done {
+			// 没有扫描完，下一轮继续扫描
+			return err
+		}
+		w.lastScan = now
+	}
+
+	found, err := s.pop(ctx, w, order)
+	if err != nil || found {
+		return err
+	}
+	s.sleep(ctx, defaultQueueIdleInterval)
+	return nil
+}
+
+// scan 扫描数据库，发送第一个有待发送通知的通道中的通知
+func (s *shardedScheduler) scan(ctx context.Context, w *partitionWorker, order []domain.Priority) (bool, error) {
+	for _, p := range order {
+		cnt, err := s.scanBatch(ctx, w.partition, p)
+		if err != nil || cnt > 0 {
+			return true, err
+		}
+	}
+	return false, nil
+}
+
+// fallbackScan 兜底扫描数据库，按照优先级从高到低依次发送所有通道中遗漏的通知，
+// 每个通道一直扫描到返回的批次不满为止，返回值 done 表示是否已经扫描完所有通道
+// 遗漏的通知很多时一轮处理不完，超出扫描预算后返回，由下一轮继续扫描，避免分布式锁来不及续约
+func (s *shardedScheduler) fallbackScan(ctx context.Context, w *partitionWorker) (bool, error) {
+	start := time.Now()
+	for _, p := range domain.Priorities {
+		for {
+			if time.Since(start) >= s.scanBudget || ctx.Err() != nil {
+				return false, nil
+			}
+			cnt, err1 := s.scanBatch(ctx, w.partition, p)
+			if err1 != nil {
+				return false, err1
+			}
+			if cnt < s.batchSize {
+				break
+			}
+		}
+	}
+	return true, nil
+}
+
+// scanBatch 扫描指定通道中的一批待发送通知并发送，返回扫描到的通知数量
+// 发送之前通知已经不再是 PENDING 状态，下一批依旧从头开始扫描
+func (s *shardedScheduler) scanBatch(ctx context.Context, partition domain.Partition, priority domain.Priority) (int, error) {
+	const offset = 0
+	notifications, err := s.notificationSvc.FindReadyNotifications(ctx, partition, priority, offset, s.batchSize)
+	if err != nil || len(notifications) == 0 {
+		return 0, err
+	}
+	return len(notifications), s.send(ctx, notifications)
+}
+
+// send 先将通知标记为发送中再发送，发送期间通知不能被取消或者修改
+// 标记失败的通知已经被取消、修改或者被其他调度器发送，直接跳过
+func (s *shardedScheduler) send(ctx context.Context, notifications []domain.Notification) error {
+	claimed, err := s.notificationSvc.MarkSending(ctx, notifications)
+	if err != nil || len(claimed) == 0 {
+		return err
+	}
+	_, err = s.sender.BatchSend(ctx, claimed)
 	return err
 }
+
+// pop 从延迟队列中取出到期的通知并发送
+// 取出之后发送失败的通知仍然是 PENDING 状态，由兜底扫描找回
+func (s *shardedScheduler) pop(ctx context.Context, w *partitionWorker, order []domain.Priority) (bool, error) {
+	for _, p := range order {
+		ids, err := s.queue.Pop(ctx, w.partition, p, time.Now(), s.batchSize)
+		if err != nil {
+			return false, err
+		}
+		if len(ids) == 0 {
+			continue
+		}
+		// 取消、修改或者已经被兜底扫描发送的通知会被过滤掉
+		notifications, err := s.notificationSvc.FindReadyByIDs(ctx, ids)
+		if err != nil {
+			return true, err
+		}
+		if len(notifications) == 0 {
+			return true, nil
+		}
+		return true, s.send(ctx, notifications)
+	}
+	return false, nil
+}
+
+func (s *shardedScheduler) sleep(ctx context.Context, d time.Duration) {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+	case <-timer.C:
+	}
+}
+
+// partitionWorker 分区的调度状态，只会被处理该分区的 goroutine 访问
+type partitionWorker struct {
+	partition domain.Partition
+	lanes     []*lane
+	// lastScan 上一次扫描数据库的时间
+	lastScan time.Time
+}
+
+// priorityOrder 返回本轮查询通道的顺序，第一个是按照平滑加权轮询选中的通道
+func (w *partitionWorker) priorityOrder() []domain.Priority {
+	total := 0
+	var selected *lane
+	for _, l := range w.lanes {
+		l.currentWeight += l.weight
+		total += l.weight
+		if selected == nil || l.currentWeight > selected.currentWeight {
+			selected = l
+		}
+	}
+	selected.currentWeight -= total
+
+	order := make([]domain.Priority, 0, len(w.lanes))
+	order = append(order, selected.priority)
+	for _, l := range w.lanes {
+		if l != selected {
+			order = append(order, l.priority)
+		}
+	}
+	return order
+}
@@ -0,0 +1,225 @@
+//go:build unit
+
+package scheduler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/robinlg/notification-platform/internal/domain"
+	notificationmocks "github.com/robinlg/notification-platform/internal/service/notification/mocks"
+	"github.com/robinlg/notification-platform/internal/service/sender"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+type stubSender struct {
+	sender.NotificationSender
+	sent [][]domain.Notification
+}
+
+func (s *stubSender) BatchSend(_ context.Context, ns []domain.Notification) ([]domain.SendResponse, error) {
+	s.sent = append(s.sent, ns)
+	return nil, nil
+}
+
+type stubQueue struct {
+	ids map[domain.Priority][]uint64
+}
+
+func (q *stubQueue) Add(_ context.Context, _ ...domain.Notification) error {
+	return nil
+}
+
+func (q *stubQueue) Pop(_ context.Context, _ domain.Partition, p domain.Priority, _ time.Time, _ int) ([]uint64, error) {
+	ids := q.ids[p]
+	delete(q.ids, p)
+	return ids, nil
+}
+
+// claimAll 标记所有通知为发送中
+func claimAll(svc *notificationmocks.MockService) {
+	svc.EXPECT().MarkSending(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, ns []domain.Notification) ([]domain.Notification, error) {
+			return ns, nil
+		}).AnyTimes()
+}
+
+func TestPartitionWorker_PriorityOrder(t *testing.T) {
+	t.Parallel()
+
+	w := &partitionWorker{lanes: newLanes(defaultLaneWeights)}
+	counts := make(map[domain.Priority]int)
+	for range 100 {
+		order := w.priorityOrder()
+		require.Len(t, order, len(domain.Priorities))
+		counts[order[0]]++
+	}
+	assert.Equal(t, map[domain.Priority]int{
+		domain.PriorityHigh:   60,
+		domain.PriorityMedium: 30,
+		domain.PriorityLow:    10,
+	}, counts)
+}
+
+func TestShardedScheduler_FallbackToOtherLanes(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	svc := notificationmocks.NewMockService(ctrl)
+	snd := &stubSender{}
+	s := newShardedScheduler(svc, snd, nil, nil, nil, 4)
+	w := &partitionWorker{partition: s.partitions[1], lanes: newLanes(defaultLaneWeights)}
+	partition := domain.Partition{ID: 1, SlotStart: 256, SlotEnd: 512}
+	require.Equal(t, partition, w.partition)
+
+	// 第一轮选中高优先级通道，高优先级和中优先级都没有待发送的通知时发送低优先级的
+	gomock.InOrder(
+		svc.EXPECT().FindReadyNotifications(gomock.Any(), partition, domain.PriorityHigh, 0, 10).Return(nil, nil),
+		svc.EXPECT().FindReadyNotifications(gomock.Any(), partition, domain.PriorityMedium, 0, 10).Return(nil, nil),
+		svc.EXPECT().FindReadyNotifications(gomock.Any(), partition, domain.PriorityLow, 0, 10).
+			Return([]domain.Notification{{ID: 1, Priority: domain.PriorityLow}}, nil),
+	)
+
+	claimAll(svc)
+
+	err := s.processPendingNotifications(t.Context(), w)
+	require.NoError(t, err)
+	require.Len(t, snd.sent, 1)
+	assert.Equal(t, uint64(1), snd.sent[0][0].ID)
+}
+
+func TestShardedScheduler_Rebalance(t *testing.T) {
+	t.Parallel()
+
+	s := newShardedScheduler(nil, nil, nil, nil, nil, 16)
+
+	// 3 个实例时每个实例最多持有 6 个分区
+	s.members.Store(3)
+	for range 6 {
+		require.True(t, s.tryAcquireSlot())
+	}
+	assert.False(t, s.tryAcquireSlot())
+
+	// 新实例加入，每个实例最多持有 4 个分区，需要让出 2 个
+	s.members.Store(4)
+	assert.True(t, s.releaseIfOverQuota())
+	assert.True(t, s.releaseIfOverQuota())
+	assert.False(t, s.releaseIfOverQuota())
+	assert.Equal(t, int32(4), s.held.Load())
+}
+
+func TestShardedScheduler_PopFromQueue(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	svc := notificationmocks.NewMockService(ctrl)
+	snd := &stubSender{}
+	queue := &stubQueue{ids: map[domain.Priority][]uint64{domain.PriorityMedium: {1, 2}}}
+	s := newShardedScheduler(svc, snd, nil, nil, queue, 4)
+	// 刚刚扫描过数据库，本轮只会从延迟队列中取
+	w := &partitionWorker{partition: s.partitions[0], lanes: newLanes(defaultLaneWeights), lastScan: time.Now()}
+
+	// ID 为 2 的通知已经被取消
+	svc.EXPECT().FindReadyByIDs(gomock.Any(), []uint64{1, 2}).
+		Return([]domain.Notification{{ID: 1}}, nil)
+	claimAll(svc)
+
+	err := s.processPendingNotifications(t.Context(), w)
+	require.NoError(t, err)
+	require.Len(t, snd.sent, 1)
+	assert.Equal(t, []domain.Notification{{ID: 1}}, snd.sent[0])
+}
+
+func TestShardedScheduler_FallbackScan(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	svc := notificationmocks.NewMockService(ctrl)
+	snd := &stubSender{}
+	queue := &stubQueue{ids: map[domain.Priority][]uint64{}}
+	s := newShardedScheduler(svc, snd, nil, nil, queue, 4)
+	s.batchSize = 2
+	w := &partitionWorker{partition: s.partitions[0], lanes: newLanes(defaultLaneWeights)}
+	partition := s.partitions[0]
+
+	// 返回的批次是满的就继续扫描同一个通道，直到批次不满再扫描下一个通道
+	gomock.InOrder(
+		svc.EXPECT().FindReadyNotifications(gomock.Any(), partition, domain.PriorityHigh, 0, 2).
+			Return([]domain.Notification{{ID: 1}, {ID: 2}}, nil),
+		svc.EXPECT().FindReadyNotifications(gomock.Any(), partition, domain.PriorityHigh, 0, 2).
+			Return([]domain.Notification{{ID: 3}}, nil),
+		svc.EXPECT().FindReadyNotifications(gomock.Any(), partition, domain.PriorityMedium, 0, 2).
+			Return([]domain.Notification{{ID: 4}, {ID: 5}}, nil),
+		svc.EXPECT().FindReadyNotifications(gomock.Any(), partition, domain.PriorityMedium, 0, 2).
+			Return(nil, nil),
+		svc.EXPECT().FindReadyNotifications(gomock.Any(), partition, domain.PriorityLow, 0, 2).
+			Return(nil, nil),
+	)
+	claimAll(svc)
+
+	err := s.processPendingNotifications(t.Context(), w)
+	require.NoError(t, err)
+	assert.Len(t, snd.sent, 3)
+	assert.False(t, w.lastScan.IsZero())
+
+	// 超出扫描预算时不记录扫描时间，下一轮继续扫描
+	s.scanBudget = 0
+	w.lastScan = time.Time{}
+	err = s.processPendingNotifications(t.Context(), w)
+	require.NoError(t, err)
+	assert.True(t, w.lastScan.IsZero())
+}
+
+func TestShardedScheduler_CancelBeforeSending(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	svc := notificationmocks.NewMockService(ctrl)
+	snd := &stubSender{}
+	s := newShardedScheduler(svc, snd, nil, nil, nil, 4)
+	w := &partitionWorker{partition: s.partitions[0], lanes: newLanes(defaultLaneWeights)}
+
+	found := []domain.Notification{
+		{ID: 1, Status: domain.SendStatusPending, Version: 1},
+		{ID: 2, Status: domain.SendStatusPending, Version: 1},
+	}
+	svc.EXPECT().FindReadyNotifications(gomock.Any(), w.partition, domain.PriorityHigh, 0, 10).Return(found, nil)
+	// 查询之后 ID 为 1 的通知被业务方取消了，标记为发送中失败，不能再发送
+	svc.EXPECT().MarkSending(gomock.Any(), found).
+		Return([]domain.Notification{{ID: 2, Status: domain.SendStatusSending, Version: 2}}, nil)
+
+	err := s.processPendingNotifications(t.Context(), w)
+	require.NoError(t, err)
+	require.Len(t, snd.sent, 1)
+	assert.Equal(t, []domain.Notification{{ID: 2, Status: domain.SendStatusSending, Version: 2}}, snd.sent[0])
+
+	// 所有通知都被取消时不发送
+	svc.EXPECT().FindReadyNotifications(gomock.Any(), w.partition, gomock.Any(), 0, 10).Return(found, nil)
+	svc.EXPECT().MarkSending(gomock.Any(), found).Return(nil, nil)
+	err = s.processPendingNotifications(t.Context(), w)
+	require.NoError(t, err)
+	assert.Len(t, snd.sent, 1)
+}
+
+func TestPartitionOf(t *testing.T) {
+	t.Parallel()
+
+	for _, n := range []int{1, 3, 7, 16, 1000} {
+		for _, p := range domain.Partitions(n) {
+			for slot := p.SlotStart; slot < p.SlotEnd; slot++ {
+				require.Equal(t, p.ID, domain.PartitionOf(slot, n), "n = %d, slot = %d", n, slot)
+			}
+		}
+	}
+}
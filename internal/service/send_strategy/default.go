@@ -8,6 +8,7 @@ import (
 	"github.com/robinlg/notification-platform/internal/domain"
 	"github.com/robinlg/notification-platform/internal/errs"
 	"github.com/robinlg/notification-platform/internal/repository"
+	"github.com/robinlg/notification-platform/internal/repository/cache"
 	configsvc "github.com/robinlg/notification-platform/internal/service/config"
 )
 
@@ -15,14 +16,17 @@ import (
 type DefaultSendStrategy struct {
 	repo      repository.NotificationRepository
 	configSvc configsvc.BusinessConfigService
-	logger    *elog.Component
+	// queue 延迟队列，为 nil 时只依赖调度器扫描数据库
+	queue  cache.DelayQueue
+	logger *elog.Component
 }
 
-// NewDefaultStrategy 创建延迟发送策略
-func NewDefaultStrategy(repo repository.NotificationRepository, configSvc configsvc.BusinessConfigService) *DefaultSendStrategy {
+// NewDefaultStrategy 创建延迟发送策略，queue 可以为 nil
+func NewDefaultStrategy(repo repository.NotificationRepository, configSvc configsvc.BusinessConfigService, queue cache.DelayQueue) *DefaultSendStrategy {
 	return &DefaultSendStrategy{
 		repo:      repo,
 		configSvc: configSvc,
+		queue:     queue,
 		logger:    elog.DefaultLogger,
 	}
 }
@@ -30,11 +34,13 @@ func NewDefaultStrategy(repo repository.NotificationRepository, configSvc config
 // Send 单条发送通知
 func (s *DefaultSendStrategy) Send(ctx context.Context, notification domain.Notification) (domain.SendResponse, error) {
 	notification.SetSendTime()
+	notification.Status = domain.SendStatusPending
 	// 创建通知记录
 	created, err := s.create(ctx, notification)
 	if err != nil {
 		return domain.SendResponse{}, fmt.Errorf("创建延迟通知失败: %w", err)
 	}
+	s.enqueue(ctx, created)
 
 	return domain.SendResponse{
 		NotificationID: created.ID,
@@ -74,6 +80,7 @@ func (s *DefaultSendStrategy) BatchSend(ctx context.Context, notifications []dom
 
 	for i := range notifications {
 		notifications[i].SetSendTime()
+		notifications[i].Status = domain.SendStatusPending
 	}
 
 	// 创建通知记录
@@ -81,6 +88,7 @@ func (s *DefaultSendStrategy) BatchSend(ctx context.Context, notifications []dom
 	if err != nil {
 		return nil, fmt.Errorf("创建延迟通知失败: %w", err)
 	}
+	s.enqueue(ctx, createdNotifications...)
 
 	// 仅创建通知记录，等待定时任务扫描发送
 	responses := make([]domain.SendResponse, len(createdNotifications))
@@ -92,3 +100,20 @@ func (s *DefaultSendStrategy) BatchSend(ctx context.Context, notifications []dom
 	}
 	return responses, nil
 }
+
+// enqueue 将等待发送的通知加入延迟队列
+// 加入失败时不影响创建结果，调度器兜底扫描数据库时依旧能够发现这些通知
+func (s *DefaultSendStrategy) enqueue(ctx context.Context, notifications ...domain.Notification) {
+	if s.queue == nil {
+		return
+	}
+	pending := make([]domain.Notification, 0, len(notifications))
+	for i := range notifications {
+		if notifications[i].Status == domain.SendStatusPending {
+			pending = append(pending, notifications[i])
+		}
+	}
+	if err := s.queue.Add(ctx, pending...); err != nil {
+		s.logger.Warn("通知加入延迟队列失败", elog.Int("count", len(pending)), elog.FieldErr(err))
+	}
+}
@@ -28,10 +28,12 @@ func NewImmediateStrategy(repo repository.NotificationRepository, sender sender.
 
 func (s *ImmediateSendStrategy) Send(ctx context.Context, notification domain.Notification) (domain.SendResponse, error) {
 	notification.SetSendTime()
+	// 直接创建为发送中，避免调度器同时发送
+	notification.Status = domain.SendStatusSending
 	created, err := s.repo.Create(ctx, notification)
 	if err == nil {
 		// 立即发送
-		return s.sender.Send(ctx, notification)
+		return s.sender.Send(ctx, created)
 	}
 
 	// 非唯一索引冲突直接返回错误
@@ -40,7 +42,7 @@ func (s *ImmediateSendStrategy) Send(ctx context.Context, notification domain.No
 	}
 
 	// 唯一索引冲突表示业务方重试
-	found, err := s.repo.GetByKey(ctx, created.BizID, created.Key)
+	found, err := s.repo.GetByKey(ctx, notification.BizID, notification.Key)
 	if err != nil {
 		return domain.SendResponse{}, fmt.Errorf("获取通知失败: %w", err)
 	}
@@ -56,15 +58,25 @@ func (s *ImmediateSendStrategy) Send(ctx context.Context, notification domain.No
 		return domain.SendResponse{}, fmt.Errorf("发送失败 %w", errs.ErrSendNotificationFailed)
 	}
 
-	// 更新通知状态为SENDING同时获取乐观锁（版本号）
-	found.Status = domain.SendStatusSending
-	err = s.repo.CASStatus(ctx, found)
+	if found.Status == domain.SendStatusExpired {
+		return domain.SendResponse{}, fmt.Errorf("%w: id = %d", errs.ErrNotificationExpired, found.ID)
+	}
+
+	// 发送失败、已取消的通知不会再次发送，发送失败的通知需要通过重新发送接口发送
+	if found.Status != domain.SendStatusPending {
+		return domain.SendResponse{}, fmt.Errorf("%w: id = %d, status = %s", errs.ErrInvalidNotificationStatus, found.ID, found.Status)
+	}
+
+	// 只有等待发送的通知可以再次发送，更新通知状态为SENDING同时获取乐观锁（版本号）
+	claimed, err := s.repo.CASMarkSending(ctx, []domain.Notification{found})
 	if err != nil {
-		return domain.SendResponse{}, fmt.Errorf("并发竞争失败: %w", err)
+		return domain.SendResponse{}, fmt.Errorf("%w: %w", errs.ErrDatabaseError, err)
+	}
+	if len(claimed) == 0 {
+		return domain.SendResponse{}, fmt.Errorf("并发竞争失败: %w, status = %s", errs.ErrNotificationVersionMismatch, found.Status)
 	}
-	found.Version++
 	// 再次立即发送
-	return s.sender.Send(ctx, found)
+	return s.sender.Send(ctx, claimed[0])
 }
 
 // BatchSend 批量发送通知，其中每个通知的发送策略必须相同
@@ -75,6 +87,7 @@ func (s *ImmediateSendStrategy) BatchSend(ctx context.Context, notifications []d
 
 	for i := range notifications {
 		notifications[i].SetSendTime()
+		notifications[i].Status = domain.SendStatusSending
 	}
 
 	// 创建通知记录
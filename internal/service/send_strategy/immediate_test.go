@@ -0,0 +1,90 @@
+//go:build unit
+
+package send_strategy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/robinlg/notification-platform/internal/domain"
+	"github.com/robinlg/notification-platform/internal/errs"
+	repositorymocks "github.com/robinlg/notification-platform/internal/repository/mocks"
+	sendermocks "github.com/robinlg/notification-platform/internal/service/sender/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestImmediateSendStrategy_SendDuplicate(t *testing.T) {
+	t.Parallel()
+
+	n := domain.Notification{BizID: 1, Key: "key-1", Channel: domain.ChannelSMS}
+	testCases := []struct {
+		name     string
+		found    domain.Notification
+		mock     func(repo *repositorymocks.MockNotificationRepository, sender *sendermocks.MockNotificationSender)
+		wantResp domain.SendResponse
+		wantErr  error
+	}{
+		{
+			name:     "已经发送成功",
+			found:    domain.Notification{ID: 10, Status: domain.SendStatusSucceeded},
+			mock:     func(_ *repositorymocks.MockNotificationRepository, _ *sendermocks.MockNotificationSender) {},
+			wantResp: domain.SendResponse{NotificationID: 10, Status: domain.SendStatusSucceeded},
+		},
+		{
+			// 发送失败的通知只能通过重新发送接口发送，返回业务错误
+			name:    "已经发送失败",
+			found:   domain.Notification{ID: 10, Status: domain.SendStatusFailed},
+			mock:    func(_ *repositorymocks.MockNotificationRepository, _ *sendermocks.MockNotificationSender) {},
+			wantErr: errs.ErrInvalidNotificationStatus,
+		},
+		{
+			name:    "已经取消",
+			found:   domain.Notification{ID: 10, Status: domain.SendStatusCanceled},
+			mock:    func(_ *repositorymocks.MockNotificationRepository, _ *sendermocks.MockNotificationSender) {},
+			wantErr: errs.ErrInvalidNotificationStatus,
+		},
+		{
+			name:  "等待发送",
+			found: domain.Notification{ID: 10, Status: domain.SendStatusPending, Version: 1},
+			mock: func(repo *repositorymocks.MockNotificationRepository, sender *sendermocks.MockNotificationSender) {
+				claimed := domain.Notification{ID: 10, Status: domain.SendStatusSending, Version: 2}
+				repo.EXPECT().CASMarkSending(gomock.Any(), []domain.Notification{{ID: 10, Status: domain.SendStatusPending, Version: 1}}).
+					Return([]domain.Notification{claimed}, nil)
+				sender.EXPECT().Send(gomock.Any(), claimed).
+					Return(domain.SendResponse{NotificationID: 10, Status: domain.SendStatusSucceeded}, nil)
+			},
+			wantResp: domain.SendResponse{NotificationID: 10, Status: domain.SendStatusSucceeded},
+		},
+		{
+			// 只有等待发送的通知被其他请求抢先发送时才是并发冲突
+			name:  "等待发送的通知被并发抢占",
+			found: domain.Notification{ID: 10, Status: domain.SendStatusPending, Version: 1},
+			mock: func(repo *repositorymocks.MockNotificationRepository, _ *sendermocks.MockNotificationSender) {
+				repo.EXPECT().CASMarkSending(gomock.Any(), gomock.Any()).Return(nil, nil)
+			},
+			wantErr: errs.ErrNotificationVersionMismatch,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := repositorymocks.NewMockNotificationRepository(ctrl)
+			sender := sendermocks.NewMockNotificationSender(ctrl)
+			repo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(domain.Notification{}, errs.ErrNotificationDuplicate)
+			repo.EXPECT().GetByKey(gomock.Any(), n.BizID, n.Key).Return(tc.found, nil)
+			tc.mock(repo, sender)
+
+			resp, err := NewImmediateStrategy(repo, sender).Send(context.Background(), n)
+			assert.ErrorIs(t, err, tc.wantErr)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, tc.wantResp, resp)
+		})
+	}
+}
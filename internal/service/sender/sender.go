@@ -2,6 +2,7 @@ package sender
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"sync"
@@ -9,7 +10,9 @@ import (
 	"github.com/ecodeclub/ekit/pool"
 	"github.com/gotomicro/ego/core/elog"
 	"github.com/robinlg/notification-platform/internal/domain"
+	"github.com/robinlg/notification-platform/internal/errs"
 	"github.com/robinlg/notification-platform/internal/repository"
+	"github.com/robinlg/notification-platform/internal/repository/cache"
 	"github.com/robinlg/notification-platform/internal/service/channel"
 	configsvc "github.com/robinlg/notification-platform/internal/service/config"
 	"github.com/robinlg/notification-platform/internal/service/notification/callback"
@@ -32,17 +35,20 @@ type sender struct {
 	callbackSvc callback.Service
 	channel     channel.Channel
 	taskPool    pool.TaskPool
+	// queue 延迟队列，为 nil 时等待重试的通知依赖调度器扫描数据库
+	queue cache.DelayQueue
 
 	logger *elog.Component
 }
 
-// NewSender 创建通知发送器
+// NewSender 创建通知发送器，queue 可以为 nil
 func NewSender(
 	repo repository.NotificationRepository,
 	configSvc configsvc.BusinessConfigService,
 	callbackSvc callback.Service,
 	channel channel.Channel,
 	taskPool pool.TaskPool,
+	queue cache.DelayQueue,
 ) NotificationSender {
 	return &sender{
 		repo:        repo,
@@ -50,6 +56,7 @@ func NewSender(
 		callbackSvc: callbackSvc,
 		channel:     channel,
 		taskPool:    taskPool,
+		queue:       queue,
 		logger:      elog.DefaultLogger,
 	}
 }
@@ -59,17 +66,30 @@ func (d *sender) Send(ctx context.Context, notification domain.Notification) (do
 	resp := domain.SendResponse{
 		NotificationID: notification.ID,
 	}
-	_, err := d.channel.Send(ctx, notification)
+	result, err := d.channel.Send(ctx, notification)
 	if err != nil {
 		d.logger.Error("发送失败 %w", elog.FieldErr(err))
-		resp.Status = domain.SendStatusFailed
-		notification.Status = domain.SendStatusFailed
+		notification.SetNextRetryTimeAndStatus(d.getChannelConfig(ctx, notification.BizID))
+		// 最终失败时保存在通知上，重新发送之后依旧可以查到，等待重试时只记录在状态变更事件中
+		notification.StatusReason = failedReason(err)
+		resp.Status = notification.Status
+		if notification.Status == domain.SendStatusPending {
+			// 还可以重试，等待调度器再次发送，此时发送结果还不确定，不需要回调
+			err = d.repo.MarkRetry(ctx, notification)
+			if err != nil {
+				return domain.SendResponse{}, err
+			}
+			d.enqueue(ctx, notification)
+			return resp, nil
+		}
 		// 如果是FAILED，需要把quota加回去
 		err = d.repo.MarkFailed(ctx, notification)
 	} else {
 		resp.Status = domain.SendStatusSucceeded
+		resp.Channel = result.Channel
 		notification.Status = domain.SendStatusSucceeded
-		err = d.repo.MarkSuccess(ctx, notification)
+		notification.DeliveredChannel = result.Channel
+		err = d.repo.MarkSuccess(ctx, notification, result.InboxMessages)
 	}
 
 	// 更新发送状态
@@ -92,6 +112,10 @@ func (d *sender) BatchSend(ctx context.Context, notifications []domain.Notificat
 	// 并发发送通知
 	var succeedMu, failedMu sync.Mutex
 	var succeed, failed []domain.SendResponse
+	// 站内信渠道生成的站内信，和通知状态一起写入
+	var inbox []domain.InboxMessage
+	// 发送失败的原因
+	reasons := make(map[uint64]string)
 
 	var wg sync.WaitGroup
 	wg.Add(len(notifications))
@@ -99,7 +123,7 @@ func (d *sender) BatchSend(ctx context.Context, notifications []domain.Notificat
 		n := notifications[i]
 		err := d.taskPool.Submit(ctx, pool.TaskFunc(func(ctx context.Context) error {
 			defer wg.Done()
-			_, err := d.channel.Send(ctx, n)
+			result, err := d.channel.Send(ctx, n)
 			if err != nil {
 				resp := domain.SendResponse{
 					NotificationID: n.ID,
@@ -107,14 +131,17 @@ func (d *sender) BatchSend(ctx context.Context, notifications []domain.Notificat
 				}
 				failedMu.Lock()
 				failed = append(failed, resp)
+				reasons[n.ID] = failedReason(err)
 				failedMu.Unlock()
 			} else {
 				resp := domain.SendResponse{
 					NotificationID: n.ID,
 					Status:         domain.SendStatusSucceeded,
+					Channel:        result.Channel,
 				}
 				succeedMu.Lock()
 				succeed = append(succeed, resp)
+				inbox = append(inbox, result.InboxMessages...)
 				succeedMu.Unlock()
 			}
 			log.Printf("submit notification[%d] = %#v\n", i, n)
@@ -130,30 +157,44 @@ func (d *sender) BatchSend(ctx context.Context, notifications []domain.Notificat
 	}
 	wg.Wait()
 
-	// 获取通知信息，以便获取版本号
-	allNotificationIDs := make([]uint64, 0, len(succeed)+len(failed))
-	for _, s := range succeed {
-		allNotificationIDs = append(allNotificationIDs, s.NotificationID)
-	}
-	for _, f := range failed {
-		allNotificationIDs = append(allNotificationIDs, f.NotificationID)
-	}
-
-	// 获取所有通知的详细信息，包括版本号
-	notificationsMap, err := d.repo.BatchGetByIDs(ctx, allNotificationIDs)
-	if err != nil {
-		d.logger.Warn("批量获取通知详情失败",
-			elog.FieldErr(err),
-			elog.Any("allNotificationIDs", allNotificationIDs),
-		)
-		return nil, fmt.Errorf("批量获取通知详情失败: %w", err)
+	// 调用方已经将通知标记为发送中，使用标记之后的版本号更新发送结果
+	notificationsMap := make(map[uint64]domain.Notification, len(notifications))
+	for i := range notifications {
+		notificationsMap[notifications[i].ID] = notifications[i]
 	}
 
 	succeedNotifications := d.getUpdatedNotifications(succeed, notificationsMap)
 	failedNotifications := d.getUpdatedNotifications(failed, notificationsMap)
+	for i := range failedNotifications {
+		// 和单条发送一致，保存每条通知各自的失败原因
+		failedNotifications[i].StatusReason = reasons[failedNotifications[i].ID]
+	}
+	failedNotifications, retryNotifications := d.splitRetryNotifications(ctx, failedNotifications)
+	if len(retryNotifications) > 0 {
+		// 还可以重试的通知回到待发送状态，发送结果以重试后的为准
+		retryIDs := make(map[uint64]struct{}, len(retryNotifications))
+		for i := range retryNotifications {
+			retryIDs[retryNotifications[i].ID] = struct{}{}
+		}
+		for i := range failed {
+			if _, ok := retryIDs[failed[i].NotificationID]; ok {
+				failed[i].Status = domain.SendStatusPending
+			}
+		}
+		retried, err := d.repo.BatchMarkRetry(ctx, retryNotifications)
+		if err != nil {
+			d.logger.Warn("批量标记通知重试失败",
+				elog.FieldErr(err),
+				elog.Any("retryNotifications", retryNotifications),
+			)
+			return nil, fmt.Errorf("批量标记通知重试失败: %w", err)
+		}
+		retryNotifications = retried
+		d.enqueue(ctx, retryNotifications...)
+	}
 
-	// 更新发送状态
-	err = d.batchUpdateStatus(ctx, succeedNotifications, failedNotifications)
+	// 更新发送状态，发送期间状态已经被修正的通知不会被更新，也不会再次归还额度和回调
+	succeedNotifications, failedNotifications, err := d.batchUpdateStatus(ctx, succeedNotifications, failedNotifications, inbox)
 	if err != nil {
 		return nil, err
 	}
@@ -165,30 +206,91 @@ func (d *sender) BatchSend(ctx context.Context, notifications []domain.Notificat
 	return append(succeed, failed...), nil
 }
 
+// enqueue 将等待重试的通知重新加入延迟队列，到达下次重试时间后才会被取出
+// 加入失败时不影响发送结果，调度器兜底扫描数据库时依旧能够发现这些通知
+func (d *sender) enqueue(ctx context.Context, notifications ...domain.Notification) {
+	if d.queue == nil {
+		return
+	}
+	if err := d.queue.Add(ctx, notifications...); err != nil {
+		d.logger.Warn("等待重试的通知加入延迟队列失败", elog.Int("count", len(notifications)), elog.FieldErr(err))
+	}
+}
+
 // getUpdatedNotifications 获取更新字段后的实体
 func (d *sender) getUpdatedNotifications(responses []domain.SendResponse, notificationsMap map[uint64]domain.Notification) []domain.Notification {
 	notifications := make([]domain.Notification, 0, len(responses))
 	for i := range responses {
 		if n, ok := notificationsMap[responses[i].NotificationID]; ok {
 			n.Status = responses[i].Status
+			n.DeliveredChannel = responses[i].Channel
 			notifications = append(notifications, n)
 		}
 	}
 	return notifications
 }
 
-// batchUpdateStatus 更新发送状态
-func (d *sender) batchUpdateStatus(ctx context.Context, succeedNotifications, failedNotifications []domain.Notification) error {
-	if len(succeedNotifications) > 0 || len(failedNotifications) > 0 {
-		err := d.repo.BatchUpdateStatusSucceededOrFailed(ctx, succeedNotifications, failedNotifications)
-		if err != nil {
-			d.logger.Warn("批量更新通知状态失败",
-				elog.Any("Error", err),
-				elog.Any("succeedNotifications", succeedNotifications),
-				elog.Any("failedNotifications", failedNotifications),
+// splitRetryNotifications 根据业务方的重试策略将发送失败的通知分为最终失败和等待重试两部分
+func (d *sender) splitRetryNotifications(ctx context.Context, notifications []domain.Notification) (failed, retry []domain.Notification) {
+	configs := make(map[int64]*domain.ChannelConfig)
+	for i := range notifications {
+		n := notifications[i]
+		cfg, ok := configs[n.BizID]
+		if !ok {
+			cfg = d.getChannelConfig(ctx, n.BizID)
+			configs[n.BizID] = cfg
+		}
+		n.SetNextRetryTimeAndStatus(cfg)
+		if n.Status == domain.SendStatusPending {
+			retry = append(retry, n)
+		} else {
+			failed = append(failed, n)
+		}
+	}
+	return failed, retry
+}
+
+// getChannelConfig 获取业务方的渠道配置，获取失败时按照没有重试策略处理
+func (d *sender) getChannelConfig(ctx context.Context, bizID int64) *domain.ChannelConfig {
+	cfg, err := d.configSvc.GetByID(ctx, bizID)
+	if err != nil {
+		if !errors.Is(err, errs.ErrConfigNotFound) {
+			d.logger.Warn("获取业务配置失败",
+				elog.FieldErr(err),
+				elog.Int64("bizID", bizID),
 			)
-			return fmt.Errorf("批量更新通知状态失败: %w", err)
 		}
+		return nil
+	}
+	return cfg.ChannelConfig
+}
+
+// batchUpdateStatus 更新发送状态，返回实际更新成功的通知
+func (d *sender) batchUpdateStatus(ctx context.Context, succeedNotifications, failedNotifications []domain.Notification, inbox []domain.InboxMessage) (succeeded, failed []domain.Notification, err error) {
+	if len(succeedNotifications) == 0 && len(failedNotifications) == 0 {
+		return nil, nil, nil
+	}
+	succeeded, failed, err = d.repo.BatchUpdateStatusSucceededOrFailed(ctx, succeedNotifications, failedNotifications, inbox)
+	if err != nil {
+		d.logger.Warn("批量更新通知状态失败",
+			elog.Any("Error", err),
+			elog.Any("succeedNotifications", succeedNotifications),
+			elog.Any("failedNotifications", failedNotifications),
+		)
+		return nil, nil, fmt.Errorf("批量更新通知状态失败: %w", err)
+	}
+	if skipped := len(succeedNotifications) + len(failedNotifications) - len(succeeded) - len(failed); skipped > 0 {
+		d.logger.Warn("部分通知在发送期间状态已被修改，忽略发送结果", elog.Int("count", skipped))
+	}
+	return succeeded, failed, nil
+}
+
+// failedReason 发送失败的原因，超出字段长度的部分会被截断
+func failedReason(err error) string {
+	const maxReasonLength = 256
+	reason := []rune(err.Error())
+	if len(reason) > maxReasonLength {
+		reason = reason[:maxReasonLength]
 	}
-	return nil
+	return string(reason)
 }
@@ -0,0 +1,180 @@
+//go:build unit
+
+package sender
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ecodeclub/ekit/pool"
+	"github.com/robinlg/notification-platform/internal/domain"
+	"github.com/robinlg/notification-platform/internal/errs"
+	"github.com/robinlg/notification-platform/internal/pkg/retry"
+	cachemocks "github.com/robinlg/notification-platform/internal/repository/cache/mocks"
+	repositorymocks "github.com/robinlg/notification-platform/internal/repository/mocks"
+	channelmocks "github.com/robinlg/notification-platform/internal/service/channel/mocks"
+	configmocks "github.com/robinlg/notification-platform/internal/service/config/mocks"
+	callbackmocks "github.com/robinlg/notification-platform/internal/service/notification/callback/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSender_Send(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	// 定时发送的通知在计划发送时间就结束了发送窗口
+	notification := domain.Notification{
+		ID:             1,
+		BizID:          2,
+		Channel:        domain.ChannelSMS,
+		Status:         domain.SendStatusSending,
+		ScheduledSTime: now.Add(-3 * time.Second),
+		ScheduledETime: now,
+		Version:        2,
+	}
+	retryConfig := domain.BusinessConfig{
+		ID: 2,
+		ChannelConfig: &domain.ChannelConfig{
+			RetryPolicy: &retry.Config{
+				Type:          "fixed",
+				FixedInterval: &retry.FixedIntervalConfig{MaxRetries: 2, Interval: time.Second},
+			},
+		},
+	}
+
+	type mocks struct {
+		repo        *repositorymocks.MockNotificationRepository
+		configSvc   *configmocks.MockBusinessConfigService
+		callbackSvc *callbackmocks.MockService
+		channel     *channelmocks.MockChannel
+		queue       *cachemocks.MockDelayQueue
+	}
+
+	testCases := []struct {
+		name       string
+		mock       func(t *testing.T, m mocks)
+		wantStatus domain.SendStatus
+	}{
+		{
+			name: "发送成功",
+			mock: func(t *testing.T, m mocks) {
+				m.channel.EXPECT().Send(gomock.Any(), notification).
+					Return(domain.SendResponse{NotificationID: 1, Status: domain.SendStatusSucceeded, Channel: domain.ChannelSMS}, nil)
+				m.repo.EXPECT().MarkSuccess(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				m.callbackSvc.EXPECT().SendCallbackByNotification(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			wantStatus: domain.SendStatusSucceeded,
+		},
+		{
+			name: "发送失败后按照发送时间计算重试窗口",
+			mock: func(t *testing.T, m mocks) {
+				m.channel.EXPECT().Send(gomock.Any(), notification).Return(domain.SendResponse{}, errs.ErrSendNotificationFailed)
+				m.configSvc.EXPECT().GetByID(gomock.Any(), int64(2)).Return(retryConfig, nil)
+				m.repo.EXPECT().MarkRetry(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, n domain.Notification) error {
+					assert.Equal(t, domain.SendStatusPending, n.Status)
+					assert.Equal(t, int32(1), n.RetryCount)
+					assert.True(t, n.ScheduledETime.After(now))
+					assert.LessOrEqual(t, n.NextRetryTime, n.ScheduledETime.UnixMilli())
+					return nil
+				})
+				// 到达下次重试时间之后才会从延迟队列中取出
+				m.queue.EXPECT().Add(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, ns ...domain.Notification) error {
+					assert.Len(t, ns, 1)
+					assert.Equal(t, ns[0].NextRetryTime, ns[0].ReadyTime().UnixMilli())
+					return nil
+				})
+				// 最终结果由回调任务通知业务方
+			},
+			wantStatus: domain.SendStatusPending,
+		},
+		{
+			name: "没有重试策略时标记失败",
+			mock: func(t *testing.T, m mocks) {
+				m.channel.EXPECT().Send(gomock.Any(), notification).Return(domain.SendResponse{}, errs.ErrSendNotificationFailed)
+				m.configSvc.EXPECT().GetByID(gomock.Any(), int64(2)).Return(domain.BusinessConfig{}, errs.ErrConfigNotFound)
+				m.repo.EXPECT().MarkFailed(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, n domain.Notification) error {
+					assert.Equal(t, domain.SendStatusFailed, n.Status)
+					assert.NotEmpty(t, n.StatusReason)
+					return nil
+				})
+				m.callbackSvc.EXPECT().SendCallbackByNotification(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			wantStatus: domain.SendStatusFailed,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mocks{
+				repo:        repositorymocks.NewMockNotificationRepository(ctrl),
+				configSvc:   configmocks.NewMockBusinessConfigService(ctrl),
+				callbackSvc: callbackmocks.NewMockService(ctrl),
+				channel:     channelmocks.NewMockChannel(ctrl),
+				queue:       cachemocks.NewMockDelayQueue(ctrl),
+			}
+			tc.mock(t, m)
+
+			s := NewSender(m.repo, m.configSvc, m.callbackSvc, m.channel, nil, m.queue)
+			resp, err := s.Send(context.Background(), notification)
+			assert.NoError(t, err)
+			assert.Equal(t, notification.ID, resp.NotificationID)
+			assert.Equal(t, tc.wantStatus, resp.Status)
+		})
+	}
+}
+
+func TestSender_BatchSend(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	notifications := []domain.Notification{
+		{ID: 1, BizID: 2, Channel: domain.ChannelSMS, Status: domain.SendStatusSending, ScheduledETime: now.Add(time.Hour), Version: 2},
+		{ID: 2, BizID: 2, Channel: domain.ChannelSMS, Status: domain.SendStatusSending, ScheduledETime: now.Add(time.Hour), Version: 2},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repositorymocks.NewMockNotificationRepository(ctrl)
+	configSvc := configmocks.NewMockBusinessConfigService(ctrl)
+	callbackSvc := callbackmocks.NewMockService(ctrl)
+	ch := channelmocks.NewMockChannel(ctrl)
+
+	ch.EXPECT().Send(gomock.Any(), gomock.Any()).Return(domain.SendResponse{}, errs.ErrSendNotificationFailed).Times(2)
+	configSvc.EXPECT().GetByID(gomock.Any(), int64(2)).Return(domain.BusinessConfig{}, errs.ErrConfigNotFound)
+	// 使用标记为发送中之后的版本号更新结果，ID 为 2 的通知在发送期间已经被修正，不会再次归还额度和回调
+	repo.EXPECT().BatchUpdateStatusSucceededOrFailed(gomock.Any(), gomock.Len(0), gomock.Len(2), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _, failed []domain.Notification, _ []domain.InboxMessage) ([]domain.Notification, []domain.Notification, error) {
+			for i := range failed {
+				assert.Equal(t, 2, failed[i].Version)
+				// 每条通知的失败原因都需要写入
+				assert.NotEmpty(t, failed[i].StatusReason)
+			}
+			return nil, []domain.Notification{notifications[0]}, nil
+		})
+	callbackSvc.EXPECT().SendCallbackByNotifications(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, ns []domain.Notification) error {
+			require.Len(t, ns, 1)
+			assert.Equal(t, uint64(1), ns[0].ID)
+			return nil
+		})
+
+	taskPool, err := pool.NewOnDemandBlockTaskPool(2, 2)
+	require.NoError(t, err)
+	require.NoError(t, taskPool.Start())
+	defer func() {
+		_, _ = taskPool.ShutdownNow()
+	}()
+
+	s := NewSender(repo, configSvc, callbackSvc, ch, taskPool, nil)
+	resp, err := s.BatchSend(context.Background(), notifications)
+	require.NoError(t, err)
+	assert.Len(t, resp, 2)
+}